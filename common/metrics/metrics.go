@@ -14,6 +14,7 @@ const (
 	LabelBCName      = "bcname"
 	LabelMessageType = "message"
 	LabelCallMethod  = "method"
+	LabelDropReason  = "reason"
 
 	LabelContractModuleName = "contract_module"
 	LabelContractName       = "contract_name"
@@ -180,6 +181,14 @@ var (
 			Buckets:   DefBuckets,
 		},
 		[]string{LabelBCName, LabelMessageType})
+	NetworkMsgDroppedCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: SubsystemNetwork,
+			Name:      "msg_dropped_total",
+			Help:      "Total number of P2P received message dropped by dispatcher.",
+		},
+		[]string{LabelBCName, LabelMessageType, LabelDropReason})
 )
 
 func RegisterMetrics() {
@@ -204,4 +213,5 @@ func RegisterMetrics() {
 	prometheus.MustRegister(NetworkMsgReceivedCounter)
 	prometheus.MustRegister(NetworkMsgReceivedBytesCounter)
 	prometheus.MustRegister(NetworkServerHandlingHistogram)
+	prometheus.MustRegister(NetworkMsgDroppedCounter)
 }
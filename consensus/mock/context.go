@@ -66,7 +66,7 @@ func (c *FakeKContext) VerifyContractPermission(initiator string, authRequire []
 	return true, nil
 }
 
-func (c *FakeKContext) VerifyContractOwnerPermission(contractName string, authRequire []string) error {
+func (c *FakeKContext) VerifyContractOwnerPermission(contractName string, authRequire []string, initiator string, req *protos.InvokeRequest) error {
 	return nil
 }
 
@@ -120,6 +120,10 @@ func (c *FakeKContext) Call(module, contract, method string, args map[string][]b
 	return nil, nil
 }
 
+func (c *FakeKContext) CallWithLimits(module, contract, method string, args map[string][]byte, limits contractBase.Limits) (*contractBase.Response, error) {
+	return c.Call(module, contract, method, args)
+}
+
 func (c *FakeKContext) UTXORWSet() *contractBase.UTXORWSet {
 	return &contractBase.UTXORWSet{
 		Rset: []*protos.TxInput{},
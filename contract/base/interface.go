@@ -24,6 +24,8 @@ type KContext interface {
 	ResourceLimit() Limits
 
 	Call(module, contract, method string, args map[string][]byte) (*Response, error)
+	// CallWithLimits 调用合约，被调合约可用的资源不超过limits
+	CallWithLimits(module, contract, method string, args map[string][]byte, limits Limits) (*Response, error)
 
 	// 合约异步事件调用
 	EmitAsyncTask(event string, args interface{}) error
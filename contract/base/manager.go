@@ -38,8 +38,8 @@ type ChainCore interface {
 	GetAccountAddresses(accountName string) ([]string, error)
 	// VerifyContractPermission verify permission of calling contract
 	VerifyContractPermission(initiator string, authRequire []string, contractName, methodName string) (bool, error)
-	// VerifyContractOwnerPermission verify contract ownership permisson
-	VerifyContractOwnerPermission(contractName string, authRequire []string) error
+	// VerifyContractOwnerPermission verify contract ownership permisson, req is the kernel invoke which may refer to a passed proposal
+	VerifyContractOwnerPermission(contractName string, authRequire []string, initiator string, req *protos.InvokeRequest) error
 	// QueryTransaction query confirmed tx
 	QueryTransaction(txid []byte) (*protos.Transaction, error)
 	// QueryBlock query block
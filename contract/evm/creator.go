@@ -25,16 +25,27 @@ const (
 )
 
 type evmCreator struct {
-	vm *evm.EVM
+	vm      *evm.EVM
+	syscall *bridge.SyscallService
 }
 
 func newEvmCreator(config *bridge.InstanceCreatorConfig) (bridge.InstanceCreator, error) {
-	opt := engine.Options{}
+	natives, err := NewNatives()
+	if err != nil {
+		return nil, err
+	}
+	opt := engine.Options{
+		Natives: natives,
+	}
 
 	vm := evm.New(opt)
-	return &evmCreator{
+	creator := &evmCreator{
 		vm: vm,
-	}, nil
+	}
+	if config != nil {
+		creator.syscall = config.SyscallService
+	}
+	return creator, nil
 }
 
 // CreateInstance instances an evm virtual machine instance which can run a single contract call
@@ -47,6 +58,7 @@ func (e *evmCreator) CreateInstance(ctx *bridge.Context, cp bridge.ContractCodeP
 		state:      state,
 		blockState: blockState,
 		cp:         cp,
+		syscall:    e.syscall,
 		fromCache:  ctx.ReadFromCache,
 	}, nil
 }
@@ -60,6 +72,7 @@ type evmInstance struct {
 	state      *stateManager
 	blockState *blockStateManager
 	cp         bridge.ContractCodeProvider
+	syscall    *bridge.SyscallService
 	code       []byte
 	abi        []byte
 	gasUsed    uint64
@@ -126,7 +139,15 @@ func (e *evmInstance) Exec() error {
 	input := []byte{}
 	jsonEncoded, ok := e.ctx.Args[evmParamJSONEncoded]
 	if !ok || string(jsonEncoded) != "true" {
-		input = e.ctx.Args[evmInput]
+		var hasInput bool
+		input, hasInput = e.ctx.Args[evmInput]
+		// 其他合约跨虚拟机调用时直接传入参数名到参数值的映射，根据部署时存储的 abi 编码。
+		if !hasInput && len(e.ctx.Args) > 0 {
+			needDecodeResp = true
+			if input, err = e.encodeNamedInput(); err != nil {
+				return err
+			}
+		}
 	} else {
 		needDecodeResp = true
 		if input, err = e.encodeInvokeInput(); err != nil {
@@ -142,6 +163,12 @@ func (e *evmInstance) Exec() error {
 			return fmt.Errorf("get evm value error")
 		}
 	}
+	// 以太坊钱包签名的交易，需与本次调用一致并按nonce防重放
+	if ethTx, ok := e.ctx.Args[EthTxArg]; ok {
+		if err := e.checkEthTx(ethTx, caller, input, value); err != nil {
+			return err
+		}
+	}
 	params := engine.CallParams{
 		CallType: exec.CallTypeCode,
 		Caller:   caller,
@@ -396,3 +423,17 @@ func (e *evmInstance) encodeInvokeInput() ([]byte, error) {
 
 	return input, nil
 }
+
+func (e *evmInstance) encodeNamedInput() ([]byte, error) {
+	args := make(map[string]interface{}, len(e.ctx.Args))
+	for k, v := range e.ctx.Args {
+		args[k] = string(v)
+	}
+
+	enc, err := New(e.abi)
+	if err != nil {
+		return nil, err
+	}
+
+	return enc.Encode(e.ctx.Method, args)
+}
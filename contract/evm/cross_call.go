@@ -0,0 +1,152 @@
+package evm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/native"
+	"github.com/wooyang2018/corechain/contract/base"
+	"github.com/wooyang2018/corechain/protos"
+)
+
+// ContractCallAddress 跨虚拟机合约调用的预编译合约地址
+// 输入为abi.encode(string module, string contract, string method, string[] keys, bytes[] values)
+// 输出为abi.encode(uint256 status, bytes body)
+var ContractCallAddress = leftPadAddress(0x01, 0x10)
+
+// 跨合约调用的基础消耗，被调合约的资源消耗通过SubResourceUsed计入当前合约
+var contractCallBaseCost = base.Limits{Cpu: 700}
+
+var contractCallOutputs = mustArguments(`[{"name":"status","type":"uint256"},{"name":"body","type":"bytes"}]`)
+
+var errNoContractCaller = errors.New("cross vm call unsupported in current context")
+
+// contractCall 由evm合约发起，通过SyscallService调用任意模块的合约
+func contractCall(ctx native.Context) ([]byte, error) {
+	instance, ok := ctx.State.EventSink.(*evmInstance)
+	if !ok || instance.syscall == nil {
+		return nil, errNoContractCaller
+	}
+	if err := useGas(ctx.Gas, contractCallBaseCost, base.Limits{}, 0); err != nil {
+		return nil, err
+	}
+
+	request, err := unpackContractCallRequest(ctx.Input)
+	if err != nil {
+		return nil, err
+	}
+	request.Header = &protos.SyscallHeader{
+		Ctxid: instance.ctx.ID,
+	}
+	resp, err := instance.syscall.ContractCall(context.TODO(), request)
+	if err != nil {
+		return nil, err
+	}
+	return abi.Pack(contractCallOutputs, uint64(resp.Response.GetStatus()), resp.Response.GetBody())
+}
+
+func unpackContractCallRequest(input []byte) (*protos.ContractCallRequest, error) {
+	var fields [3][]byte
+	for i := range fields {
+		field, err := unpackDynamicBytes(input, i*binary.Word256Bytes)
+		if err != nil {
+			return nil, err
+		}
+		fields[i] = field
+	}
+	keys, err := unpackDynamicArray(input, 3*binary.Word256Bytes)
+	if err != nil {
+		return nil, err
+	}
+	values, err := unpackDynamicArray(input, 4*binary.Word256Bytes)
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) != len(values) {
+		return nil, fmt.Errorf("args keys and values length mismatch")
+	}
+
+	request := &protos.ContractCallRequest{
+		Module:   string(fields[0]),
+		Contract: string(fields[1]),
+		Method:   string(fields[2]),
+	}
+	if request.Module == "" || request.Contract == "" || request.Method == "" {
+		return nil, fmt.Errorf("module, contract and method can not be empty")
+	}
+	for i := range keys {
+		request.Args = append(request.Args, &protos.ArgPair{
+			Key:   string(keys[i]),
+			Value: values[i],
+		})
+	}
+	return request, nil
+}
+
+// burrow的abi库不能正确解码string[]和bytes[]等动态类型数组，这里按照abi规范手动解码
+
+// unpackDynamicBytes 解码head位置偏移量所指向的string或bytes
+func unpackDynamicBytes(data []byte, head int) ([]byte, error) {
+	offset, err := readWordAsInt(data, head)
+	if err != nil {
+		return nil, err
+	}
+	return readBytesAt(data, offset)
+}
+
+// unpackDynamicArray 解码head位置偏移量所指向的string[]或bytes[]
+func unpackDynamicArray(data []byte, head int) ([][]byte, error) {
+	offset, err := readWordAsInt(data, head)
+	if err != nil {
+		return nil, err
+	}
+	length, err := readWordAsInt(data, offset)
+	if err != nil {
+		return nil, err
+	}
+	// 数组元素的偏移量相对于长度字段之后的位置
+	start := offset + binary.Word256Bytes
+	if length > (len(data)-start)/binary.Word256Bytes {
+		return nil, fmt.Errorf("array length %d out of range", length)
+	}
+	elems := make([][]byte, 0, length)
+	for i := 0; i < length; i++ {
+		elemOffset, err := readWordAsInt(data, start+i*binary.Word256Bytes)
+		if err != nil {
+			return nil, err
+		}
+		elem, err := readBytesAt(data, start+elemOffset)
+		if err != nil {
+			return nil, err
+		}
+		elems = append(elems, elem)
+	}
+	return elems, nil
+}
+
+func readBytesAt(data []byte, pos int) ([]byte, error) {
+	length, err := readWordAsInt(data, pos)
+	if err != nil {
+		return nil, err
+	}
+	start := pos + binary.Word256Bytes
+	if length > len(data)-start {
+		return nil, fmt.Errorf("bytes length %d out of range", length)
+	}
+	return data[start : start+length], nil
+}
+
+func readWordAsInt(data []byte, pos int) (int, error) {
+	if pos < 0 || pos > len(data)-binary.Word256Bytes {
+		return 0, fmt.Errorf("abi data offset %d out of range", pos)
+	}
+	n := new(big.Int).SetBytes(data[pos : pos+binary.Word256Bytes])
+	if !n.IsInt64() || n.Int64() > int64(len(data)) {
+		return 0, fmt.Errorf("abi data value %s out of range", n)
+	}
+	return int(n.Int64()), nil
+}
@@ -0,0 +1,113 @@
+package evm
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/native"
+	"github.com/wooyang2018/corechain/contract/bridge"
+)
+
+// encodeContractCall 按照solidity的abi.encode规则编码，burrow的abi.Pack对动态类型数组的编码不符合规范
+func encodeContractCall(module, contract, method string, keys []string, values [][]byte) []byte {
+	word := func(n int) []byte {
+		return binary.LeftPadBytes(big.NewInt(int64(n)).Bytes(), binary.Word256Bytes)
+	}
+	encBytes := func(b []byte) []byte {
+		padded := make([]byte, (len(b)+binary.Word256Bytes-1)/binary.Word256Bytes*binary.Word256Bytes)
+		copy(padded, b)
+		return append(word(len(b)), padded...)
+	}
+	encArray := func(elems [][]byte) []byte {
+		var head, tail []byte
+		for _, elem := range elems {
+			head = append(head, word(len(elems)*binary.Word256Bytes+len(tail))...)
+			tail = append(tail, encBytes(elem)...)
+		}
+		return append(append(word(len(elems)), head...), tail...)
+	}
+
+	var keyBytes [][]byte
+	for _, k := range keys {
+		keyBytes = append(keyBytes, []byte(k))
+	}
+	tails := [][]byte{encBytes([]byte(module)), encBytes([]byte(contract)), encBytes([]byte(method)),
+		encArray(keyBytes), encArray(values)}
+	var head, tail []byte
+	for _, t := range tails {
+		head = append(head, word(len(tails)*binary.Word256Bytes+len(tail))...)
+		tail = append(tail, t...)
+	}
+	return append(head, tail...)
+}
+
+func TestUnpackContractCallRequest(t *testing.T) {
+	input := encodeContractCall("native", "counter", "increase",
+		[]string{"key", "amount"}, [][]byte{[]byte("xuper"), []byte("10")})
+	request, err := unpackContractCallRequest(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if request.Module != "native" || request.Contract != "counter" || request.Method != "increase" {
+		t.Fatalf("unexpected request %v", request)
+	}
+	if len(request.Args) != 2 || request.Args[1].Key != "amount" || !bytes.Equal(request.Args[1].Value, []byte("10")) {
+		t.Fatalf("unexpected args %v", request.Args)
+	}
+
+	// 截断的输入应当报错而不是越界
+	if _, err := unpackContractCallRequest(input[:len(input)-40]); err == nil {
+		t.Error("expect error for truncated input")
+	}
+
+	input = encodeContractCall("native", "counter", "increase", []string{"key"}, nil)
+	if _, err := unpackContractCallRequest(input); err == nil {
+		t.Error("expect error for mismatched keys and values")
+	}
+}
+
+func TestContractCallWithoutBridge(t *testing.T) {
+	ctx := native.Context{
+		CallParams: engine.CallParams{
+			Gas: big.NewInt(10000),
+		},
+	}
+	if _, err := contractCall(ctx); err != errNoContractCaller {
+		t.Errorf("expect errNoContractCaller, got %v", err)
+	}
+}
+
+func TestEncodeNamedInput(t *testing.T) {
+	ei := &evmInstance{
+		ctx: &bridge.Context{
+			ContractName: "contractName",
+			Method:       "store",
+			Args:         map[string][]byte{"num": []byte("5")},
+		},
+		abi: []byte(`[{"constant":false,"inputs":[{"internalType":"uint256","name":"num","type":"uint256"}],"name":"store","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"}]`),
+	}
+
+	input, err := ei.encodeNamedInput()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect, err := New(ei.abi)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := expect.Encode("store", map[string]interface{}{"num": "5"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(input, want) {
+		t.Errorf("expect %x, got %x", want, input)
+	}
+
+	ei.ctx.Args = map[string][]byte{"other": []byte("5")}
+	if _, err := ei.encodeNamedInput(); err == nil {
+		t.Error("expect error for missing arg")
+	}
+}
@@ -0,0 +1,324 @@
+package evm
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/wooyang2018/corechain/contract/sandbox"
+)
+
+// 以太坊钱包签名的交易映射到evm合约调用时使用的保留字段
+const (
+	// EthTxArg 调用参数中携带原始RLP交易
+	EthTxArg = "$eth_tx"
+	// EthSignPublicKey 发起人签名的公钥字段为该值时，签名字段为原始RLP交易
+	EthSignPublicKey = "ethereum"
+	// 以太坊地址的nonce，合约名中不允许出现$，不会与合约存储冲突
+	ethNonceBucket = "$eth_nonce"
+)
+
+var (
+	ErrInvalidRLP      = errors.New("invalid rlp encoding")
+	ErrTypedEthTx      = errors.New("typed ethereum transaction unsupported")
+	ErrInvalidEthSign  = errors.New("invalid ethereum transaction signature")
+	ErrEthNonceInvalid = errors.New("ethereum transaction nonce invalid")
+)
+
+var (
+	secp256k1N     = btcec.Params().N
+	secp256k1HalfN = new(big.Int).Rsh(secp256k1N, 1)
+)
+
+// EthTx legacy或EIP-155格式的以太坊交易
+type EthTx struct {
+	Nonce    uint64
+	GasPrice *big.Int
+	GasLimit uint64
+	To       []byte
+	Value    *big.Int
+	Data     []byte
+	V, R, S  *big.Int
+
+	raw []byte
+}
+
+// DecodeEthTx 解析RLP编码的legacy交易，不支持EIP-2718类型交易
+func DecodeEthTx(raw []byte) (*EthTx, error) {
+	if len(raw) > 0 && raw[0] <= 0x7f {
+		return nil, ErrTypedEthTx
+	}
+	items, err := decodeRLPList(raw)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) != 9 {
+		return nil, fmt.Errorf("%w: expect 9 fields, got %d", ErrInvalidRLP, len(items))
+	}
+
+	tx := &EthTx{raw: append([]byte(nil), raw...)}
+	if tx.Nonce, err = rlpUint64(items[0]); err != nil {
+		return nil, err
+	}
+	if tx.GasPrice, err = rlpBigInt(items[1]); err != nil {
+		return nil, err
+	}
+	if tx.GasLimit, err = rlpUint64(items[2]); err != nil {
+		return nil, err
+	}
+	if len(items[3]) != 0 && len(items[3]) != crypto.AddressLength {
+		return nil, fmt.Errorf("%w: invalid to address", ErrInvalidRLP)
+	}
+	tx.To = items[3]
+	if tx.Value, err = rlpBigInt(items[4]); err != nil {
+		return nil, err
+	}
+	tx.Data = items[5]
+	if tx.V, err = rlpBigInt(items[6]); err != nil {
+		return nil, err
+	}
+	if tx.R, err = rlpBigInt(items[7]); err != nil {
+		return nil, err
+	}
+	if tx.S, err = rlpBigInt(items[8]); err != nil {
+		return nil, err
+	}
+	// v为27、28，或者EIP-155的chainID*2+35+{0,1}
+	if v := tx.V; v.Cmp(big.NewInt(27)) < 0 || (v.Cmp(big.NewInt(28)) > 0 && v.Cmp(big.NewInt(37)) < 0) {
+		return nil, ErrInvalidEthSign
+	}
+	return tx, nil
+}
+
+// Raw 原始RLP编码
+func (tx *EthTx) Raw() []byte {
+	return tx.raw
+}
+
+// Hash 以太坊交易哈希
+func (tx *EthTx) Hash() []byte {
+	return crypto.Keccak256(tx.raw)
+}
+
+// ChainID EIP-155交易签名绑定的链id，未绑定链id的交易返回nil
+func (tx *EthTx) ChainID() *big.Int {
+	if tx.V.BitLen() <= 8 && (tx.V.Uint64() == 27 || tx.V.Uint64() == 28) {
+		return nil
+	}
+	id := new(big.Int).Sub(tx.V, big.NewInt(35))
+	return id.Rsh(id, 1)
+}
+
+// SigningHash 签名的交易摘要
+func (tx *EthTx) SigningHash() []byte {
+	items := [][]byte{
+		encodeRLPUint64(tx.Nonce),
+		encodeRLPBytes(tx.GasPrice.Bytes()),
+		encodeRLPUint64(tx.GasLimit),
+		encodeRLPBytes(tx.To),
+		encodeRLPBytes(tx.Value.Bytes()),
+		encodeRLPBytes(tx.Data),
+	}
+	if chainID := tx.ChainID(); chainID != nil {
+		items = append(items, encodeRLPBytes(chainID.Bytes()), encodeRLPBytes(nil), encodeRLPBytes(nil))
+	}
+	return crypto.Keccak256(encodeRLPList(items))
+}
+
+// Sender 从签名中恢复发送者地址
+func (tx *EthTx) Sender() (crypto.Address, error) {
+	var recID uint64
+	if tx.ChainID() == nil {
+		recID = tx.V.Uint64() - 27
+	} else {
+		recID = uint64(new(big.Int).Sub(tx.V, big.NewInt(35)).Bit(0))
+	}
+	// 与以太坊一致只接受low-s签名，避免同一交易存在两个合法签名
+	if tx.R.Sign() <= 0 || tx.S.Sign() <= 0 || tx.R.Cmp(secp256k1N) >= 0 || tx.S.Cmp(secp256k1HalfN) > 0 {
+		return crypto.ZeroAddress, ErrInvalidEthSign
+	}
+
+	sig := make([]byte, 65)
+	sig[0] = byte(27 + recID)
+	tx.R.FillBytes(sig[1:33])
+	tx.S.FillBytes(sig[33:])
+	pub, _, err := ecdsa.RecoverCompact(sig, tx.SigningHash())
+	if err != nil {
+		return crypto.ZeroAddress, fmt.Errorf("%w: %v", ErrInvalidEthSign, err)
+	}
+	return EthAddressFromPublicKey(pub), nil
+}
+
+// EthAddressFromPublicKey 以太坊地址为未压缩公钥keccak256哈希的后20字节
+func EthAddressFromPublicKey(pub *btcec.PublicKey) crypto.Address {
+	var addr crypto.Address
+	copy(addr[:], crypto.Keccak256(pub.SerializeUncompressed()[1:])[12:])
+	return addr
+}
+
+// checkEthTx 校验调用携带的以太坊交易与本次调用一致，并消耗发送者的nonce
+// 交易的发送者映射为发起人，nonce的读写集保证同一交易只能上链一次
+func (e *evmInstance) checkEthTx(raw []byte, caller crypto.Address, input []byte, value *big.Int) error {
+	tx, err := DecodeEthTx(raw)
+	if err != nil {
+		return err
+	}
+	sender, err := tx.Sender()
+	if err != nil {
+		return err
+	}
+	if sender != caller || string(tx.Data) != string(input) || tx.Value.Cmp(value) != 0 {
+		return fmt.Errorf("ethereum transaction does not match the contract call")
+	}
+
+	buf, err := e.ctx.State.Get(ethNonceBucket, sender.Bytes())
+	if err != nil && err != sandbox.ErrNotFound {
+		return err
+	}
+	nonce := DecodeEthNonce(buf)
+	if tx.Nonce != nonce {
+		return fmt.Errorf("%w: expect %d, got %d", ErrEthNonceInvalid, nonce, tx.Nonce)
+	}
+	return e.ctx.State.Put(ethNonceBucket, sender.Bytes(), EncodeEthNonce(nonce+1))
+}
+
+// EthNonceKey 以太坊地址的nonce在状态中的bucket和key
+func EthNonceKey(addr crypto.Address) (string, []byte) {
+	return ethNonceBucket, addr.Bytes()
+}
+
+// DecodeEthNonce 未记录nonce的地址从0开始
+func DecodeEthNonce(buf []byte) uint64 {
+	if len(buf) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(buf)
+}
+
+// EncodeEthNonce nonce使用8字节大端编码存储
+func EncodeEthNonce(nonce uint64) []byte {
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, nonce)
+	return buf
+}
+
+// decodeRLPList 解析只包含字符串元素的RLP列表，拒绝非规范编码和多余的字节
+func decodeRLPList(buf []byte) ([][]byte, error) {
+	content, rest, isList, err := splitRLP(buf)
+	if err != nil {
+		return nil, err
+	}
+	if !isList || len(rest) != 0 {
+		return nil, ErrInvalidRLP
+	}
+	var items [][]byte
+	for len(content) > 0 {
+		var item []byte
+		item, content, isList, err = splitRLP(content)
+		if err != nil {
+			return nil, err
+		}
+		if isList {
+			return nil, ErrInvalidRLP
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// splitRLP 拆分第一个RLP元素，返回其内容和剩余字节
+func splitRLP(buf []byte) (content, rest []byte, isList bool, err error) {
+	if len(buf) == 0 {
+		return nil, nil, false, ErrInvalidRLP
+	}
+	prefix := buf[0]
+	var offset, size uint64
+	switch {
+	case prefix < 0x80:
+		return buf[:1], buf[1:], false, nil
+	case prefix < 0xb8:
+		offset, size = 1, uint64(prefix-0x80)
+		// 单个小于0x80的字节必须直接编码
+		if size == 1 && (len(buf) < 2 || buf[1] < 0x80) {
+			return nil, nil, false, ErrInvalidRLP
+		}
+	case prefix < 0xc0:
+		offset, size, err = rlpLongSize(buf, int(prefix-0xb7))
+	case prefix < 0xf8:
+		offset, size, isList = 1, uint64(prefix-0xc0), true
+	default:
+		offset, size, err = rlpLongSize(buf, int(prefix-0xf7))
+		isList = true
+	}
+	if err != nil {
+		return nil, nil, false, err
+	}
+	if size > uint64(len(buf))-offset {
+		return nil, nil, false, ErrInvalidRLP
+	}
+	return buf[offset : offset+size], buf[offset+size:], isList, nil
+}
+
+func rlpLongSize(buf []byte, lenOfLen int) (uint64, uint64, error) {
+	if len(buf) < 1+lenOfLen || buf[1] == 0 {
+		return 0, 0, ErrInvalidRLP
+	}
+	var size uint64
+	for _, b := range buf[1 : 1+lenOfLen] {
+		size = size<<8 | uint64(b)
+	}
+	// 长度不超过55时必须使用短格式
+	if size < 56 {
+		return 0, 0, ErrInvalidRLP
+	}
+	return uint64(1 + lenOfLen), size, nil
+}
+
+func rlpUint64(buf []byte) (uint64, error) {
+	if len(buf) > 8 || (len(buf) > 0 && buf[0] == 0) {
+		return 0, ErrInvalidRLP
+	}
+	var v uint64
+	for _, b := range buf {
+		v = v<<8 | uint64(b)
+	}
+	return v, nil
+}
+
+func rlpBigInt(buf []byte) (*big.Int, error) {
+	if len(buf) > 32 || (len(buf) > 0 && buf[0] == 0) {
+		return nil, ErrInvalidRLP
+	}
+	return new(big.Int).SetBytes(buf), nil
+}
+
+func encodeRLPUint64(v uint64) []byte {
+	return encodeRLPBytes(new(big.Int).SetUint64(v).Bytes())
+}
+
+func encodeRLPBytes(buf []byte) []byte {
+	if len(buf) == 1 && buf[0] < 0x80 {
+		return []byte{buf[0]}
+	}
+	return append(encodeRLPLength(len(buf), 0x80), buf...)
+}
+
+func encodeRLPList(items [][]byte) []byte {
+	var content []byte
+	for _, item := range items {
+		content = append(content, item...)
+	}
+	return append(encodeRLPLength(len(content), 0xc0), content...)
+}
+
+func encodeRLPLength(size int, offset byte) []byte {
+	if size < 56 {
+		return []byte{offset + byte(size)}
+	}
+	sizeBuf := new(big.Int).SetInt64(int64(size)).Bytes()
+	return append([]byte{offset + 55 + byte(len(sizeBuf))}, sizeBuf...)
+}
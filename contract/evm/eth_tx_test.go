@@ -0,0 +1,140 @@
+package evm
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/wooyang2018/corechain/contract/base"
+	"github.com/wooyang2018/corechain/contract/bridge"
+	"github.com/wooyang2018/corechain/contract/sandbox"
+)
+
+// EIP-155规范中的示例交易
+const eip155ExampleTx = "f86c098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a7640000" +
+	"8025a028ef61340bd939bc2195fe537567866003e1a15d3c71ff63e1590620aa636276a067cbe9d8997f761aecb7" +
+	"03304b3800ccf555c9f3dc64214b297fb1966a3b6d83"
+
+func signEthTx(t *testing.T, key *btcec.PrivateKey, tx *EthTx, chainID int64) []byte {
+	tx.V = big.NewInt(chainID*2 + 35)
+	sig, err := ecdsa.SignCompact(key, tx.SigningHash(), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx.V.Add(tx.V, big.NewInt(int64(sig[0]-27)))
+	tx.R = new(big.Int).SetBytes(sig[1:33])
+	tx.S = new(big.Int).SetBytes(sig[33:])
+	return encodeRLPList([][]byte{
+		encodeRLPUint64(tx.Nonce),
+		encodeRLPBytes(tx.GasPrice.Bytes()),
+		encodeRLPUint64(tx.GasLimit),
+		encodeRLPBytes(tx.To),
+		encodeRLPBytes(tx.Value.Bytes()),
+		encodeRLPBytes(tx.Data),
+		encodeRLPBytes(tx.V.Bytes()),
+		encodeRLPBytes(tx.R.Bytes()),
+		encodeRLPBytes(tx.S.Bytes()),
+	})
+}
+
+func TestDecodeEthTx(t *testing.T) {
+	raw, _ := hex.DecodeString(eip155ExampleTx)
+	tx, err := DecodeEthTx(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx.Nonce != 9 || tx.GasLimit != 21000 || tx.ChainID().Int64() != 1 {
+		t.Errorf("unexpected tx %+v", tx)
+	}
+	sender, err := tx.Sender()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect, _ := crypto.AddressFromHexString("9d8a62f656a8d1615c1294fd71e9cfb3e4855a4f")
+	if sender != expect {
+		t.Errorf("expect sender %s, got %s", expect, sender)
+	}
+
+	// 修改签名内容后恢复出的发送者不同
+	tx.Nonce++
+	if other, err := tx.Sender(); err == nil && other == expect {
+		t.Error("sender should change with the signed content")
+	}
+
+	for _, raw := range []string{
+		"02f8720181",
+		eip155ExampleTx + "00",
+		eip155ExampleTx[:len(eip155ExampleTx)-2],
+		"c3810501",
+		"c0",
+	} {
+		buf, _ := hex.DecodeString(raw)
+		if _, err := DecodeEthTx(buf); err == nil {
+			t.Errorf("raw tx %s should be rejected", raw)
+		}
+	}
+}
+
+func TestCheckEthTx(t *testing.T) {
+	key, err := btcec.NewPrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sender := EthAddressFromPublicKey(key.PubKey())
+	to, _ := ContractNameToEVMAddress("counter")
+	input := []byte{0x37, 0x13, 0x03, 0xc0}
+	signed := func(nonce uint64) []byte {
+		return signEthTx(t, key, &EthTx{
+			Nonce:    nonce,
+			GasPrice: big.NewInt(1),
+			GasLimit: 100000,
+			To:       to.Bytes(),
+			Value:    big.NewInt(10),
+			Data:     input,
+		}, 1337)
+	}
+
+	state := sandbox.NewXModelCache(&base.SandboxConfig{XMReader: sandbox.NewMemXModel()})
+	e := &evmInstance{ctx: &bridge.Context{State: state}}
+	value := big.NewInt(10)
+
+	raw := signed(0)
+	tx, err := DecodeEthTx(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx.ChainID().Int64() != 1337 {
+		t.Errorf("expect chain id 1337, got %s", tx.ChainID())
+	}
+	if err := e.checkEthTx(raw, sender, input, value); err != nil {
+		t.Fatal(err)
+	}
+	// 重放同一交易
+	if err := e.checkEthTx(raw, sender, input, value); !errors.Is(err, ErrEthNonceInvalid) {
+		t.Errorf("expect nonce error, got %v", err)
+	}
+	if err := e.checkEthTx(signed(1), sender, input, value); err != nil {
+		t.Fatal(err)
+	}
+
+	// 调用者、参数或转账金额与签名不一致
+	raw = signed(2)
+	if err := e.checkEthTx(raw, crypto.Address{1}, input, value); err == nil {
+		t.Error("caller mismatch should fail")
+	}
+	if err := e.checkEthTx(raw, sender, []byte{0x01}, value); err == nil {
+		t.Error("input mismatch should fail")
+	}
+	if err := e.checkEthTx(raw, sender, input, big.NewInt(11)); err == nil {
+		t.Error("value mismatch should fail")
+	}
+
+	buf, err := state.Get(EthNonceKey(sender))
+	if err != nil || DecodeEthNonce(buf) != 2 {
+		t.Errorf("expect nonce 2, got %x %v", buf, err)
+	}
+}
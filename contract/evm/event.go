@@ -0,0 +1,83 @@
+package evm
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/wooyang2018/corechain/protos"
+)
+
+// PackEventFromAbi 根据合约abi将合约事件还原为evm日志的topics和data，是unpackEventFromAbi的逆过程
+func PackEventFromAbi(abiByte []byte, event *protos.ContractEvent) ([]binary.Word256, []byte, error) {
+	spec, err := abi.ReadSpec(abiByte)
+	if err != nil {
+		return nil, nil, err
+	}
+	eventSpec, ok := spec.EventsByName[event.GetName()]
+	if !ok {
+		return nil, nil, fmt.Errorf("event %s not found in abi", event.GetName())
+	}
+
+	var fields []json.RawMessage
+	if err := json.Unmarshal(event.GetBody(), &fields); err != nil {
+		return nil, nil, err
+	}
+	if len(fields) != len(eventSpec.Inputs) {
+		return nil, nil, fmt.Errorf("event %s expect %d fields, got %d",
+			event.GetName(), len(eventSpec.Inputs), len(fields))
+	}
+
+	var uint8type = reflect.TypeOf((*[]uint8)(nil))
+	vals := abi.GetPackingTypes(eventSpec.Inputs)
+	args := make([]interface{}, len(vals))
+	for i, input := range eventSpec.Inputs {
+		if input.IsArray {
+			return nil, nil, fmt.Errorf("event %s array field unsupported", event.GetName())
+		}
+		// bytes类型在unpackEventFromAbi中被编码为hex字符串
+		if reflect.TypeOf(vals[i]) == uint8type {
+			var s string
+			if err := json.Unmarshal(fields[i], &s); err != nil {
+				return nil, nil, err
+			}
+			buf, err := hex.DecodeString(s)
+			if err != nil {
+				return nil, nil, err
+			}
+			args[i] = buf
+			continue
+		}
+		if err := json.Unmarshal(fields[i], vals[i]); err != nil {
+			return nil, nil, err
+		}
+		if n, ok := vals[i].(*big.Int); ok {
+			args[i] = n.String()
+		} else {
+			args[i] = reflect.ValueOf(vals[i]).Elem().Interface()
+		}
+	}
+	return abi.PackEvent(eventSpec, args...)
+}
+
+// MethodFromAbi 根据调用数据中的函数选择器查找合约abi中对应的方法名
+func MethodFromAbi(abiByte []byte, input []byte) (string, error) {
+	if len(input) < abi.FunctionIDSize {
+		return "", fmt.Errorf("input too short to contain function id")
+	}
+	spec, err := abi.ReadSpec(abiByte)
+	if err != nil {
+		return "", err
+	}
+	for name, fn := range spec.Functions {
+		if bytes.Equal(fn.FunctionID[:], input[:abi.FunctionIDSize]) {
+			return name, nil
+		}
+	}
+	return "", fmt.Errorf("function id %x not found in abi", input[:abi.FunctionIDSize])
+}
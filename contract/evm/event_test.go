@@ -0,0 +1,75 @@
+package evm
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/exec"
+)
+
+const eventTestAbi = `[{"anonymous":false,"inputs":[{"indexed":true,"name":"from","type":"address"},{"indexed":false,"name":"value","type":"uint256"},{"indexed":false,"name":"memo","type":"string"},{"indexed":false,"name":"data","type":"bytes"}],"name":"Transfer","type":"event"},{"constant":false,"inputs":[{"name":"num","type":"uint256"}],"name":"store","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"}]`
+
+func TestPackEventFromAbi(t *testing.T) {
+	spec, err := abi.ReadSpec([]byte(eventTestAbi))
+	if err != nil {
+		t.Fatal(err)
+	}
+	from, err := ContractNameToEVMAddress("counter")
+	if err != nil {
+		t.Fatal(err)
+	}
+	topics, data, err := abi.PackEvent(spec.EventsByName["Transfer"], from, "100", "hello", []byte{0x01, 0xab})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	event, err := unpackEventFromAbi([]byte(eventTestAbi), "counter", &exec.LogEvent{
+		Address: crypto.Address{},
+		Topics:  topics,
+		Data:    data,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotTopics, gotData, err := PackEventFromAbi([]byte(eventTestAbi), event)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(gotTopics) != len(topics) {
+		t.Fatalf("expect %d topics, got %d", len(topics), len(gotTopics))
+	}
+	for i := range topics {
+		if gotTopics[i] != topics[i] {
+			t.Errorf("topic %d mismatch, expect %x got %x", i, topics[i], gotTopics[i])
+		}
+	}
+	if !bytes.Equal(gotData, data) {
+		t.Errorf("data mismatch, expect %x got %x", data, gotData)
+	}
+}
+
+func TestMethodFromAbi(t *testing.T) {
+	spec, err := abi.ReadSpec([]byte(eventTestAbi))
+	if err != nil {
+		t.Fatal(err)
+	}
+	input, _, err := spec.Pack("store", "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	method, err := MethodFromAbi([]byte(eventTestAbi), input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if method != "store" {
+		t.Errorf("expect store, got %s", method)
+	}
+
+	if _, err := MethodFromAbi([]byte(eventTestAbi), []byte{0x01, 0x02, 0x03, 0x04}); err == nil {
+		t.Error("expect error for unknown function id")
+	}
+}
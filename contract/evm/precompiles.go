@@ -0,0 +1,296 @@
+package evm
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/backend/groth16"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/native"
+	"github.com/hyperledger/burrow/permission"
+	"github.com/wooyang2018/corechain/contract/base"
+	cryptoClient "github.com/wooyang2018/corechain/crypto/client"
+	cryptoBase "github.com/wooyang2018/corechain/crypto/client/base"
+	"github.com/wooyang2018/corechain/crypto/common/account"
+	cryptoTypes "github.com/wooyang2018/corechain/crypto/common/types"
+	"github.com/wooyang2018/corechain/crypto/core/gmsm/sm2"
+	"github.com/wooyang2018/corechain/crypto/core/gmsm/sm3"
+	"github.com/wooyang2018/corechain/crypto/core/hash"
+	"github.com/wooyang2018/corechain/crypto/core/zkp/hash/mimc"
+)
+
+// 链原生密码学算法的预编译合约地址，与以太坊标准预编译合约(0x01-0x09)和burrow的keccak256(0x14)区分开
+var (
+	XuperSignVerifyAddress = leftPadAddress(0x01, 0x01)
+	SM3HashAddress         = leftPadAddress(0x01, 0x02)
+	SM2VerifyAddress       = leftPadAddress(0x01, 0x03)
+	RingSignVerifyAddress  = leftPadAddress(0x01, 0x04)
+	MiMCHashAddress        = leftPadAddress(0x01, 0x05)
+	MiMCVerifyAddress      = leftPadAddress(0x01, 0x06)
+)
+
+// 预编译合约的资源消耗，evm中的gas即为Cpu资源
+// 签名验证在解析输入前先按输入长度收取基础消耗，避免超长的公钥列表免费消耗解码资源
+var (
+	sigVerifyBaseCost    = base.Limits{Cpu: 3000}
+	sigVerifyPerWordCost = base.Limits{Cpu: 30}
+	sigVerifyPerKeyCost  = base.Limits{Cpu: 3000}
+	sm3BaseCost          = base.Limits{Cpu: 60}
+	sm3PerWordCost       = base.Limits{Cpu: 12}
+	mimcHashBaseCost     = base.Limits{Cpu: 200}
+	mimcHashPerWordCost  = base.Limits{Cpu: 50}
+	mimcVerifyCost       = base.Limits{Cpu: 200000}
+)
+
+// 预编译合约的输入参数按照solidity的abi.encode编码，不包含函数选择器
+// 公钥列表为json数组，每个元素为链上json格式的公钥
+var (
+	sigVerifyInputs  = mustArguments(`[{"name":"publicKeys","type":"string"},{"name":"signature","type":"bytes"},{"name":"message","type":"bytes"}]`)
+	sm2VerifyInputs  = mustArguments(`[{"name":"publicKey","type":"string"},{"name":"signature","type":"bytes"},{"name":"message","type":"bytes"}]`)
+	mimcVerifyInputs = mustArguments(`[{"name":"proof","type":"bytes"},{"name":"verifyingKey","type":"bytes"},{"name":"hash","type":"bytes"}]`)
+)
+
+// Precompiles 链原生密码学算法及跨虚拟机调用的预编译合约
+var Precompiles = native.New().
+	MustFunction(`Verify XuperSignature including single, multi and ring signatures`,
+		XuperSignVerifyAddress,
+		permission.None,
+		xuperSignVerify).
+	MustFunction(`Compute the sm3 hash of input`,
+		SM3HashAddress,
+		permission.None,
+		sm3Hash).
+	MustFunction(`Verify sm2 signature`,
+		SM2VerifyAddress,
+		permission.None,
+		sm2Verify).
+	MustFunction(`Verify schnorr ring signature`,
+		RingSignVerifyAddress,
+		permission.None,
+		ringSignVerify).
+	MustFunction(`Compute the MiMC hash of input`,
+		MiMCHashAddress,
+		permission.None,
+		mimcHash).
+	MustFunction(`Verify groth16 proof of the MiMC hash preimage`,
+		MiMCVerifyAddress,
+		permission.None,
+		mimcProofVerify).
+	MustFunction(`Call contract of any module through xbridge`,
+		ContractCallAddress,
+		permission.None,
+		contractCall)
+
+// NewNatives 合并burrow默认的native合约和链原生的预编译合约
+func NewNatives() (*native.Natives, error) {
+	return native.Merge(native.Permissions, native.Precompiles, Precompiles)
+}
+
+type ringVerifier interface {
+	VerifySchnorrRing(keys []*ecdsa.PublicKey, sig, message []byte) (bool, error)
+}
+
+func xuperSignVerify(ctx native.Context) ([]byte, error) {
+	var keysJSON string
+	var sig, msg []byte
+	if err := useGas(ctx.Gas, sigVerifyBaseCost, sigVerifyPerWordCost, wordsIn(len(ctx.Input))); err != nil {
+		return nil, err
+	}
+	if err := abi.Unpack(sigVerifyInputs, ctx.Input, &keysJSON, &sig, &msg); err != nil {
+		return nil, err
+	}
+	client, keys, err := parsePublicKeys(keysJSON)
+	if err != nil {
+		return nil, err
+	}
+	if err := useGas(ctx.Gas, base.Limits{}, sigVerifyPerKeyCost, uint64(len(keys))); err != nil {
+		return nil, err
+	}
+
+	ok, _ := client.VerifyXuperSignature(keys, sig, msg)
+	return packBool(ok), nil
+}
+
+func sm3Hash(ctx native.Context) ([]byte, error) {
+	if err := useGas(ctx.Gas, sm3BaseCost, sm3PerWordCost, wordsIn(len(ctx.Input))); err != nil {
+		return nil, err
+	}
+	return sm3.Sm3Sum(ctx.Input), nil
+}
+
+func sm2Verify(ctx native.Context) ([]byte, error) {
+	var keyJSON string
+	var sig, msg []byte
+	if err := useGas(ctx.Gas, sigVerifyBaseCost, sigVerifyPerWordCost, wordsIn(len(ctx.Input))); err != nil {
+		return nil, err
+	}
+	if err := abi.Unpack(sm2VerifyInputs, ctx.Input, &keyJSON, &sig, &msg); err != nil {
+		return nil, err
+	}
+	_, key, err := parsePublicKey([]byte(keyJSON))
+	if err != nil {
+		return nil, err
+	}
+	if key.Curve.Params().Name != cryptoTypes.CurveGm {
+		return nil, fmt.Errorf("sm2 verify: curve %s unsupported", key.Curve.Params().Name)
+	}
+	if err := useGas(ctx.Gas, base.Limits{}, sigVerifyPerKeyCost, 1); err != nil {
+		return nil, err
+	}
+
+	// 签名为ASN.1编码的(r,s)，message为待验证的摘要
+	pub := &sm2.PublicKey{Curve: key.Curve, X: key.X, Y: key.Y}
+	return packBool(pub.Verify(msg, sig)), nil
+}
+
+func ringSignVerify(ctx native.Context) ([]byte, error) {
+	var keysJSON string
+	var sig, msg []byte
+	if err := useGas(ctx.Gas, sigVerifyBaseCost, sigVerifyPerWordCost, wordsIn(len(ctx.Input))); err != nil {
+		return nil, err
+	}
+	if err := abi.Unpack(sigVerifyInputs, ctx.Input, &keysJSON, &sig, &msg); err != nil {
+		return nil, err
+	}
+	client, keys, err := parsePublicKeys(keysJSON)
+	if err != nil {
+		return nil, err
+	}
+	verifier, ok := client.(ringVerifier)
+	if !ok {
+		return nil, fmt.Errorf("ring signature unsupported by crypto client")
+	}
+	if err := useGas(ctx.Gas, base.Limits{}, sigVerifyPerKeyCost, uint64(len(keys))); err != nil {
+		return nil, err
+	}
+
+	// 兼容统一签名格式XuperSignature和原始的环签名
+	xuperSig := new(cryptoTypes.XuperSignature)
+	if err := json.Unmarshal(sig, xuperSig); err == nil && xuperSig.SigType != "" {
+		if xuperSig.SigType != cryptoTypes.SchnorrRing {
+			return packBool(false), nil
+		}
+		sig = xuperSig.SigContent
+	}
+
+	ok, _ = verifier.VerifySchnorrRing(keys, sig, msg)
+	return packBool(ok), nil
+}
+
+func mimcHash(ctx native.Context) ([]byte, error) {
+	if err := useGas(ctx.Gas, mimcHashBaseCost, mimcHashPerWordCost, wordsIn(len(ctx.Input))); err != nil {
+		return nil, err
+	}
+	return hash.HashUsingDefaultMiMC(ctx.Input), nil
+}
+
+func mimcProofVerify(ctx native.Context) ([]byte, error) {
+	var proofBuf, vkBuf, hashResult []byte
+	if err := useGas(ctx.Gas, mimcVerifyCost, base.Limits{}, 0); err != nil {
+		return nil, err
+	}
+	if err := abi.Unpack(mimcVerifyInputs, ctx.Input, &proofBuf, &vkBuf, &hashResult); err != nil {
+		return nil, err
+	}
+
+	// mimc电路基于BLS12_381曲线编译
+	proof := groth16.NewProof(ecc.BLS12_381)
+	if _, err := proof.ReadFrom(bytes.NewReader(proofBuf)); err != nil {
+		return nil, fmt.Errorf("read proof error: %v", err)
+	}
+	vk := groth16.NewVerifyingKey(ecc.BLS12_381)
+	if _, err := vk.ReadFrom(bytes.NewReader(vkBuf)); err != nil {
+		return nil, fmt.Errorf("read verifying key error: %v", err)
+	}
+
+	ok, _ := mimc.Verify(proof, vk, hashResult)
+	return packBool(ok), nil
+}
+
+// parsePublicKeys 解析json数组格式的公钥列表，所有公钥需使用相同的曲线
+func parsePublicKeys(keysJSON string) (cryptoBase.CryptoClient, []*ecdsa.PublicKey, error) {
+	var rawKeys []json.RawMessage
+	if err := json.Unmarshal([]byte(keysJSON), &rawKeys); err != nil {
+		return nil, nil, fmt.Errorf("parse public keys error: %v", err)
+	}
+	if len(rawKeys) == 0 {
+		return nil, nil, fmt.Errorf("empty public keys")
+	}
+
+	var client cryptoBase.CryptoClient
+	keys := make([]*ecdsa.PublicKey, 0, len(rawKeys))
+	for _, rawKey := range rawKeys {
+		c, key, err := parsePublicKey(rawKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		if client == nil {
+			client = c
+		} else if key.Curve.Params().Name != keys[0].Curve.Params().Name {
+			return nil, nil, fmt.Errorf("public keys use different curves")
+		}
+		keys = append(keys, key)
+	}
+	return client, keys, nil
+}
+
+// parsePublicKey 解析json格式的公钥，国密客户端只能解析P-256曲线的公钥，SM2公钥需按SM2曲线单独解析
+func parsePublicKey(keyJSON []byte) (cryptoBase.CryptoClient, *ecdsa.PublicKey, error) {
+	client, err := cryptoClient.CreateCryptoClientFromJSONPublicKey(keyJSON)
+	if err != nil {
+		return nil, nil, err
+	}
+	jsonKey := new(account.ECDSAPublicKey)
+	if err := json.Unmarshal(keyJSON, jsonKey); err != nil {
+		return nil, nil, err
+	}
+	if jsonKey.Curvname == cryptoTypes.CurveGm {
+		curve := sm2.P256Sm2()
+		if jsonKey.X == nil || jsonKey.Y == nil || !curve.IsOnCurve(jsonKey.X, jsonKey.Y) {
+			return nil, nil, fmt.Errorf("invalid sm2 public key")
+		}
+		return client, &ecdsa.PublicKey{Curve: curve, X: jsonKey.X, Y: jsonKey.Y}, nil
+	}
+	key, err := client.GetEcdsaPublicKeyFromJsonStr(string(keyJSON))
+	if err != nil {
+		return nil, nil, err
+	}
+	return client, key, nil
+}
+
+// useGas 按基础消耗加上n倍的单位消耗扣除gas
+func useGas(gas *big.Int, baseCost, unitCost base.Limits, n uint64) error {
+	required := uint64(baseCost.Cpu) + uint64(unitCost.Cpu)*n
+	return engine.UseGasNegative(gas, required)
+}
+
+func wordsIn(n int) uint64 {
+	return uint64((n + binary.Word256Bytes - 1) / binary.Word256Bytes)
+}
+
+func packBool(ok bool) []byte {
+	res := make([]byte, binary.Word256Bytes)
+	if ok {
+		res[binary.Word256Bytes-1] = 1
+	}
+	return res
+}
+
+func leftPadAddress(bs ...byte) crypto.Address {
+	return crypto.AddressFromWord256(binary.LeftPadWord256(bs))
+}
+
+func mustArguments(inputs string) []abi.Argument {
+	spec, err := abi.ReadSpec([]byte(fmt.Sprintf(`[{"type":"function","name":"f","inputs":%s}]`, inputs)))
+	if err != nil {
+		panic(err)
+	}
+	return spec.Functions["f"].Inputs
+}
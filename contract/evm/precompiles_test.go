@@ -0,0 +1,214 @@
+package evm
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/native"
+	"github.com/wooyang2018/corechain/crypto/client/chain"
+	"github.com/wooyang2018/corechain/crypto/common/account"
+	cryptoTypes "github.com/wooyang2018/corechain/crypto/common/types"
+	"github.com/wooyang2018/corechain/crypto/core/gmsm/sm2"
+	"github.com/wooyang2018/corechain/crypto/core/gmsm/sm3"
+)
+
+func newPrecompileContext(input []byte, gas int64) native.Context {
+	return native.Context{
+		CallParams: engine.CallParams{
+			Input: input,
+			Gas:   big.NewInt(gas),
+		},
+	}
+}
+
+func TestPrecompileSM3Hash(t *testing.T) {
+	input := []byte("hello corechain")
+	ctx := newPrecompileContext(input, 1000)
+	out, err := sm3Hash(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(out, sm3.Sm3Sum(input)) {
+		t.Errorf("sm3 hash mismatch, got %x", out)
+	}
+	if ctx.Gas.Int64() != 1000-int64(sm3BaseCost.Cpu+sm3PerWordCost.Cpu) {
+		t.Errorf("unexpected gas left %s", ctx.Gas)
+	}
+
+	if _, err := sm3Hash(newPrecompileContext(input, 10)); err == nil {
+		t.Error("expect out of gas error")
+	}
+}
+
+func TestPrecompileSignVerify(t *testing.T) {
+	xcc := chain.GetInstance().(*chain.XchainCryptoClient)
+	var privKeys []*ecdsa.PrivateKey
+	var pubKeys []*ecdsa.PublicKey
+	var jsonKeys []json.RawMessage
+	for _, seed := range []string{"precompile seed one 1234567890", "precompile seed two 1234567890",
+		"precompile seed three 1234567890"} {
+		privKey, err := xcc.GenerateKeyBySeed([]byte(seed))
+		if err != nil {
+			t.Fatal(err)
+		}
+		jsonKey, err := xcc.GetEcdsaPublicKeyJsonFormatStr(privKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		privKeys = append(privKeys, privKey)
+		pubKeys = append(pubKeys, &privKey.PublicKey)
+		jsonKeys = append(jsonKeys, json.RawMessage(jsonKey))
+	}
+	keysJSON, _ := json.Marshal(jsonKeys[:1])
+	ringKeysJSON, _ := json.Marshal(jsonKeys)
+	msg := []byte("precompile message")
+
+	sig, err := xcc.SignSchnorr(privKeys[0], msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ringSig, err := xcc.SignSchnorrRing(pubKeys[:2], privKeys[2], msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name   string
+		f      func(native.Context) ([]byte, error)
+		keys   []byte
+		sig    []byte
+		msg    []byte
+		expect bool
+	}{
+		{"xuper sign", xuperSignVerify, keysJSON, sig, msg, true},
+		{"xuper sign wrong msg", xuperSignVerify, keysJSON, sig, []byte("other"), false},
+		{"ring sign", ringSignVerify, ringKeysJSON, ringSig, msg, true},
+		{"ring sign wrong msg", ringSignVerify, ringKeysJSON, ringSig, []byte("other"), false},
+	}
+	for _, c := range cases {
+		input, err := abi.Pack(sigVerifyInputs, string(c.keys), c.sig, c.msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out, err := c.f(newPrecompileContext(input, 100000))
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if !bytes.Equal(out, packBool(c.expect)) {
+			t.Errorf("%s: expect %v, got %x", c.name, c.expect, out)
+		}
+	}
+
+	// 非法的公钥参数应当回滚
+	input, err := abi.Pack(sigVerifyInputs, "not json", sig, msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := xuperSignVerify(newPrecompileContext(input, 100000)); err == nil {
+		t.Error("expect error for invalid public keys")
+	}
+
+	// 解析公钥前先按输入长度扣除gas，gas不足时不解码输入
+	input, err = abi.Pack(sigVerifyInputs, string(bytes.Repeat(ringKeysJSON, 100)), ringSig, msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := newPrecompileContext(input, int64(sigVerifyBaseCost.Cpu))
+	if _, err := ringSignVerify(ctx); err != errors.Codes.InsufficientGas {
+		t.Errorf("expect insufficient gas, got %v", err)
+	}
+	ctx = newPrecompileContext(input, 100000000)
+	if _, err := ringSignVerify(ctx); err == nil {
+		t.Error("expect error for invalid public keys")
+	}
+	expect := int64(sigVerifyBaseCost.Cpu) + int64(sigVerifyPerWordCost.Cpu)*int64(wordsIn(len(input)))
+	if used := 100000000 - ctx.Gas.Int64(); used != expect {
+		t.Errorf("unexpected gas used %d, expect %d", used, expect)
+	}
+}
+
+func TestPrecompileSM2Verify(t *testing.T) {
+	privKey, err := sm2.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	jsonKey, err := json.Marshal(&account.ECDSAPublicKey{
+		Curvname: cryptoTypes.CurveGm,
+		X:        privKey.PublicKey.X,
+		Y:        privKey.PublicKey.Y,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := sm3.Sm3Sum([]byte("precompile message"))
+	sig, err := privKey.Sign(rand.Reader, msg, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name   string
+		msg    []byte
+		expect bool
+	}{
+		{"sm2 sign", msg, true},
+		{"sm2 sign wrong msg", sm3.Sm3Sum([]byte("other")), false},
+	}
+	for _, c := range cases {
+		input, err := abi.Pack(sm2VerifyInputs, string(jsonKey), sig, c.msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out, err := sm2Verify(newPrecompileContext(input, 100000))
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if !bytes.Equal(out, packBool(c.expect)) {
+			t.Errorf("%s: expect %v, got %x", c.name, c.expect, out)
+		}
+	}
+
+	// 非SM2曲线的公钥应当回滚
+	nistKey, err := chain.GetInstance().(*chain.XchainCryptoClient).GenerateKeyBySeed([]byte("precompile seed one 1234567890"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	nistJSON, err := chain.GetInstance().GetEcdsaPublicKeyJsonFormatStr(nistKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	input, err := abi.Pack(sm2VerifyInputs, nistJSON, sig, msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sm2Verify(newPrecompileContext(input, 100000)); err == nil {
+		t.Error("expect error for non sm2 public key")
+	}
+
+	// 公钥列表中的曲线不一致时解析失败
+	mixedJSON, _ := json.Marshal([]json.RawMessage{json.RawMessage(nistJSON), jsonKey})
+	if _, _, err := parsePublicKeys(string(mixedJSON)); err == nil {
+		t.Error("expect error for public keys with different curves")
+	}
+}
+
+func TestPrecompileNatives(t *testing.T) {
+	natives, err := NewNatives()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, addr := range []crypto.Address{XuperSignVerifyAddress, SM3HashAddress, SM2VerifyAddress,
+		RingSignVerifyAddress, MiMCHashAddress, MiMCVerifyAddress} {
+		if !natives.IsRegistered(addr) {
+			t.Errorf("precompile %v not registered", addr)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package htlc
+
+import (
+	"fmt"
+
+	xctx "github.com/wooyang2018/corechain/common/context"
+	"github.com/wooyang2018/corechain/common/timer"
+	"github.com/wooyang2018/corechain/contract/base"
+	"github.com/wooyang2018/corechain/ledger"
+	"github.com/wooyang2018/corechain/logger"
+)
+
+type LedgerRely interface {
+	// 获取状态机最新确认快照
+	GetTipXMSnapshotReader() (ledger.SnapshotReader, error)
+}
+
+type HTLCCtx struct {
+	// 基础上下文
+	xctx.BaseCtx
+	BcName   string
+	Ledger   LedgerRely
+	Contract base.Manager
+}
+
+func NewHTLCCtx(bcName string, leg LedgerRely, contract base.Manager) (*HTLCCtx, error) {
+	if bcName == "" || leg == nil || contract == nil {
+		return nil, fmt.Errorf("new htlc ctx failed because param error")
+	}
+
+	log, err := logger.NewLogger("", HTLCKernelContract)
+	if err != nil {
+		return nil, fmt.Errorf("new htlc ctx failed because new logger error. err:%v", err)
+	}
+
+	ctx := new(HTLCCtx)
+	ctx.XLog = log
+	ctx.Timer = timer.NewXTimer()
+	ctx.BcName = bcName
+	ctx.Ledger = leg
+	ctx.Contract = contract
+
+	return ctx, nil
+}
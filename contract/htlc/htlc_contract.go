@@ -0,0 +1,225 @@
+package htlc
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/wooyang2018/corechain/contract/base"
+	ltx "github.com/wooyang2018/corechain/ledger/tx"
+	"github.com/wooyang2018/corechain/protos"
+)
+
+type KernMethod struct {
+	BcName string
+}
+
+func NewKernContractMethod(bcName string) *KernMethod {
+	t := &KernMethod{
+		BcName: bcName,
+	}
+	return t
+}
+
+// Lock 发起者将amount转入合约托管，receiver在提供preimage后领取，超过timeout高度后发起者可退款
+func (t *KernMethod) Lock(ctx base.KContext) (*base.Response, error) {
+	args := ctx.Args()
+	receiverBuf := args["receiver"]
+	amountBuf := args["amount"]
+	hashlockBuf := args["hashlock"]
+	timeoutBuf := args["timeout"]
+	if receiverBuf == nil || amountBuf == nil || hashlockBuf == nil || timeoutBuf == nil {
+		return nil, fmt.Errorf("lock failed, receiver, amount, hashlock or timeout is nil")
+	}
+
+	amount, ok := new(big.Int).SetString(string(amountBuf), 10)
+	if !ok || amount.Sign() <= 0 {
+		return nil, fmt.Errorf("lock failed, invalid amount %s", amountBuf)
+	}
+	hashlock, err := hex.DecodeString(string(hashlockBuf))
+	if err != nil || len(hashlock) != 32 {
+		return nil, fmt.Errorf("lock failed, hashlock should be hex encoded sha256 digest")
+	}
+	timeout, err := strconv.ParseInt(string(timeoutBuf), 10, 64)
+	if err != nil || timeout <= 0 {
+		return nil, fmt.Errorf("lock failed, invalid timeout height %s", timeoutBuf)
+	}
+
+	// 同一hashlock只能锁定一次，避免preimage公开后被重复利用
+	swapID := hex.EncodeToString(hashlock)
+	if _, err := ctx.Get(GetHTLCBucket(), []byte(swapID)); err == nil {
+		return nil, fmt.Errorf("lock failed, swap %s already exists", swapID)
+	}
+
+	swap := &Swap{
+		Sender:   ctx.Initiator(),
+		Receiver: string(receiverBuf),
+		Amount:   amount.String(),
+		Hashlock: swapID,
+		Timeout:  timeout,
+		Status:   SwapStatusLocked,
+	}
+	if err := ctx.Transfer(swap.Sender, HTLCKernelContract, amount); err != nil {
+		return nil, fmt.Errorf("lock failed, transfer to htlc error: %v", err)
+	}
+	if err := t.updateSwap(ctx, swapID, swap, "Lock"); err != nil {
+		return nil, err
+	}
+
+	ctx.AddResourceUsed(base.Limits{
+		XFee: htlcFee,
+	})
+
+	return &base.Response{
+		Status:  statusOK,
+		Message: "success",
+		Body:    []byte(swapID),
+	}, nil
+}
+
+// Claim 任何人提交正确的preimage即可将托管资产转给receiver，交易需通过$expire_height声明在timeout高度之前打包
+func (t *KernMethod) Claim(ctx base.KContext) (*base.Response, error) {
+	args := ctx.Args()
+	swapIDBuf := args["swap_id"]
+	preimageBuf := args["preimage"]
+	if swapIDBuf == nil || preimageBuf == nil {
+		return nil, fmt.Errorf("claim failed, swap_id or preimage is nil")
+	}
+	preimage, err := hex.DecodeString(string(preimageBuf))
+	if err != nil {
+		return nil, fmt.Errorf("claim failed, preimage should be hex encoded")
+	}
+
+	swap, err := t.getSwap(ctx, string(swapIDBuf))
+	if err != nil {
+		return nil, fmt.Errorf("claim failed, err: %v", err)
+	}
+	if swap.Status != SwapStatusLocked {
+		return nil, fmt.Errorf("swap status is %s, only a locked swap could be claimed", swap.Status)
+	}
+	if MakeHashlock(preimage) != swap.Hashlock {
+		return nil, fmt.Errorf("claim failed, preimage mismatch hashlock")
+	}
+
+	// 与Refund的高度区间不重叠，超时后只能退款
+	expireHeight, err := strconv.ParseInt(string(args[ltx.ExpireHeightArg]), 10, 64)
+	if err != nil || expireHeight >= swap.Timeout {
+		return nil, fmt.Errorf("claim failed, %s should be less than timeout height %d", ltx.ExpireHeightArg, swap.Timeout)
+	}
+
+	amount, _ := new(big.Int).SetString(swap.Amount, 10)
+	if err := ctx.Transfer(HTLCKernelContract, swap.Receiver, amount); err != nil {
+		return nil, fmt.Errorf("claim failed, transfer to receiver error: %v", err)
+	}
+	swap.Status = SwapStatusClaimed
+	swap.Preimage = string(preimageBuf)
+	if err := t.updateSwap(ctx, string(swapIDBuf), swap, "Claim"); err != nil {
+		return nil, err
+	}
+
+	ctx.AddResourceUsed(base.Limits{
+		XFee: htlcFee,
+	})
+
+	return &base.Response{
+		Status:  statusOK,
+		Message: "success",
+		Body:    nil,
+	}, nil
+}
+
+// Refund 发起者在超时后取回托管资产，交易需通过$lock_height声明不早于timeout高度打包
+func (t *KernMethod) Refund(ctx base.KContext) (*base.Response, error) {
+	args := ctx.Args()
+	swapIDBuf := args["swap_id"]
+	if swapIDBuf == nil {
+		return nil, fmt.Errorf("refund failed, swap_id is nil")
+	}
+
+	swap, err := t.getSwap(ctx, string(swapIDBuf))
+	if err != nil {
+		return nil, fmt.Errorf("refund failed, err: %v", err)
+	}
+	if swap.Sender != ctx.Initiator() {
+		return nil, fmt.Errorf("no authority to refund: %s", ctx.Initiator())
+	}
+	if swap.Status != SwapStatusLocked {
+		return nil, fmt.Errorf("swap status is %s, only a locked swap could be refunded", swap.Status)
+	}
+
+	// 区块高度由交易的lock_height保证，执行结果不依赖节点当前高度
+	lockHeight, err := strconv.ParseInt(string(args[ltx.LockHeightArg]), 10, 64)
+	if err != nil || lockHeight < swap.Timeout {
+		return nil, fmt.Errorf("refund failed, %s should be no less than timeout height %d", ltx.LockHeightArg, swap.Timeout)
+	}
+
+	amount, _ := new(big.Int).SetString(swap.Amount, 10)
+	if err := ctx.Transfer(HTLCKernelContract, swap.Sender, amount); err != nil {
+		return nil, fmt.Errorf("refund failed, transfer to sender error: %v", err)
+	}
+	swap.Status = SwapStatusRefunded
+	if err := t.updateSwap(ctx, string(swapIDBuf), swap, "Refund"); err != nil {
+		return nil, err
+	}
+
+	ctx.AddResourceUsed(base.Limits{
+		XFee: htlcFee,
+	})
+
+	return &base.Response{
+		Status:  statusOK,
+		Message: "success",
+		Body:    nil,
+	}, nil
+}
+
+func (t *KernMethod) Query(ctx base.KContext) (*base.Response, error) {
+	args := ctx.Args()
+	swapIDBuf := args["swap_id"]
+	if swapIDBuf == nil {
+		return nil, fmt.Errorf("query failed, swap_id is nil")
+	}
+
+	swapBuf, err := ctx.Get(GetHTLCBucket(), swapIDBuf)
+	if err != nil {
+		return nil, fmt.Errorf("query failed, no swap found, err: %v", err)
+	}
+
+	return &base.Response{
+		Status:  statusOK,
+		Message: "success",
+		Body:    swapBuf,
+	}, nil
+}
+
+func (t *KernMethod) getSwap(ctx base.KContext, swapID string) (*Swap, error) {
+	swapBuf, err := ctx.Get(GetHTLCBucket(), []byte(swapID))
+	if err != nil {
+		return nil, fmt.Errorf("get swap failed, no swap found")
+	}
+	swap, err := parseSwap(swapBuf)
+	if err != nil {
+		return nil, fmt.Errorf("get swap failed, parse swap error")
+	}
+
+	return swap, nil
+}
+
+func (t *KernMethod) updateSwap(ctx base.KContext, swapID string, swap *Swap, event string) error {
+	swapBuf, err := json.Marshal(swap)
+	if err != nil {
+		return fmt.Errorf("update swap failed, marshal swap error")
+	}
+	if err := ctx.Put(GetHTLCBucket(), []byte(swapID), swapBuf); err != nil {
+		return fmt.Errorf("update swap failed, save swap error")
+	}
+
+	ctx.AddEvent(&protos.ContractEvent{
+		Contract: HTLCKernelContract,
+		Name:     event,
+		Body:     swapBuf,
+	})
+	return nil
+}
@@ -0,0 +1,157 @@
+package htlc
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/wooyang2018/corechain/contract/base"
+	ltx "github.com/wooyang2018/corechain/ledger/tx"
+	"github.com/wooyang2018/corechain/protos"
+)
+
+type fakeKContext struct {
+	base.KContext
+	initiator string
+	args      map[string][]byte
+	data      map[string][]byte
+	balances  map[string]*big.Int
+}
+
+func newFakeKContext(initiator string, balance int64) *fakeKContext {
+	return &fakeKContext{
+		initiator: initiator,
+		data:      make(map[string][]byte),
+		balances:  map[string]*big.Int{initiator: big.NewInt(balance)},
+	}
+}
+
+func (c *fakeKContext) Args() map[string][]byte { return c.args }
+
+func (c *fakeKContext) Initiator() string { return c.initiator }
+
+func (c *fakeKContext) Get(bucket string, key []byte) ([]byte, error) {
+	value, ok := c.data[bucket+"/"+string(key)]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return value, nil
+}
+
+func (c *fakeKContext) Put(bucket string, key, value []byte) error {
+	c.data[bucket+"/"+string(key)] = value
+	return nil
+}
+
+func (c *fakeKContext) Transfer(from, to string, amount *big.Int) error {
+	balance := c.balance(from)
+	if balance.Cmp(amount) < 0 {
+		return errors.New("balance not enough")
+	}
+	balance.Sub(balance, amount)
+	c.balance(to).Add(c.balance(to), amount)
+	return nil
+}
+
+func (c *fakeKContext) AddEvent(events ...*protos.ContractEvent) {}
+
+func (c *fakeKContext) AddResourceUsed(delta base.Limits) {}
+
+func (c *fakeKContext) balance(addr string) *big.Int {
+	if _, ok := c.balances[addr]; !ok {
+		c.balances[addr] = big.NewInt(0)
+	}
+	return c.balances[addr]
+}
+
+func TestHTLCClaim(t *testing.T) {
+	preimage := []byte("secret")
+	hashlock := MakeHashlock(preimage)
+	ctx := newFakeKContext("alice", 100)
+	m := NewKernContractMethod("corechain")
+
+	ctx.args = map[string][]byte{
+		"receiver": []byte("bob"),
+		"amount":   []byte("60"),
+		"hashlock": []byte(hashlock),
+		"timeout":  []byte("100"),
+	}
+	if _, err := m.Lock(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Lock(ctx); err == nil {
+		t.Fatal("lock the same hashlock twice should fail")
+	}
+	if ctx.balance(HTLCKernelContract).Int64() != 60 {
+		t.Fatal("htlc should hold the locked amount")
+	}
+
+	ctx.args = map[string][]byte{"swap_id": []byte(hashlock), "preimage": []byte(hex.EncodeToString([]byte("wrong")))}
+	if _, err := m.Claim(ctx); err == nil {
+		t.Fatal("claim with wrong preimage should fail")
+	}
+	ctx.args["preimage"] = []byte(hex.EncodeToString(preimage))
+	if _, err := m.Claim(ctx); err == nil {
+		t.Fatal("claim without expire height should fail")
+	}
+	ctx.args[ltx.ExpireHeightArg] = []byte("100")
+	if _, err := m.Claim(ctx); err == nil {
+		t.Fatal("claim at timeout height should fail")
+	}
+	ctx.args[ltx.ExpireHeightArg] = []byte("99")
+	if _, err := m.Claim(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if ctx.balance("bob").Int64() != 60 || ctx.balance(HTLCKernelContract).Int64() != 0 {
+		t.Fatal("receiver should get the locked amount")
+	}
+
+	swapBuf, _ := ctx.Get(GetHTLCBucket(), []byte(hashlock))
+	swap, _ := parseSwap(swapBuf)
+	if swap.Status != SwapStatusClaimed || swap.Preimage != hex.EncodeToString(preimage) {
+		t.Fatalf("unexpected swap %+v", swap)
+	}
+
+	ctx.args = map[string][]byte{"swap_id": []byte(hashlock), ltx.LockHeightArg: []byte("100")}
+	if _, err := m.Refund(ctx); err == nil {
+		t.Fatal("refund a claimed swap should fail")
+	}
+}
+
+func TestHTLCRefund(t *testing.T) {
+	hashlock := MakeHashlock([]byte("secret"))
+	ctx := newFakeKContext("alice", 100)
+	m := NewKernContractMethod("corechain")
+
+	ctx.args = map[string][]byte{
+		"receiver": []byte("bob"),
+		"amount":   []byte("60"),
+		"hashlock": []byte(hashlock),
+		"timeout":  []byte("100"),
+	}
+	if _, err := m.Lock(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx.args = map[string][]byte{"swap_id": []byte(hashlock)}
+	if _, err := m.Refund(ctx); err == nil {
+		t.Fatal("refund without lock height should fail")
+	}
+	ctx.args[ltx.LockHeightArg] = []byte("99")
+	if _, err := m.Refund(ctx); err == nil {
+		t.Fatal("refund before timeout should fail")
+	}
+	ctx.args[ltx.LockHeightArg] = []byte("100")
+	ctx.initiator = "bob"
+	if _, err := m.Refund(ctx); err == nil {
+		t.Fatal("only sender could refund")
+	}
+	ctx.initiator = "alice"
+	if _, err := m.Refund(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if ctx.balance("alice").Int64() != 100 {
+		t.Fatal("sender should get the locked amount back")
+	}
+}
@@ -0,0 +1,44 @@
+package htlc
+
+import (
+	"fmt"
+)
+
+// Manager manages all htlc releated data, providing read/write interface
+type Manager struct {
+	Ctx *HTLCCtx
+}
+
+// NewHTLCManager create instance of HTLCManager
+func NewHTLCManager(ctx *HTLCCtx) (HTLCManager, error) {
+	if ctx == nil || ctx.Ledger == nil || ctx.Contract == nil || ctx.BcName == "" {
+		return nil, fmt.Errorf("htlc ctx set error")
+	}
+
+	t := NewKernContractMethod(ctx.BcName)
+	register := ctx.Contract.GetKernRegistry()
+	register.RegisterKernMethod(HTLCKernelContract, "Lock", t.Lock)
+	register.RegisterKernMethod(HTLCKernelContract, "Claim", t.Claim)
+	register.RegisterKernMethod(HTLCKernelContract, "Refund", t.Refund)
+	register.RegisterKernMethod(HTLCKernelContract, "Query", t.Query)
+
+	mg := &Manager{
+		Ctx: ctx,
+	}
+
+	return mg, nil
+}
+
+// GetSwap get swap by id from the tip snapshot
+func (mgr *Manager) GetSwap(swapID string) (*Swap, error) {
+	reader, err := mgr.Ctx.Ledger.GetTipXMSnapshotReader()
+	if err != nil {
+		return nil, err
+	}
+	swapBuf, err := reader.Get(GetHTLCBucket(), []byte(swapID))
+	if err != nil {
+		return nil, fmt.Errorf("query swap failed.err:%v", err)
+	}
+
+	return parseSwap(swapBuf)
+}
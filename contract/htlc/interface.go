@@ -0,0 +1,5 @@
+package htlc
+
+type HTLCManager interface {
+	GetSwap(swapID string) (*Swap, error)
+}
@@ -0,0 +1,50 @@
+package htlc
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+)
+
+const (
+	HTLCKernelContract = "$htlc"
+
+	SwapStatusLocked   = "locked"
+	SwapStatusClaimed  = "claimed"
+	SwapStatusRefunded = "refunded"
+
+	statusOK = 200
+	// 锁定、领取、退款消耗的手续费
+	htlcFee = 100
+)
+
+// Swap 哈希时间锁托管记录，以hashlock作为swap id，
+// 领取后记录preimage，对方链可据此领取另一侧的资产完成原子交换
+type Swap struct {
+	Sender   string `json:"sender"`
+	Receiver string `json:"receiver"`
+	Amount   string `json:"amount"`
+	Hashlock string `json:"hashlock"`
+	Timeout  int64  `json:"timeout"`
+	Status   string `json:"status"`
+	Preimage string `json:"preimage,omitempty"`
+}
+
+// GetHTLCBucket return the bucket of htlc swaps
+func GetHTLCBucket() string {
+	return HTLCKernelContract
+}
+
+// MakeHashlock 计算preimage对应的hashlock, 即sha256的hex编码
+func MakeHashlock(preimage []byte) string {
+	digest := sha256.Sum256(preimage)
+	return hex.EncodeToString(digest[:])
+}
+
+func parseSwap(buf []byte) (*Swap, error) {
+	swap := &Swap{}
+	if err := json.Unmarshal(buf, swap); err != nil {
+		return nil, err
+	}
+	return swap, nil
+}
@@ -80,6 +80,30 @@ func (k *kcontextImpl) Call(module, contractName, method string, args map[string
 	}, nil
 }
 
+func (k *kcontextImpl) CallWithLimits(module, contractName, method string, args map[string][]byte, limits base.Limits) (*base.Response, error) {
+	// 被调合约的资源上限为上下文限额减去已用资源，调用期间临时收紧上下文限额
+	origin := k.ctx.ResourceLimits
+	capped := new(base.Limits).Add(k.ctx.ResourceUsed()).Add(limits)
+	capped.Disk = limits.Disk
+	if capped.Cpu > origin.Cpu {
+		capped.Cpu = origin.Cpu
+	}
+	if capped.Memory > origin.Memory {
+		capped.Memory = origin.Memory
+	}
+	if capped.Disk > origin.Disk {
+		capped.Disk = origin.Disk
+	}
+	if capped.XFee > origin.XFee {
+		capped.XFee = origin.XFee
+	}
+	k.ctx.ResourceLimits = *capped
+	defer func() {
+		k.ctx.ResourceLimits = origin
+	}()
+	return k.Call(module, contractName, method, args)
+}
+
 // EmitAsyncTask 异步发送订阅事件
 func (k *kcontextImpl) EmitAsyncTask(event string, args interface{}) (err error) {
 	var rawBytes []byte
@@ -12,6 +12,7 @@ import (
 	"github.com/wooyang2018/corechain/contract/sandbox"
 	"github.com/wooyang2018/corechain/logger"
 	"github.com/wooyang2018/corechain/permission/base"
+	"github.com/wooyang2018/corechain/protos"
 )
 
 const (
@@ -105,7 +106,16 @@ func newManagerImpl(cfg *contractBase.ManagerConfig) (contractBase.Manager, erro
 }
 
 func (m *managerImpl) NewContext(cfg *contractBase.ContextConfig) (contractBase.VMContext, error) {
-	return m.xbridge.NewContext(cfg)
+	ctx, err := m.xbridge.NewContext(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &proposalContext{
+		VMContext:    ctx,
+		state:        cfg.State,
+		initiator:    cfg.Initiator,
+		contractName: cfg.ContractName,
+	}, nil
 }
 
 func (m *managerImpl) NewStateSandbox(cfg *contractBase.SandboxConfig) (contractBase.StateSandbox, error) {
@@ -157,7 +167,13 @@ func (m *managerImpl) upgradeContract(ctx contractBase.KContext) (*contractBase.
 		return nil, errors.New("invoke Upgrade error, contract name is nil")
 	}
 
-	err := m.core.VerifyContractOwnerPermission(string(contractName), ctx.AuthRequire())
+	req := &protos.InvokeRequest{
+		ModuleName:   "xkernel",
+		ContractName: "$contract",
+		MethodName:   "upgradeContract",
+		Args:         ctx.Args(),
+	}
+	err := m.core.VerifyContractOwnerPermission(string(contractName), ctx.AuthRequire(), ctx.Initiator(), req)
 	if err != nil {
 		return nil, err
 	}
@@ -5,8 +5,11 @@ import (
 
 	"github.com/wooyang2018/corechain/contract/base"
 	mock2 "github.com/wooyang2018/corechain/contract/mock"
+	"github.com/wooyang2018/corechain/contract/proposal/utils"
 	"github.com/wooyang2018/corechain/contract/sandbox"
+	"github.com/wooyang2018/corechain/ledger"
 	"github.com/wooyang2018/corechain/logger"
+	aclBase "github.com/wooyang2018/corechain/permission/base"
 )
 
 func GetMockContractConfig() *base.ContractConfig {
@@ -72,3 +75,56 @@ func (h *helloContract) Hi(ctx base.KContext) (*base.Response, error) {
 		Body: []byte("hello " + string(name)),
 	}, nil
 }
+
+func TestInvokeConsumeProposal(t *testing.T) {
+	th := mock2.NewTestHelper(contractConfig)
+	defer th.Close()
+	m := th.Manager()
+
+	m.GetKernRegistry().RegisterKernMethod("$hello", "Hi", new(helloContract).Hi)
+
+	args := map[string][]byte{
+		"name":                     []byte("xuper"),
+		aclBase.GetProposalIDArg(): []byte("1"),
+	}
+	proposal, _ := utils.UnParse(&utils.Proposal{
+		Args: map[string]interface{}{
+			aclBase.ProposalInitiatorArg: mock2.ContractAccount,
+			aclBase.ProposalContractArg:  "$hello",
+			aclBase.ProposalMethodArg:    "Hi",
+			aclBase.ProposalArgsHashArg:  aclBase.MakeProposalArgsHash(args),
+		},
+		Status: utils.ProposalStatusPassed,
+	})
+	th.State().Put(utils.GetProposalBucket(), []byte(utils.MakeProposalKey("1")), &ledger.VersionedData{
+		RefTxid:  []byte("txid"),
+		PureData: &ledger.PureData{Value: proposal},
+	})
+	isUsed := func() bool {
+		used, err := th.State().Get(utils.GetProposalBucket(), []byte(utils.MakeProposalUsedKey("1")))
+		return err == nil && len(used.GetPureData().GetValue()) > 0
+	}
+
+	// 与提案绑定的参数不一致的调用不消耗提案
+	other := map[string][]byte{
+		"name":                     []byte("mallory"),
+		aclBase.GetProposalIDArg(): []byte("1"),
+	}
+	if _, err := th.Invoke("xkernel", "$hello", "Hi", other); err != nil {
+		t.Fatal(err)
+	}
+	if isUsed() {
+		t.Fatal("proposal should not be consumed by unrelated request")
+	}
+
+	if _, err := th.Invoke("xkernel", "$hello", "Hi", args); err != nil {
+		t.Fatal(err)
+	}
+	if !isUsed() {
+		t.Fatal("proposal should be marked as used")
+	}
+	// 同一提案不能再授权其他交易
+	if _, err := th.Invoke("xkernel", "$hello", "Hi", args); err != ErrProposalUsed {
+		t.Fatalf("expect %v, got %v", ErrProposalUsed, err)
+	}
+}
@@ -0,0 +1,48 @@
+package kernel
+
+import (
+	"errors"
+
+	contractBase "github.com/wooyang2018/corechain/contract/base"
+	"github.com/wooyang2018/corechain/contract/proposal/utils"
+	"github.com/wooyang2018/corechain/permission/base"
+)
+
+var ErrProposalUsed = errors.New("proposal has been used by another tx")
+
+// proposalContext 引用提案的调用在执行前消耗该提案，一个提案只能授权一笔交易
+// 消耗标记进入交易读写集，两笔交易引用同一提案时只有一笔能通过读集版本校验
+// 只有提案绑定的发起人、合约方法和参数与本次调用一致时才消耗，其他调用引用提案不影响提案的使用
+type proposalContext struct {
+	contractBase.VMContext
+	state        contractBase.StateSandbox
+	initiator    string
+	contractName string
+}
+
+func (c *proposalContext) Invoke(method string, args map[string][]byte) (*contractBase.Response, error) {
+	req := base.NewProposalRequest(c.initiator, c.contractName, method, args)
+	if req != nil {
+		if err := consumeProposal(c.state, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.VMContext.Invoke(method, args)
+}
+
+func consumeProposal(state contractBase.StateSandbox, req *base.ProposalRequest) error {
+	proposalBuf, err := state.Get(utils.GetProposalBucket(), []byte(utils.MakeProposalKey(req.ProposalID)))
+	if err != nil || len(proposalBuf) == 0 {
+		return nil
+	}
+	proposal, err := utils.Parse(string(proposalBuf))
+	if err != nil || proposal.Status != utils.ProposalStatusPassed || !req.Match(proposal.Args) {
+		return nil
+	}
+
+	key := []byte(utils.MakeProposalUsedKey(req.ProposalID))
+	if used, err := state.Get(utils.GetProposalBucket(), key); err == nil && len(used) > 0 {
+		return ErrProposalUsed
+	}
+	return state.Put(utils.GetProposalBucket(), key, []byte("1"))
+}
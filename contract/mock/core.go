@@ -20,7 +20,7 @@ func (f *fakeChainCore) VerifyContractPermission(initiator string, authRequire [
 }
 
 // VerifyContractOwnerPermission verify contract ownership permisson
-func (f *fakeChainCore) VerifyContractOwnerPermission(contractName string, authRequire []string) error {
+func (f *fakeChainCore) VerifyContractOwnerPermission(contractName string, authRequire []string, initiator string, req *protos.InvokeRequest) error {
 	return nil
 }
 
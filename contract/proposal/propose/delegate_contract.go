@@ -0,0 +1,218 @@
+package propose
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/wooyang2018/corechain/contract/base"
+	"github.com/wooyang2018/corechain/contract/proposal/utils"
+)
+
+// Delegate 将发起者的治理投票权委托给delegate，委托期间发起者不能直接投票，可随时撤销
+func (t *KernMethod) Delegate(ctx base.KContext) (*base.Response, error) {
+	delegate := string(ctx.Args()["delegate"])
+	if delegate == "" {
+		return nil, fmt.Errorf("delegate failed, delegate is nil")
+	}
+	from := ctx.Initiator()
+	if delegate == from {
+		return nil, fmt.Errorf("delegate failed, can not delegate to self")
+	}
+	// 只支持一层委托，避免委托链
+	if _, err := ctx.Get(utils.GetProposalBucket(), []byte(utils.MakeDelegateKey(delegate))); err == nil {
+		return nil, fmt.Errorf("delegate failed, %s has delegated to others", delegate)
+	}
+
+	err := ctx.Put(utils.GetProposalBucket(), []byte(utils.MakeDelegateKey(from)), []byte(delegate))
+	if err != nil {
+		return nil, err
+	}
+
+	delta := base.Limits{
+		XFee: 100,
+	}
+	ctx.AddResourceUsed(delta)
+
+	return &base.Response{
+		Status:  utils.StatusOK,
+		Message: "success",
+		Body:    nil,
+	}, nil
+}
+
+// Undelegate 撤销委托，已由代理人投出的票保持有效
+func (t *KernMethod) Undelegate(ctx base.KContext) (*base.Response, error) {
+	key := []byte(utils.MakeDelegateKey(ctx.Initiator()))
+	if _, err := ctx.Get(utils.GetProposalBucket(), key); err != nil {
+		return nil, fmt.Errorf("undelegate failed, no delegation found")
+	}
+	if err := ctx.Del(utils.GetProposalBucket(), key); err != nil {
+		return nil, err
+	}
+
+	delta := base.Limits{
+		XFee: 100,
+	}
+	ctx.AddResourceUsed(delta)
+
+	return &base.Response{
+		Status:  utils.StatusOK,
+		Message: "success",
+		Body:    nil,
+	}, nil
+}
+
+func (t *KernMethod) QueryDelegate(ctx base.KContext) (*base.Response, error) {
+	account := ctx.Args()["account"]
+	if account == nil {
+		return nil, fmt.Errorf("query delegate failed, account is nil")
+	}
+	delegate, err := ctx.Get(utils.GetProposalBucket(), []byte(utils.MakeDelegateKey(string(account))))
+	if err != nil {
+		return nil, fmt.Errorf("query delegate failed, no delegation found")
+	}
+
+	return &base.Response{
+		Status:  utils.StatusOK,
+		Message: "success",
+		Body:    delegate,
+	}, nil
+}
+
+// SetVoteRule 设置提案类型的表决规则，只能由通过的提案触发，提案类型为module.contract.method
+func (t *KernMethod) SetVoteRule(ctx base.KContext) (*base.Response, error) {
+	if ctx.Caller() != utils.ProposalKernelContract {
+		return nil, fmt.Errorf("caller %s no authority to SetVoteRule", ctx.Caller())
+	}
+
+	ruleArgs := make(map[string]interface{})
+	if err := json.Unmarshal(ctx.Args()["args"], &ruleArgs); err != nil {
+		return nil, fmt.Errorf("set vote rule failed, parse args error")
+	}
+	proposalType, _ := ruleArgs["type"].(string)
+	if !utils.IsProposalType(proposalType) {
+		return nil, fmt.Errorf("set vote rule failed, type should be module.contract.method")
+	}
+	rule := &utils.VoteRule{}
+	var err error
+	if rule.QuorumPercent, err = parsePercent(ruleArgs["quorum_percent"], 1); err != nil {
+		return nil, err
+	}
+	if rule.ApprovePercent, err = parsePercent(ruleArgs["approve_percent"], 51); err != nil {
+		return nil, err
+	}
+
+	ruleBuf, err := json.Marshal(rule)
+	if err != nil {
+		return nil, err
+	}
+	err = ctx.Put(utils.GetProposalBucket(), []byte(utils.MakeVoteRuleKey(proposalType)), ruleBuf)
+	if err != nil {
+		return nil, err
+	}
+
+	return &base.Response{
+		Status:  utils.StatusOK,
+		Message: "success",
+		Body:    nil,
+	}, nil
+}
+
+func (t *KernMethod) QueryVoteRule(ctx base.KContext) (*base.Response, error) {
+	proposalType := ctx.Args()["type"]
+	if proposalType == nil {
+		return nil, fmt.Errorf("query vote rule failed, type is nil")
+	}
+	ruleBuf, err := ctx.Get(utils.GetProposalBucket(), []byte(utils.MakeVoteRuleKey(string(proposalType))))
+	if err != nil {
+		return nil, fmt.Errorf("query vote rule failed, no rule found for type %s", proposalType)
+	}
+
+	return &base.Response{
+		Status:  utils.StatusOK,
+		Message: "success",
+		Body:    ruleBuf,
+	}, nil
+}
+
+// getVoter 返回投票锁仓的账户，代理人代为投票时为委托人
+func (t *KernMethod) getVoter(ctx base.KContext) (string, error) {
+	initiator := ctx.Initiator()
+	delegator := string(ctx.Args()["delegator"])
+	if delegator == "" {
+		if _, err := ctx.Get(utils.GetProposalBucket(), []byte(utils.MakeDelegateKey(initiator))); err == nil {
+			return "", fmt.Errorf("voting power of %s has been delegated, undelegate first", initiator)
+		}
+		return initiator, nil
+	}
+
+	delegate, err := ctx.Get(utils.GetProposalBucket(), []byte(utils.MakeDelegateKey(delegator)))
+	if err != nil || string(delegate) != initiator {
+		return "", fmt.Errorf("%s is not the delegate of %s", initiator, delegator)
+	}
+	return delegator, nil
+}
+
+// getVoteRule 提案类型没有设置表决规则时返回nil，使用min_vote_percent
+func (t *KernMethod) getVoteRule(ctx base.KContext, proposalType string) (*utils.VoteRule, error) {
+	if proposalType == "" {
+		return nil, nil
+	}
+	ruleBuf, err := ctx.Get(utils.GetProposalBucket(), []byte(utils.MakeVoteRuleKey(proposalType)))
+	if err != nil || len(ruleBuf) == 0 {
+		return nil, nil
+	}
+	rule := &utils.VoteRule{}
+	if err := json.Unmarshal(ruleBuf, rule); err != nil {
+		return nil, fmt.Errorf("parse vote rule error")
+	}
+	return rule, nil
+}
+
+// isPassed 根据表决规则统计投票结果，totalSupply为治理代币总额
+func isPassed(proposal *utils.Proposal, totalSupply *big.Int) bool {
+	yes := amountOf(proposal.VoteAmount)
+	if proposal.Rule == nil {
+		voteThread := big.NewInt(0)
+		voteThread.SetString(proposal.Args["min_vote_percent"].(string), 10)
+		threadTickets := new(big.Int).Mul(totalSupply, voteThread)
+		threadTickets.Div(threadTickets, big.NewInt(100))
+		return yes.Cmp(threadTickets) >= 0
+	}
+
+	no := amountOf(proposal.NoAmount)
+	total := new(big.Int).Add(yes, no)
+	total.Add(total, amountOf(proposal.AbstainAmount))
+	// total*100 >= totalSupply*quorum
+	quorum := new(big.Int).Mul(totalSupply, big.NewInt(proposal.Rule.QuorumPercent))
+	if new(big.Int).Mul(total, big.NewInt(100)).Cmp(quorum) < 0 {
+		return false
+	}
+	// yes*100 >= (yes+no)*approve，赞成与反对均为0时不通过
+	decisive := new(big.Int).Add(yes, no)
+	if decisive.Sign() == 0 {
+		return false
+	}
+	approve := decisive.Mul(decisive, big.NewInt(proposal.Rule.ApprovePercent))
+	return new(big.Int).Mul(yes, big.NewInt(100)).Cmp(approve) >= 0
+}
+
+func amountOf(amount *big.Int) *big.Int {
+	if amount == nil {
+		return big.NewInt(0)
+	}
+	return amount
+}
+
+func parsePercent(value interface{}, min int64) (int64, error) {
+	percentStr, _ := value.(string)
+	percent := big.NewInt(0)
+	if _, ok := percent.SetString(percentStr, 10); !ok {
+		return 0, fmt.Errorf("invalid percent %v", value)
+	}
+	if percent.Cmp(big.NewInt(min)) < 0 || percent.Cmp(big.NewInt(100)) > 0 {
+		return 0, fmt.Errorf("percent should be in [%d, 100], got %s", min, percentStr)
+	}
+	return percent.Int64(), nil
+}
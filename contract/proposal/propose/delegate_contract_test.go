@@ -0,0 +1,192 @@
+package propose
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/wooyang2018/corechain/contract/base"
+	"github.com/wooyang2018/corechain/contract/proposal/utils"
+)
+
+type fakeKContext struct {
+	base.KContext
+	initiator string
+	caller    string
+	args      map[string][]byte
+	data      map[string][]byte
+	locked    map[string]int64
+}
+
+func newFakeKContext() *fakeKContext {
+	return &fakeKContext{
+		data:   make(map[string][]byte),
+		locked: make(map[string]int64),
+	}
+}
+
+func (c *fakeKContext) Args() map[string][]byte { return c.args }
+
+func (c *fakeKContext) Initiator() string { return c.initiator }
+
+func (c *fakeKContext) Caller() string { return c.caller }
+
+func (c *fakeKContext) Get(bucket string, key []byte) ([]byte, error) {
+	value, ok := c.data[bucket+"/"+string(key)]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return value, nil
+}
+
+func (c *fakeKContext) Put(bucket string, key, value []byte) error {
+	c.data[bucket+"/"+string(key)] = value
+	return nil
+}
+
+func (c *fakeKContext) Del(bucket string, key []byte) error {
+	delete(c.data, bucket+"/"+string(key))
+	return nil
+}
+
+func (c *fakeKContext) Call(module, contract, method string, args map[string][]byte) (*base.Response, error) {
+	if contract == utils.GovernTokenKernelContract && method == "Lock" {
+		amount, _ := new(big.Int).SetString(string(args["amount"]), 10)
+		c.locked[string(args["from"])] += amount.Int64()
+	}
+	return &base.Response{Status: utils.StatusOK}, nil
+}
+
+func (c *fakeKContext) AddResourceUsed(delta base.Limits) {}
+
+func TestDelegateVote(t *testing.T) {
+	ctx := newFakeKContext()
+	m := NewKernContractMethod("corechain")
+	proposal := &utils.Proposal{
+		Args:       map[string]interface{}{"stop_vote_height": "100"},
+		VoteAmount: big.NewInt(0),
+		Status:     utils.ProposalStatusVoting,
+		Rule:       &utils.VoteRule{QuorumPercent: 50, ApprovePercent: 60},
+	}
+	if err := m.updateProposal(ctx, "1", proposal); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx.initiator = "alice"
+	ctx.args = map[string][]byte{"delegate": []byte("bob")}
+	if _, err := m.Delegate(ctx); err != nil {
+		t.Fatal(err)
+	}
+	ctx.initiator = "bob"
+	ctx.args = map[string][]byte{"delegate": []byte("carol")}
+	if _, err := m.Delegate(ctx); err != nil {
+		t.Fatal(err)
+	}
+	ctx.initiator = "carol"
+	ctx.args = map[string][]byte{"delegate": []byte("alice")}
+	if _, err := m.Delegate(ctx); err == nil {
+		t.Fatal("delegate to an account who has delegated should fail")
+	}
+
+	// 委托期间不能直接投票
+	ctx.initiator = "alice"
+	ctx.args = map[string][]byte{"proposal_id": []byte("1"), "amount": []byte("10")}
+	if _, err := m.Vote(ctx); err == nil {
+		t.Fatal("delegated account should not vote directly")
+	}
+	ctx.initiator = "carol"
+	ctx.args = map[string][]byte{"proposal_id": []byte("1"), "amount": []byte("10"), "delegator": []byte("alice")}
+	if _, err := m.Vote(ctx); err == nil {
+		t.Fatal("only the delegate could vote on behalf of the delegator")
+	}
+	ctx.initiator = "bob"
+	ctx.args["option"] = []byte(utils.VoteOptionNo)
+	if _, err := m.Vote(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if ctx.locked["alice"] != 10 || ctx.locked["bob"] != 0 {
+		t.Fatalf("delegator's tokens should be locked, %v", ctx.locked)
+	}
+
+	ctx.initiator = "alice"
+	ctx.args = nil
+	if _, err := m.Undelegate(ctx); err != nil {
+		t.Fatal(err)
+	}
+	ctx.args = map[string][]byte{"proposal_id": []byte("1"), "amount": []byte("30"), "option": []byte(utils.VoteOptionYes)}
+	if _, err := m.Vote(ctx); err != nil {
+		t.Fatal(err)
+	}
+	ctx.initiator = "carol"
+	ctx.args = map[string][]byte{"proposal_id": []byte("1"), "amount": []byte("15"), "option": []byte(utils.VoteOptionAbstain)}
+	if _, err := m.Vote(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	proposal, _ = m.getProposal(ctx, "1")
+	if proposal.VoteAmount.Int64() != 30 || proposal.NoAmount.Int64() != 10 || proposal.AbstainAmount.Int64() != 15 {
+		t.Fatalf("unexpected votes %+v", proposal)
+	}
+	// 投票总数55，赞成率75%
+	if !isPassed(proposal, big.NewInt(100)) {
+		t.Fatal("proposal should pass")
+	}
+	if isPassed(proposal, big.NewInt(120)) {
+		t.Fatal("proposal should not reach quorum")
+	}
+	proposal.Rule.ApprovePercent = 80
+	if isPassed(proposal, big.NewInt(100)) {
+		t.Fatal("proposal should not reach approve percent")
+	}
+}
+
+func TestProposeVoteRule(t *testing.T) {
+	ctx := newFakeKContext()
+	m := NewKernContractMethod("corechain")
+	proposalType := "xkernel.$parachain.editGroup"
+
+	ctx.caller = utils.ProposalKernelContract
+	ctx.args = map[string][]byte{"args": []byte(`{"type":"editGroup","quorum_percent":"40","approve_percent":"60"}`)}
+	if _, err := m.SetVoteRule(ctx); err == nil {
+		t.Fatal("vote rule type should be module.contract.method")
+	}
+	ctx.args = map[string][]byte{"args": []byte(`{"type":"` + proposalType + `","quorum_percent":"40","approve_percent":"60"}`)}
+	if _, err := m.SetVoteRule(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// 提案者填写的规则被忽略，使用触发方法在链上设置的规则
+	ctx.caller = ""
+	ctx.initiator = "alice"
+	ctx.args = map[string][]byte{"proposal": []byte(`{"args":{"stop_vote_height":"100","min_vote_percent":"51"},
+		"rule":{"quorum_percent":1,"approve_percent":1},
+		"trigger":{"height":110,"module":"xkernel","contract":"$parachain","method":"editGroup"}}`)}
+	resp, err := m.Propose(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proposal, _ := m.getProposal(ctx, string(resp.Body))
+	if proposal.Rule == nil || proposal.Rule.QuorumPercent != 40 || proposal.Rule.ApprovePercent != 60 {
+		t.Fatalf("proposal should use the on-chain vote rule, got %+v", proposal.Rule)
+	}
+
+	// 不能通过type选择其他类型的规则
+	ctx.args = map[string][]byte{"proposal": []byte(`{"args":{"stop_vote_height":"100","min_vote_percent":"51","type":"xkernel.$govern_token.Transfer"},
+		"trigger":{"height":110,"module":"xkernel","contract":"$parachain","method":"editGroup"}}`)}
+	if _, err := m.Propose(ctx); err == nil {
+		t.Fatal("proposal type mismatch with trigger should fail")
+	}
+
+	// 没有设置规则的触发方法使用min_vote_percent
+	ctx.args = map[string][]byte{"proposal": []byte(`{"args":{"stop_vote_height":"100","min_vote_percent":"51"},
+		"rule":{"quorum_percent":1,"approve_percent":1},
+		"trigger":{"height":110,"module":"xkernel","contract":"$consensus","method":"updateConsensus"}}`)}
+	resp, err = m.Propose(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proposal, _ = m.getProposal(ctx, string(resp.Body))
+	if proposal.Rule != nil {
+		t.Fatalf("proposal without on-chain rule should not carry rule, got %+v", proposal.Rule)
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/wooyang2018/corechain/contract/base"
 	"github.com/wooyang2018/corechain/contract/proposal/utils"
+	aclBase "github.com/wooyang2018/corechain/permission/base"
 )
 
 type KernMethod struct {
@@ -40,6 +41,16 @@ func (t *KernMethod) Propose(ctx base.KContext) (*base.Response, error) {
 		return nil, err
 	}
 
+	// 提案类型由触发的合约方法确定，该类型设置了表决规则时必须使用，提案者不能自行选择或填写规则
+	proposalType := utils.MakeProposalType(proposal.Trigger)
+	if argType, ok := proposal.Args["type"].(string); ok && argType != "" && argType != proposalType {
+		return nil, fmt.Errorf("proposal type %s mismatch with trigger %s", argType, proposalType)
+	}
+	proposal.Rule, err = t.getVoteRule(ctx, proposalType)
+	if err != nil {
+		return nil, err
+	}
+
 	// 校验参数
 	err = checkProposalArgs(proposal)
 	if err != nil {
@@ -83,6 +94,8 @@ func (t *KernMethod) Propose(ctx base.KContext) (*base.Response, error) {
 
 	// 设置初始投票数
 	proposal.VoteAmount = big.NewInt(0)
+	proposal.NoAmount = big.NewInt(0)
+	proposal.AbstainAmount = big.NewInt(0)
 	// 设置voting状态
 	proposal.Status = utils.ProposalStatusVoting
 	// 设置提案者
@@ -126,6 +139,13 @@ func (t *KernMethod) Vote(ctx base.KContext) (*base.Response, error) {
 	if !isAmount || lockAmount.Cmp(big.NewInt(0)) == -1 {
 		return nil, fmt.Errorf("vote failed, amount is not valid: %s", string(amountBuf))
 	}
+	option := utils.VoteOptionYes
+	if optionBuf := args["option"]; len(optionBuf) > 0 {
+		option = string(optionBuf)
+	}
+	if option != utils.VoteOptionYes && option != utils.VoteOptionNo && option != utils.VoteOptionAbstain {
+		return nil, fmt.Errorf("vote failed, option is not valid: %s", option)
+	}
 
 	// 获取提案
 	proposal, err := t.getProposal(ctx, string(proposalIDBuf))
@@ -138,8 +158,11 @@ func (t *KernMethod) Vote(ctx base.KContext) (*base.Response, error) {
 		return nil, fmt.Errorf("proposal status is %s,can not vote now", proposal.Status)
 	}
 
-	// 冻结一定数量的治理代币，根据提案类型冻结不同数量的代币
-	from := ctx.Initiator() // 冻结账户地址
+	// 冻结投票账户的治理代币，代理人投票时冻结委托人的代币
+	from, err := t.getVoter(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("vote failed, %v", err)
+	}
 	governTokenArgs := make(map[string][]byte)
 	governTokenArgs["from"] = []byte(from)
 	governTokenArgs["amount"] = amountBuf
@@ -168,7 +191,14 @@ func (t *KernMethod) Vote(ctx base.KContext) (*base.Response, error) {
 	// 获取并更新提案投票数
 	amount := big.NewInt(0)
 	amount.SetString(string(amountBuf), 10)
-	proposal.VoteAmount = proposal.VoteAmount.Add(proposal.VoteAmount, amount)
+	switch option {
+	case utils.VoteOptionNo:
+		proposal.NoAmount = new(big.Int).Add(amountOf(proposal.NoAmount), amount)
+	case utils.VoteOptionAbstain:
+		proposal.AbstainAmount = new(big.Int).Add(amountOf(proposal.AbstainAmount), amount)
+	default:
+		proposal.VoteAmount = proposal.VoteAmount.Add(proposal.VoteAmount, amount)
+	}
 	err = t.updateProposal(ctx, string(proposalIDBuf), proposal)
 	if err != nil {
 		return nil, err
@@ -205,9 +235,11 @@ func (t *KernMethod) Thaw(ctx base.KContext) (*base.Response, error) {
 		return nil, fmt.Errorf("no authority to thaw: %s", ctx.Initiator())
 	}
 
-	// 比较投票数
-	if proposal.VoteAmount.Cmp(big.NewInt(0)) == 1 {
-		return nil, fmt.Errorf("some one has voted %s tickets, can not thaw now", proposal.VoteAmount.String())
+	// 比较投票数，包含反对票和弃权票
+	voted := new(big.Int).Add(proposal.VoteAmount, amountOf(proposal.NoAmount))
+	voted.Add(voted, amountOf(proposal.AbstainAmount))
+	if voted.Cmp(big.NewInt(0)) == 1 {
+		return nil, fmt.Errorf("some one has voted %s tickets, can not thaw now", voted.String())
 	}
 
 	// 比较投票状态
@@ -324,15 +356,18 @@ func (t *KernMethod) CheckVoteResult(ctx base.KContext) (*base.Response, error)
 	if err != nil {
 		return nil, fmt.Errorf("CheckVoteResult failed, query govern token totalsupply error")
 	}
-	threadTickets := big.NewInt(0)
-	threadTickets.SetString(string(totalSupplyRes.Body), 10)
-	voteThread := big.NewInt(0)
-	voteThread.SetString(proposal.Args["min_vote_percent"].(string), 10)
-	threadTickets = threadTickets.Mul(threadTickets, voteThread).Div(threadTickets, big.NewInt(100))
+	totalSupply := big.NewInt(0)
+	totalSupply.SetString(string(totalSupplyRes.Body), 10)
 
 	// 统计投票结果
-	if proposal.VoteAmount.Cmp(threadTickets) == -1 {
+	if !isPassed(proposal, totalSupply) {
 		proposal.Status = utils.ProposalStatusRejected
+	} else if proposal.Trigger == nil {
+		// 没有trigger的提案用于COMMUNITY_VOTE授权，交易通过引用提案id完成授权，直接解锁治理代币
+		proposal.Status = utils.ProposalStatusPassed
+		if t.unlockGovernTokensForProposal(ctx, string(proposalIDBuf)) != nil {
+			return nil, fmt.Errorf("proposal check vote failed, unlock govern token error")
+		}
 	} else {
 		proposal.Status = utils.ProposalStatusPassed
 		// 增加定时任务，回调proposal.Trigger
@@ -542,25 +577,43 @@ func (t *KernMethod) unParse(proposal *utils.Proposal) ([]byte, error) {
 }
 
 func checkProposalArgs(proposal *utils.Proposal) error {
-	if proposal.Args["min_vote_percent"] == "" || proposal.Args["stop_vote_height"] == "" {
-		return fmt.Errorf("no min_vote_percent or stop_vote_height found")
+	stopVoteHeight, ok := proposal.Args["stop_vote_height"].(string)
+	if !ok || stopVoteHeight == "" {
+		return fmt.Errorf("no stop_vote_height found")
 	}
 
-	err := checkVoteThread(proposal.Args["min_vote_percent"].(string))
-	if err != nil {
-		return err
+	// 有表决规则的提案不需要min_vote_percent
+	if proposal.Rule == nil {
+		minVotePercent, ok := proposal.Args["min_vote_percent"].(string)
+		if !ok || minVotePercent == "" {
+			return fmt.Errorf("no min_vote_percent found")
+		}
+		err := checkVoteThread(minVotePercent)
+		if err != nil {
+			return err
+		}
 	}
 
-	voteStopHeight, err := parseVoteStopHeight(proposal.Args["stop_vote_height"].(string))
+	voteStopHeight, err := parseVoteStopHeight(stopVoteHeight)
 	if err != nil {
 		return err
 	}
 
+	// COMMUNITY_VOTE授权提案需绑定被授权的发起人、合约方法和参数摘要
+	if _, ok := proposal.Args["acl_target"]; ok {
+		for _, key := range []string{aclBase.ProposalInitiatorArg, aclBase.ProposalContractArg,
+			aclBase.ProposalMethodArg, aclBase.ProposalArgsHashArg} {
+			if value, _ := proposal.Args[key].(string); value == "" {
+				return fmt.Errorf("no %s found", key)
+			}
+		}
+	}
+
 	// 判断 voteStopHeight 大于当前高度
 	// todo
 
 	// 判断 trigger.Height 大于 voteStopHeight
-	if proposal.Trigger.Height != 0 {
+	if proposal.Trigger != nil && proposal.Trigger.Height != 0 {
 		triggerHeight := big.NewInt(proposal.Trigger.Height)
 		if triggerHeight.Cmp(voteStopHeight) != 1 {
 			return fmt.Errorf("trigger_height must be bigger than stop_vote_height")
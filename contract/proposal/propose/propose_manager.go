@@ -27,6 +27,11 @@ func NewProposeManager(ctx *ProposeCtx) (ProposeManager, error) {
 	register.RegisterKernMethod(utils.ProposalKernelContract, "CheckVoteResult", t.CheckVoteResult)
 	register.RegisterKernMethod(utils.ProposalKernelContract, "Trigger", t.Trigger)
 	register.RegisterKernMethod(utils.ProposalKernelContract, "Query", t.Query)
+	register.RegisterKernMethod(utils.ProposalKernelContract, "Delegate", t.Delegate)
+	register.RegisterKernMethod(utils.ProposalKernelContract, "Undelegate", t.Undelegate)
+	register.RegisterKernMethod(utils.ProposalKernelContract, "QueryDelegate", t.QueryDelegate)
+	register.RegisterKernMethod(utils.ProposalKernelContract, "SetVoteRule", t.SetVoteRule)
+	register.RegisterKernMethod(utils.ProposalKernelContract, "QueryVoteRule", t.QueryVoteRule)
 
 	mg := &Manager{
 		Ctx: ctx,
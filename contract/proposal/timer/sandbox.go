@@ -0,0 +1,24 @@
+package timer
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/wooyang2018/corechain/contract/base"
+)
+
+// ErrTransferInTimerTx 定时交易只携带读写集，不携带utxo输入输出，周期任务调用的合约不能转账
+var ErrTransferInTimerTx = errors.New("transfer is not allowed in scheduled call")
+
+type taskSandbox struct {
+	base.StateSandbox
+}
+
+// NewTaskSandbox 生成定时交易使用的沙盒，拒绝合约转账，避免转账在定时交易中被丢弃
+func NewTaskSandbox(state base.StateSandbox) base.StateSandbox {
+	return &taskSandbox{StateSandbox: state}
+}
+
+func (s *taskSandbox) Transfer(from, to string, amount *big.Int) error {
+	return ErrTransferInTimerTx
+}
@@ -0,0 +1,324 @@
+package timer
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+
+	"github.com/wooyang2018/corechain/contract/base"
+	"github.com/wooyang2018/corechain/contract/proposal/utils"
+)
+
+// Schedule 发起周期性合约调用，并将amount转入合约托管，用于支付每次执行的手续费
+// 被调合约在定时交易中执行，不能转账，转账会返回错误
+func (t *KernMethod) Schedule(ctx base.KContext) (*base.Response, error) {
+	args := ctx.Args()
+	task := &ScheduledTask{
+		Owner:    ctx.Initiator(),
+		Module:   string(args["module"]),
+		Contract: string(args["contract"]),
+		Method:   string(args["method"]),
+		Args:     make(map[string]string),
+		Status:   TaskStatusActive,
+	}
+	if task.Module == "" || task.Contract == "" || task.Method == "" {
+		return nil, fmt.Errorf("schedule failed, module, contract or method is nil")
+	}
+	// 内核合约信任$timer_task发起的调用，不允许用户任务调用
+	if task.Module == "xkernel" {
+		return nil, fmt.Errorf("schedule failed, kernel contract is not allowed")
+	}
+	if argsBuf := args["args"]; len(argsBuf) > 0 {
+		if err := json.Unmarshal(argsBuf, &task.Args); err != nil {
+			return nil, fmt.Errorf("schedule failed, unmarshal args error: %v", err)
+		}
+	}
+
+	var err error
+	if task.IntervalBlocks, err = parseOptionalInt(args, "interval_blocks"); err != nil {
+		return nil, err
+	}
+	if task.IntervalSeconds, err = parseOptionalInt(args, "interval_seconds"); err != nil {
+		return nil, err
+	}
+	if (task.IntervalBlocks > 0) == (task.IntervalSeconds > 0) {
+		return nil, fmt.Errorf("schedule failed, exactly one of interval_blocks and interval_seconds should be set")
+	}
+	if task.NextHeight, err = parseOptionalInt(args, "start_height"); err != nil {
+		return nil, err
+	}
+	if task.NextTime, err = parseOptionalInt(args, "start_time"); err != nil {
+		return nil, err
+	}
+	if task.MaxRuns, err = parseOptionalInt(args, "max_runs"); err != nil {
+		return nil, err
+	}
+
+	feePerRun, ok := new(big.Int).SetString(string(args["fee_per_run"]), 10)
+	if !ok || feePerRun.Cmp(big.NewInt(minFeePerRun)) < 0 {
+		return nil, fmt.Errorf("schedule failed, fee_per_run should be no less than %d", minFeePerRun)
+	}
+	amount, ok := new(big.Int).SetString(string(args["amount"]), 10)
+	if !ok || amount.Cmp(feePerRun) < 0 {
+		return nil, fmt.Errorf("schedule failed, amount should be no less than fee_per_run")
+	}
+	task.FeePerRun = feePerRun.String()
+	task.Escrow = amount.String()
+
+	if err := ctx.Transfer(task.Owner, utils.TimerTaskKernelContract, amount); err != nil {
+		return nil, fmt.Errorf("schedule failed, transfer to timer task error: %v", err)
+	}
+
+	task.ID, err = t.getNextTaskID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("schedule failed, get task_id err")
+	}
+	if err := ctx.Put(utils.GetTimerBucket(), utils.GetTaskIDKey(), []byte(task.ID)); err != nil {
+		return nil, err
+	}
+	if err := ctx.Put(utils.GetTimerBucket(), makeActiveKey(task.ID), []byte(task.ID)); err != nil {
+		return nil, err
+	}
+	if err := t.saveTask(ctx, task); err != nil {
+		return nil, err
+	}
+
+	ctx.AddResourceUsed(base.Limits{
+		XFee: 0,
+	})
+
+	return &base.Response{
+		Status:  utils.StatusOK,
+		Message: "success",
+		Body:    []byte(task.ID),
+	}, nil
+}
+
+// Cancel 取消任务并退还剩余托管，已结束的任务也可通过Cancel取回剩余托管
+func (t *KernMethod) Cancel(ctx base.KContext) (*base.Response, error) {
+	taskID := string(ctx.Args()["task_id"])
+	task, err := t.getTask(ctx, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("cancel failed, err: %v", err)
+	}
+	if task.Owner != ctx.Initiator() {
+		return nil, fmt.Errorf("no authority to cancel: %s", ctx.Initiator())
+	}
+	if task.Status == TaskStatusCancelled {
+		return nil, fmt.Errorf("task %s has been cancelled", taskID)
+	}
+
+	if task.Status == TaskStatusActive {
+		task.Status = TaskStatusCancelled
+		if err := ctx.Del(utils.GetTimerBucket(), makeActiveKey(taskID)); err != nil {
+			return nil, err
+		}
+	}
+	escrow, _ := new(big.Int).SetString(task.Escrow, 10)
+	if escrow.Sign() > 0 {
+		if err := ctx.Transfer(utils.TimerTaskKernelContract, task.Owner, escrow); err != nil {
+			return nil, fmt.Errorf("cancel failed, refund error: %v", err)
+		}
+	}
+	task.Escrow = "0"
+	if err := t.saveTask(ctx, task); err != nil {
+		return nil, err
+	}
+
+	ctx.AddResourceUsed(base.Limits{
+		XFee: 0,
+	})
+
+	return &base.Response{
+		Status:  utils.StatusOK,
+		Message: "success",
+		Body:    nil,
+	}, nil
+}
+
+// QueryTask 查询任务详情
+func (t *KernMethod) QueryTask(ctx base.KContext) (*base.Response, error) {
+	taskBuf, err := ctx.Get(utils.GetTimerBucket(), makeTaskKey(string(ctx.Args()["task_id"])))
+	if err != nil {
+		return nil, fmt.Errorf("query failed, no task found, err: %v", err)
+	}
+	return &base.Response{
+		Status:  utils.StatusOK,
+		Message: "success",
+		Body:    taskBuf,
+	}, nil
+}
+
+// QueryRuns 按执行顺序查询任务的每次执行结果
+func (t *KernMethod) QueryRuns(ctx base.KContext) (*base.Response, error) {
+	taskID := string(ctx.Args()["task_id"])
+	if _, err := t.getTask(ctx, taskID); err != nil {
+		return nil, fmt.Errorf("query runs failed, err: %v", err)
+	}
+	prefix := []byte(makeRunPrefix(taskID))
+	iter, err := ctx.Select(utils.GetTimerBucket(), prefix, utils.PrefixRange(prefix))
+	if err != nil {
+		return nil, fmt.Errorf("query runs failed, generate runs iterator error")
+	}
+	defer iter.Close()
+	runs := make([]*TaskRun, 0)
+	for iter.Next() {
+		run := &TaskRun{}
+		if err := json.Unmarshal(iter.Value(), run); err != nil {
+			return nil, err
+		}
+		runs = append(runs, run)
+	}
+	runsBuf, err := json.Marshal(runs)
+	if err != nil {
+		return nil, err
+	}
+	return &base.Response{
+		Status:  utils.StatusOK,
+		Message: "success",
+		Body:    runsBuf,
+	}, nil
+}
+
+// doScheduledTasks 执行到期的周期任务，单个任务执行失败只记录结果，不影响其他任务，
+// 每个区块最多执行maxTasksPerBlock个，其余到期任务顺延，下个区块从上次停止处轮转执行
+func (t *KernMethod) doScheduledTasks(ctx base.KContext, height, blockTime int64) error {
+	prefix := []byte(activePrefix)
+	iter, err := ctx.Select(utils.GetTimerBucket(), prefix, utils.PrefixRange(prefix))
+	if err != nil {
+		return fmt.Errorf("do scheduled tasks failed, generate tasks iterator error")
+	}
+	taskIDs := make([]string, 0)
+	for iter.Next() {
+		taskIDs = append(taskIDs, string(iter.Value()))
+	}
+	iter.Close()
+
+	if cursor, err := ctx.Get(utils.GetTimerBucket(), []byte(scheduleCursorKey)); err == nil {
+		start := sort.SearchStrings(taskIDs, string(cursor))
+		taskIDs = append(taskIDs[start:], taskIDs[:start]...)
+	}
+
+	executed := 0
+	for _, taskID := range taskIDs {
+		if executed >= maxTasksPerBlock {
+			return ctx.Put(utils.GetTimerBucket(), []byte(scheduleCursorKey), []byte(taskID))
+		}
+		task, err := t.getTask(ctx, taskID)
+		if err != nil {
+			return err
+		}
+		if !task.isDue(height, blockTime) {
+			continue
+		}
+		if err := t.runTask(ctx, task, height, blockTime); err != nil {
+			return err
+		}
+		executed++
+	}
+	return nil
+}
+
+func (t *KernMethod) runTask(ctx base.KContext, task *ScheduledTask, height, blockTime int64) error {
+	escrow, _ := new(big.Int).SetString(task.Escrow, 10)
+	feePerRun, _ := new(big.Int).SetString(task.FeePerRun, 10)
+	if escrow.Cmp(feePerRun) < 0 {
+		task.Status = TaskStatusExhausted
+		return t.finishTask(ctx, task)
+	}
+	// 手续费在执行前扣除并销毁，执行失败也不退还
+	task.Escrow = escrow.Sub(escrow, feePerRun).String()
+	task.Runs++
+	if err := t.burnFee(ctx, feePerRun); err != nil {
+		return err
+	}
+
+	callArgs := make(map[string][]byte, len(task.Args)+2)
+	for k, v := range task.Args {
+		callArgs[k] = []byte(v)
+	}
+	callArgs[taskIDArgKey] = []byte(task.ID)
+	callArgs[taskOwnerArgKey] = []byte(task.Owner)
+
+	run := &TaskRun{
+		Run:    task.Runs,
+		Height: height,
+		Time:   blockTime,
+		Status: RunStatusSuccess,
+	}
+	resp, err := ctx.CallWithLimits(task.Module, task.Contract, task.Method, callArgs, feeToLimits(feePerRun))
+	if err != nil {
+		run.Status = RunStatusFailed
+		run.Message = err.Error()
+	} else if resp.Status >= base.StatusErrorThreshold {
+		run.Status = RunStatusFailed
+		run.Message = resp.Message
+	}
+	runBuf, err := json.Marshal(run)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Put(utils.GetTimerBucket(), makeRunKey(task.ID, run.Run), runBuf); err != nil {
+		return err
+	}
+	task.LastRunStatus = run.Status
+
+	if task.IntervalBlocks > 0 {
+		task.NextHeight = height + task.IntervalBlocks
+	} else {
+		task.NextTime = blockTime + task.IntervalSeconds
+	}
+	if task.MaxRuns > 0 && task.Runs >= task.MaxRuns {
+		task.Status = TaskStatusFinished
+		return t.finishTask(ctx, task)
+	}
+	return t.saveTask(ctx, task)
+}
+
+func (t *KernMethod) burnFee(ctx base.KContext, fee *big.Int) error {
+	burned := big.NewInt(0)
+	if buf, err := ctx.Get(utils.GetTimerBucket(), []byte(burnedFeeKey)); err == nil {
+		burned.SetString(string(buf), 10)
+	}
+	return ctx.Put(utils.GetTimerBucket(), []byte(burnedFeeKey), []byte(burned.Add(burned, fee).String()))
+}
+
+func (t *KernMethod) finishTask(ctx base.KContext, task *ScheduledTask) error {
+	if err := ctx.Del(utils.GetTimerBucket(), makeActiveKey(task.ID)); err != nil {
+		return err
+	}
+	return t.saveTask(ctx, task)
+}
+
+func (t *KernMethod) getTask(ctx base.KContext, taskID string) (*ScheduledTask, error) {
+	if taskID == "" {
+		return nil, fmt.Errorf("task_id is nil")
+	}
+	taskBuf, err := ctx.Get(utils.GetTimerBucket(), makeTaskKey(taskID))
+	if err != nil {
+		return nil, fmt.Errorf("task %s not found", taskID)
+	}
+	return parseTask(taskBuf)
+}
+
+func (t *KernMethod) saveTask(ctx base.KContext, task *ScheduledTask) error {
+	taskBuf, err := json.Marshal(task)
+	if err != nil {
+		return fmt.Errorf("save task failed, marshal task error")
+	}
+	return ctx.Put(utils.GetTimerBucket(), makeTaskKey(task.ID), taskBuf)
+}
+
+func parseOptionalInt(args map[string][]byte, key string) (int64, error) {
+	buf, ok := args[key]
+	if !ok || len(buf) == 0 {
+		return 0, nil
+	}
+	value, err := strconv.ParseInt(string(buf), 10, 64)
+	if err != nil || value < 0 {
+		return 0, fmt.Errorf("invalid %s %s", key, buf)
+	}
+	return value, nil
+}
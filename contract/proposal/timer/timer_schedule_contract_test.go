@@ -0,0 +1,338 @@
+package timer
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/wooyang2018/corechain/contract/base"
+	"github.com/wooyang2018/corechain/contract/proposal/utils"
+)
+
+type fakeIterator struct {
+	keys   []string
+	values [][]byte
+	idx    int
+}
+
+func (it *fakeIterator) Key() []byte   { return []byte(it.keys[it.idx-1]) }
+func (it *fakeIterator) Value() []byte { return it.values[it.idx-1] }
+func (it *fakeIterator) Next() bool    { it.idx++; return it.idx <= len(it.keys) }
+func (it *fakeIterator) Error() error  { return nil }
+func (it *fakeIterator) Close()        {}
+
+type fakeKContext struct {
+	base.KContext
+	initiator string
+	args      map[string][]byte
+	data      map[string][]byte
+	balances  map[string]*big.Int
+	calls     int
+	failCall  bool
+	limits    base.Limits
+	// 被调合约通过该沙盒转账
+	callState base.StateSandbox
+}
+
+func newFakeKContext(initiator string, balance int64) *fakeKContext {
+	return &fakeKContext{
+		initiator: initiator,
+		data:      make(map[string][]byte),
+		balances:  map[string]*big.Int{initiator: big.NewInt(balance)},
+	}
+}
+
+func (c *fakeKContext) Args() map[string][]byte { return c.args }
+
+func (c *fakeKContext) Initiator() string { return c.initiator }
+
+func (c *fakeKContext) Get(bucket string, key []byte) ([]byte, error) {
+	value, ok := c.data[bucket+"/"+string(key)]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return value, nil
+}
+
+func (c *fakeKContext) Put(bucket string, key, value []byte) error {
+	c.data[bucket+"/"+string(key)] = value
+	return nil
+}
+
+func (c *fakeKContext) Del(bucket string, key []byte) error {
+	delete(c.data, bucket+"/"+string(key))
+	return nil
+}
+
+func (c *fakeKContext) Select(bucket string, startKey []byte, endKey []byte) (base.Iterator, error) {
+	it := &fakeIterator{}
+	for k := range c.data {
+		if !strings.HasPrefix(k, bucket+"/") {
+			continue
+		}
+		key := strings.TrimPrefix(k, bucket+"/")
+		if key >= string(startKey) && key < string(endKey) {
+			it.keys = append(it.keys, key)
+		}
+	}
+	sort.Strings(it.keys)
+	for _, key := range it.keys {
+		it.values = append(it.values, c.data[bucket+"/"+key])
+	}
+	return it, nil
+}
+
+func (c *fakeKContext) Transfer(from, to string, amount *big.Int) error {
+	if c.balance(from).Cmp(amount) < 0 {
+		return errors.New("balance not enough")
+	}
+	c.balance(from).Sub(c.balance(from), amount)
+	c.balance(to).Add(c.balance(to), amount)
+	return nil
+}
+
+func (c *fakeKContext) Call(module, contract, method string, args map[string][]byte) (*base.Response, error) {
+	c.calls++
+	if c.failCall {
+		return nil, errors.New("call failed")
+	}
+	if c.callState != nil {
+		if err := c.callState.Transfer(contract, "bob", big.NewInt(1)); err != nil {
+			return nil, err
+		}
+	}
+	return &base.Response{Status: utils.StatusOK}, nil
+}
+
+func (c *fakeKContext) CallWithLimits(module, contract, method string, args map[string][]byte, limits base.Limits) (*base.Response, error) {
+	c.limits = limits
+	return c.Call(module, contract, method, args)
+}
+
+func (c *fakeKContext) AddResourceUsed(delta base.Limits) {}
+
+func (c *fakeKContext) balance(addr string) *big.Int {
+	if _, ok := c.balances[addr]; !ok {
+		c.balances[addr] = big.NewInt(0)
+	}
+	return c.balances[addr]
+}
+
+func doBlock(t *testing.T, m *KernMethod, ctx *fakeKContext, height, blockTime string) {
+	ctx.args = map[string][]byte{"block_height": []byte(height), "block_time": []byte(blockTime)}
+	if _, err := m.Do(ctx); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestScheduleEveryNBlocks(t *testing.T) {
+	ctx := newFakeKContext("alice", 1000)
+	m := NewKernContractMethod("corechain")
+
+	ctx.args = map[string][]byte{
+		"module":          []byte("xkernel"),
+		"contract":        []byte(utils.ProposalKernelContract),
+		"method":          []byte("Trigger"),
+		"interval_blocks": []byte("2"),
+		"fee_per_run":     []byte("100"),
+		"amount":          []byte("250"),
+	}
+	if _, err := m.Schedule(ctx); err == nil {
+		t.Fatal("schedule a kernel contract should fail")
+	}
+	ctx.args["module"] = []byte("wasm")
+	ctx.args["fee_per_run"] = []byte("99")
+	if _, err := m.Schedule(ctx); err == nil {
+		t.Fatal("schedule with fee_per_run less than minimum should fail")
+	}
+	ctx.args["fee_per_run"] = []byte("100")
+	ctx.args["contract"] = []byte("settle")
+	ctx.args["start_height"] = []byte("10")
+	resp, err := m.Schedule(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	taskID := string(resp.Body)
+
+	doBlock(t, m, ctx, "9", "0")
+	doBlock(t, m, ctx, "10", "0")
+	doBlock(t, m, ctx, "11", "0")
+	ctx.failCall = true
+	doBlock(t, m, ctx, "12", "0")
+	// 剩余托管不足一次执行
+	doBlock(t, m, ctx, "14", "0")
+	if ctx.calls != 2 {
+		t.Fatalf("expect 2 calls, got %d", ctx.calls)
+	}
+
+	task, err := m.getTask(ctx, taskID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if task.Status != TaskStatusExhausted || task.Runs != 2 || task.Escrow != "50" ||
+		task.LastRunStatus != RunStatusFailed {
+		t.Fatalf("unexpected task %+v", task)
+	}
+	if ctx.limits.Cpu != 100*feeCpuRate || ctx.limits.XFee != 100 {
+		t.Fatalf("unexpected call limits %+v", ctx.limits)
+	}
+	if burned, _ := ctx.Get(utils.GetTimerBucket(), []byte(burnedFeeKey)); string(burned) != "200" {
+		t.Fatalf("unexpected burned fee %s", burned)
+	}
+
+	ctx.args = map[string][]byte{"task_id": []byte(taskID)}
+	resp, err = m.QueryRuns(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var runs []*TaskRun
+	json.Unmarshal(resp.Body, &runs)
+	if len(runs) != 2 || runs[0].Height != 10 || runs[0].Status != RunStatusSuccess ||
+		runs[1].Height != 12 || runs[1].Status != RunStatusFailed {
+		t.Fatalf("unexpected runs %+v", runs)
+	}
+
+	// 取回剩余托管
+	if _, err := m.Cancel(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if ctx.balance("alice").Int64() != 800 {
+		t.Fatalf("unexpected balance %d", ctx.balance("alice").Int64())
+	}
+}
+
+func TestScheduleByTimeAndCancel(t *testing.T) {
+	ctx := newFakeKContext("alice", 1000)
+	m := NewKernContractMethod("corechain")
+
+	ctx.args = map[string][]byte{
+		"module":           []byte("wasm"),
+		"contract":         []byte("settle"),
+		"method":           []byte("run"),
+		"interval_seconds": []byte("60"),
+		"start_time":       []byte("1000"),
+		"max_runs":         []byte("5"),
+		"fee_per_run":      []byte("100"),
+		"amount":           []byte("500"),
+	}
+	resp, err := m.Schedule(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	taskID := string(resp.Body)
+
+	doBlock(t, m, ctx, "1", "999")
+	doBlock(t, m, ctx, "2", "1001")
+	doBlock(t, m, ctx, "3", "1030")
+	doBlock(t, m, ctx, "4", "1061")
+	if ctx.calls != 2 {
+		t.Fatalf("expect 2 calls, got %d", ctx.calls)
+	}
+
+	ctx.initiator = "bob"
+	ctx.args = map[string][]byte{"task_id": []byte(taskID)}
+	if _, err := m.Cancel(ctx); err == nil {
+		t.Fatal("only owner could cancel")
+	}
+	ctx.initiator = "alice"
+	if _, err := m.Cancel(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if ctx.balance("alice").Int64() != 800 {
+		t.Fatalf("unexpected balance %d", ctx.balance("alice").Int64())
+	}
+	doBlock(t, m, ctx, "5", "2000")
+	if ctx.calls != 2 {
+		t.Fatal("cancelled task should not run")
+	}
+	if _, err := m.Cancel(ctx); err == nil {
+		t.Fatal("cancel twice should fail")
+	}
+}
+
+func TestScheduleTasksPerBlock(t *testing.T) {
+	ctx := newFakeKContext("alice", 100000)
+	m := NewKernContractMethod("corechain")
+
+	total := maxTasksPerBlock + 5
+	for i := 0; i < total; i++ {
+		ctx.args = map[string][]byte{
+			"module":          []byte("wasm"),
+			"contract":        []byte("settle"),
+			"method":          []byte("run"),
+			"interval_blocks": []byte("1"),
+			"fee_per_run":     []byte("100"),
+			"amount":          []byte("1000"),
+		}
+		if _, err := m.Schedule(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// 超出上限的任务顺延，下个区块优先执行
+	doBlock(t, m, ctx, "1", "0")
+	if ctx.calls != maxTasksPerBlock {
+		t.Fatalf("expect %d calls, got %d", maxTasksPerBlock, ctx.calls)
+	}
+	doBlock(t, m, ctx, "2", "0")
+	if ctx.calls != 2*maxTasksPerBlock {
+		t.Fatalf("expect %d calls, got %d", 2*maxTasksPerBlock, ctx.calls)
+	}
+	prefix := []byte(taskPrefix)
+	iter, _ := ctx.Select(utils.GetTimerBucket(), prefix, utils.PrefixRange(prefix))
+	for iter.Next() {
+		task, _ := parseTask(iter.Value())
+		if task.Runs == 0 {
+			t.Fatalf("task %s should be run in the next block", task.ID)
+		}
+	}
+}
+
+func TestScheduledTransfer(t *testing.T) {
+	ctx := newFakeKContext("alice", 1000)
+	m := NewKernContractMethod("corechain")
+
+	ctx.args = map[string][]byte{
+		"module":          []byte("wasm"),
+		"contract":        []byte("settle"),
+		"method":          []byte("pay"),
+		"interval_blocks": []byte("1"),
+		"fee_per_run":     []byte("100"),
+		"amount":          []byte("500"),
+	}
+	resp, err := m.Schedule(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	taskID := string(resp.Body)
+	ctx.balances["settle"] = big.NewInt(10)
+
+	// 定时交易不携带utxo输入输出，被调合约转账失败且余额不变
+	ctx.callState = NewTaskSandbox(ctx)
+	doBlock(t, m, ctx, "1", "0")
+	if ctx.balance("settle").Int64() != 10 || ctx.balance("bob").Int64() != 0 {
+		t.Fatalf("scheduled call should not transfer, settle %d bob %d",
+			ctx.balance("settle").Int64(), ctx.balance("bob").Int64())
+	}
+
+	ctx.args = map[string][]byte{"task_id": []byte(taskID)}
+	resp, err = m.QueryRuns(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var runs []*TaskRun
+	json.Unmarshal(resp.Body, &runs)
+	if len(runs) != 1 || runs[0].Status != RunStatusFailed || runs[0].Message != ErrTransferInTimerTx.Error() {
+		t.Fatalf("unexpected runs %+v", runs)
+	}
+
+	// 未经过定时交易沙盒的调用可以转账
+	ctx.callState = ctx
+	doBlock(t, m, ctx, "2", "0")
+	if ctx.balance("settle").Int64() != 9 || ctx.balance("bob").Int64() != 1 {
+		t.Fatal("transfer without task sandbox should succeed")
+	}
+}
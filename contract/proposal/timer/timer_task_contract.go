@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"strconv"
 
 	"github.com/wooyang2018/corechain/contract/base"
 	"github.com/wooyang2018/corechain/contract/proposal/utils"
@@ -71,12 +72,25 @@ func (t *KernMethod) Do(ctx base.KContext) (*base.Response, error) {
 	if err != nil {
 		return nil, fmt.Errorf("do timer tasks failed, generate proposals iterator error")
 	}
-	defer iter.Close()
 	for iter.Next() {
 		// 触发交易
 		triggerBuf := iter.Value()
 		t.Trigger(ctx, triggerBuf)
 	}
+	iter.Close()
+
+	// 执行到期的周期任务，block_time为父区块的出块时间(秒)
+	blockHeight, err := strconv.ParseInt(string(blockHeightBuf), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("do timer tasks failed, invalid block_height")
+	}
+	blockTime, err := parseOptionalInt(args, "block_time")
+	if err != nil {
+		return nil, err
+	}
+	if err := t.doScheduledTasks(ctx, blockHeight, blockTime); err != nil {
+		return nil, err
+	}
 
 	delta := base.Limits{
 		XFee: 0,
@@ -22,6 +22,10 @@ func NewTimerTaskManager(ctx *TimerCtx) (TimerManager, error) {
 	register := ctx.Contract.GetKernRegistry()
 	register.RegisterKernMethod(utils.TimerTaskKernelContract, "Add", t.Add)
 	register.RegisterKernMethod(utils.TimerTaskKernelContract, "Do", t.Do)
+	register.RegisterKernMethod(utils.TimerTaskKernelContract, "Schedule", t.Schedule)
+	register.RegisterKernMethod(utils.TimerTaskKernelContract, "Cancel", t.Cancel)
+	register.RegisterKernMethod(utils.TimerTaskKernelContract, "QueryTask", t.QueryTask)
+	register.RegisterKernMethod(utils.TimerTaskKernelContract, "QueryRuns", t.QueryRuns)
 
 	mg := &Manager{
 		Ctx: ctx,
@@ -0,0 +1,136 @@
+package timer
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/wooyang2018/corechain/contract/base"
+)
+
+const (
+	TaskStatusActive    = "active"
+	TaskStatusCancelled = "cancelled"
+	TaskStatusFinished  = "finished"
+	// 托管的手续费不足以支付下一次执行
+	TaskStatusExhausted = "exhausted"
+
+	RunStatusSuccess = "success"
+	RunStatusFailed  = "failed"
+)
+
+const (
+	taskPrefix   = "task_"
+	activePrefix = "active_"
+	runPrefix    = "run_"
+
+	taskIDArgKey    = "$task_id"
+	taskOwnerArgKey = "$task_owner"
+
+	// 累计扣除的手续费，$timer_task没有转出这部分资产的途径，扣除即销毁
+	burnedFeeKey = "burned_fee"
+	// 上个区块因数量上限停止执行的位置，下个区块从该任务开始轮转
+	scheduleCursorKey = "schedule_cursor"
+)
+
+const (
+	// 单次执行的最低手续费
+	minFeePerRun = 100
+	// 每个区块最多执行的周期任务数，超出的到期任务顺延到后续区块
+	maxTasksPerBlock = 20
+)
+
+// 每单位手续费可用的资源，与默认gas_price一致
+const (
+	feeCpuRate  = 1000
+	feeMemRate  = 1000000
+	feeDiskRate = 1
+)
+
+// ScheduledTask 用户发起的周期性合约调用，按区块间隔或出块时间间隔执行
+type ScheduledTask struct {
+	ID       string            `json:"id"`
+	Owner    string            `json:"owner"`
+	Module   string            `json:"module"`
+	Contract string            `json:"contract"`
+	Method   string            `json:"method"`
+	Args     map[string]string `json:"args,omitempty"`
+
+	// 两者只能设置一个
+	IntervalBlocks  int64 `json:"interval_blocks,omitempty"`
+	IntervalSeconds int64 `json:"interval_seconds,omitempty"`
+	NextHeight      int64 `json:"next_height,omitempty"`
+	NextTime        int64 `json:"next_time,omitempty"`
+
+	// MaxRuns为0时不限制执行次数
+	MaxRuns int64 `json:"max_runs,omitempty"`
+	Runs    int64 `json:"runs"`
+
+	// 每次执行从托管余额中扣除并销毁FeePerRun，取消时退还剩余部分，被调合约的资源上限由FeePerRun折算
+	FeePerRun string `json:"fee_per_run"`
+	Escrow    string `json:"escrow"`
+
+	Status        string `json:"status"`
+	LastRunStatus string `json:"last_run_status,omitempty"`
+}
+
+// TaskRun 一次执行的结果
+type TaskRun struct {
+	Run     int64  `json:"run"`
+	Height  int64  `json:"height"`
+	Time    int64  `json:"time,omitempty"`
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+// isDue 判断任务在该区块是否需要执行，blockTime为0时不执行按时间调度的任务
+func (task *ScheduledTask) isDue(height, blockTime int64) bool {
+	if task.IntervalBlocks > 0 {
+		return height >= task.NextHeight
+	}
+	return blockTime > 0 && blockTime >= task.NextTime
+}
+
+// feeToLimits 将单次执行的手续费折算为被调合约的资源上限
+func feeToLimits(fee *big.Int) base.Limits {
+	if !fee.IsInt64() {
+		return base.MaxLimits
+	}
+	n := fee.Int64()
+	scale := func(rate, max int64) int64 {
+		if n > max/rate {
+			return max
+		}
+		return n * rate
+	}
+	return base.Limits{
+		Cpu:    scale(feeCpuRate, base.MaxLimits.Cpu),
+		Memory: scale(feeMemRate, base.MaxLimits.Memory),
+		Disk:   scale(feeDiskRate, base.MaxLimits.Disk),
+		XFee:   scale(1, base.MaxLimits.XFee),
+	}
+}
+
+func parseTask(taskBuf []byte) (*ScheduledTask, error) {
+	task := &ScheduledTask{}
+	if err := json.Unmarshal(taskBuf, task); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+func makeTaskKey(taskID string) []byte {
+	return []byte(taskPrefix + taskID)
+}
+
+func makeActiveKey(taskID string) []byte {
+	return []byte(activePrefix + taskID)
+}
+
+func makeRunPrefix(taskID string) string {
+	return runPrefix + taskID + "_"
+}
+
+func makeRunKey(taskID string, run int64) []byte {
+	return []byte(fmt.Sprintf("%s%020d", makeRunPrefix(taskID), run))
+}
@@ -15,6 +15,10 @@ const (
 	ProposalStatusPassed              = "passed"
 	ProposalStatusCompletedAndFailure = "completed_failure"
 	ProposalStatusCompletedAndSuccess = "completed_success"
+
+	VoteOptionYes     = "yes"
+	VoteOptionNo      = "no"
+	VoteOptionAbstain = "abstain"
 )
 
 const (
@@ -39,9 +43,22 @@ type Proposal struct {
 	Args    map[string]interface{} `json:"args"`
 	Trigger *TriggerDesc           `json:"trigger"`
 
-	VoteAmount *big.Int `json:"vote_amount"`
-	Status     string   `json:"status"`
-	Proposer   string   `json:"proposer"`
+	// VoteAmount为赞成票数，反对票和弃权票只参与法定人数统计
+	VoteAmount    *big.Int `json:"vote_amount"`
+	NoAmount      *big.Int `json:"no_amount,omitempty"`
+	AbstainAmount *big.Int `json:"abstain_amount,omitempty"`
+	Status        string   `json:"status"`
+	Proposer      string   `json:"proposer"`
+
+	// 触发的合约方法对应的表决规则，提案时从链上读取，为空时使用min_vote_percent
+	Rule *VoteRule `json:"rule,omitempty"`
+}
+
+// VoteRule 提案类型的表决规则
+// 投票总数(赞成、反对、弃权)达到治理代币总额的QuorumPercent，且赞成票不低于赞成与反对票之和的ApprovePercent时提案通过
+type VoteRule struct {
+	QuorumPercent  int64 `json:"quorum_percent"`
+	ApprovePercent int64 `json:"approve_percent"`
 }
 
 // TriggerDesc is the description to trigger a event used by proposal
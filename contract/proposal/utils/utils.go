@@ -1,5 +1,7 @@
 package utils
 
+import "strings"
+
 const (
 	StatusOK        = 200
 	StatusException = 500
@@ -20,6 +22,9 @@ const (
 	proposalBucket  = "proposal"
 	proposalIDKey   = "id"
 	proposalLockKey = "lock"
+	delegateKey     = "delegate"
+	voteRuleKey     = "rule"
+	proposalUsedKey = "used"
 )
 
 // GetGovernTokenBucket return the govern token bucket name
@@ -97,6 +102,43 @@ func MakeProposalLockPrefixSeparator(proposalID string) string {
 	return proposalLockKey + separator + proposalID + separator + prefixEnd
 }
 
+// MakeProposalUsedKey generate the key marking a proposal consumed by the tx referencing it
+func MakeProposalUsedKey(proposalID string) string {
+	return proposalUsedKey + separator + proposalID
+}
+
+// MakeDelegateKey generate the key of account's governance delegate
+func MakeDelegateKey(account string) string {
+	return delegateKey + separator + account
+}
+
+// MakeVoteRuleKey generate the key of proposal type's vote rule
+func MakeVoteRuleKey(proposalType string) string {
+	return voteRuleKey + separator + proposalType
+}
+
+// MakeProposalType 提案类型由触发的合约方法确定，格式为module.contract.method，没有触发器时为空
+func MakeProposalType(trigger *TriggerDesc) string {
+	if trigger == nil {
+		return ""
+	}
+	return trigger.Module + "." + trigger.Contract + "." + trigger.Method
+}
+
+// IsProposalType check whether the proposal type is module.contract.method
+func IsProposalType(proposalType string) bool {
+	parts := strings.Split(proposalType, ".")
+	if len(parts) != 3 {
+		return false
+	}
+	for _, part := range parts {
+		if part == "" {
+			return false
+		}
+	}
+	return true
+}
+
 // PrefixRange returns key range that satisfy the given prefix
 func PrefixRange(prefix []byte) []byte {
 	var limit []byte
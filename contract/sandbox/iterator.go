@@ -88,6 +88,11 @@ func newMultiIterator(front, back ledger.XIterator) ledger.XIterator {
 	return m
 }
 
+// NewMultiIterator 合并两个有序迭代器，key相同时以front为准
+func NewMultiIterator(front, back ledger.XIterator) ledger.XIterator {
+	return newMultiIterator(front, back)
+}
+
 func (m *multiIterator) Key() []byte {
 	return m.key
 }
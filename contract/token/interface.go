@@ -0,0 +1,10 @@
+package token
+
+import (
+	"math/big"
+)
+
+type TokenManager interface {
+	GetAsset(symbol string) (*Asset, error)
+	GetBalance(symbol, account string) (*big.Int, error)
+}
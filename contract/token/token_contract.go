@@ -0,0 +1,422 @@
+package token
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/wooyang2018/corechain/contract/base"
+	"github.com/wooyang2018/corechain/protos"
+)
+
+type KernMethod struct {
+	BcName string
+}
+
+func NewKernContractMethod(bcName string) *KernMethod {
+	t := &KernMethod{
+		BcName: bcName,
+	}
+	return t
+}
+
+// Create 创建资产，初始发行量归创建者所有
+func (t *KernMethod) Create(ctx base.KContext) (*base.Response, error) {
+	args := ctx.Args()
+	symbol := string(args["symbol"])
+	if !symbolRegexp.MatchString(symbol) {
+		return nil, fmt.Errorf("create failed, symbol should match %s", symbolRegexp.String())
+	}
+	if _, err := ctx.Get(GetTokenBucket(), makeAssetKey(symbol)); err == nil {
+		return nil, fmt.Errorf("create failed, asset %s already exists", symbol)
+	}
+
+	decimals, err := strconv.ParseUint(string(args["decimals"]), 10, 32)
+	if err != nil || decimals > maxDecimals {
+		return nil, fmt.Errorf("create failed, decimals should be in [0, %d]", maxDecimals)
+	}
+	supply, ok := parseAmount(args["supply"])
+	if !ok {
+		return nil, fmt.Errorf("create failed, invalid supply %s", args["supply"])
+	}
+	asset := &Asset{
+		Symbol:      symbol,
+		Name:        string(args["name"]),
+		Decimals:    uint32(decimals),
+		TotalSupply: supply.String(),
+		Owner:       sender(ctx),
+	}
+	if maxSupplyBuf := args["max_supply"]; len(maxSupplyBuf) > 0 {
+		maxSupply, ok := parseAmount(maxSupplyBuf)
+		if !ok || maxSupply.Cmp(supply) < 0 {
+			return nil, fmt.Errorf("create failed, max_supply should be no less than supply")
+		}
+		asset.MaxSupply = maxSupply.String()
+	}
+	if mintersBuf := args["minters"]; len(mintersBuf) > 0 {
+		if err := json.Unmarshal(mintersBuf, &asset.Minters); err != nil {
+			return nil, fmt.Errorf("create failed, minters should be a json array")
+		}
+	}
+
+	if err := t.saveAsset(ctx, asset); err != nil {
+		return nil, err
+	}
+	if err := t.setBalance(ctx, symbol, asset.Owner, supply); err != nil {
+		return nil, err
+	}
+	t.addEvent(ctx, EventCreate, &TokenEvent{Symbol: symbol, To: asset.Owner, Amount: asset.TotalSupply})
+
+	ctx.AddResourceUsed(base.Limits{
+		XFee: createFee,
+	})
+
+	return &base.Response{
+		Status:  statusOK,
+		Message: "success",
+		Body:    nil,
+	}, nil
+}
+
+// Mint 由Owner或Minters增发资产
+func (t *KernMethod) Mint(ctx base.KContext) (*base.Response, error) {
+	args := ctx.Args()
+	asset, err := t.getAsset(ctx, string(args["symbol"]))
+	if err != nil {
+		return nil, fmt.Errorf("mint failed, err: %v", err)
+	}
+	minter := sender(ctx)
+	if !asset.canMint(minter) {
+		return nil, fmt.Errorf("no authority to mint: %s", minter)
+	}
+	to := string(args["to"])
+	if to == "" {
+		to = minter
+	}
+	amount, ok := parseAmount(args["amount"])
+	if !ok || amount.Sign() == 0 {
+		return nil, fmt.Errorf("mint failed, invalid amount %s", args["amount"])
+	}
+
+	totalSupply, _ := new(big.Int).SetString(asset.TotalSupply, 10)
+	totalSupply.Add(totalSupply, amount)
+	if asset.MaxSupply != "" {
+		maxSupply, _ := new(big.Int).SetString(asset.MaxSupply, 10)
+		if totalSupply.Cmp(maxSupply) > 0 {
+			return nil, fmt.Errorf("mint failed, exceed max supply %s", asset.MaxSupply)
+		}
+	}
+	asset.TotalSupply = totalSupply.String()
+
+	balance, err := t.getBalance(ctx, asset.Symbol, to)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.setBalance(ctx, asset.Symbol, to, balance.Add(balance, amount)); err != nil {
+		return nil, err
+	}
+	if err := t.saveAsset(ctx, asset); err != nil {
+		return nil, err
+	}
+	t.addEvent(ctx, EventMint, &TokenEvent{Symbol: asset.Symbol, To: to, Amount: amount.String()})
+
+	ctx.AddResourceUsed(base.Limits{
+		XFee: tokenFee,
+	})
+
+	return &base.Response{
+		Status:  statusOK,
+		Message: "success",
+		Body:    nil,
+	}, nil
+}
+
+// Burn 销毁发起者持有的资产
+func (t *KernMethod) Burn(ctx base.KContext) (*base.Response, error) {
+	args := ctx.Args()
+	asset, err := t.getAsset(ctx, string(args["symbol"]))
+	if err != nil {
+		return nil, fmt.Errorf("burn failed, err: %v", err)
+	}
+	amount, ok := parseAmount(args["amount"])
+	if !ok || amount.Sign() == 0 {
+		return nil, fmt.Errorf("burn failed, invalid amount %s", args["amount"])
+	}
+	from := sender(ctx)
+	if err := t.subBalance(ctx, asset.Symbol, from, amount); err != nil {
+		return nil, fmt.Errorf("burn failed, err: %v", err)
+	}
+
+	totalSupply, _ := new(big.Int).SetString(asset.TotalSupply, 10)
+	asset.TotalSupply = totalSupply.Sub(totalSupply, amount).String()
+	if err := t.saveAsset(ctx, asset); err != nil {
+		return nil, err
+	}
+	t.addEvent(ctx, EventBurn, &TokenEvent{Symbol: asset.Symbol, From: from, Amount: amount.String()})
+
+	ctx.AddResourceUsed(base.Limits{
+		XFee: tokenFee,
+	})
+
+	return &base.Response{
+		Status:  statusOK,
+		Message: "success",
+		Body:    nil,
+	}, nil
+}
+
+// SetMinters 由Owner重新设置可增发账户列表
+func (t *KernMethod) SetMinters(ctx base.KContext) (*base.Response, error) {
+	args := ctx.Args()
+	asset, err := t.getAsset(ctx, string(args["symbol"]))
+	if err != nil {
+		return nil, fmt.Errorf("set minters failed, err: %v", err)
+	}
+	if asset.Owner != sender(ctx) {
+		return nil, fmt.Errorf("no authority to set minters: %s", sender(ctx))
+	}
+	var minters []string
+	if err := json.Unmarshal(args["minters"], &minters); err != nil {
+		return nil, fmt.Errorf("set minters failed, minters should be a json array")
+	}
+	asset.Minters = minters
+	if err := t.saveAsset(ctx, asset); err != nil {
+		return nil, err
+	}
+
+	ctx.AddResourceUsed(base.Limits{
+		XFee: tokenFee,
+	})
+
+	return &base.Response{
+		Status:  statusOK,
+		Message: "success",
+		Body:    nil,
+	}, nil
+}
+
+// Transfer 发起者向to转账，合约调用时以调用合约作为转出方
+func (t *KernMethod) Transfer(ctx base.KContext) (*base.Response, error) {
+	args := ctx.Args()
+	if err := t.transfer(ctx, string(args["symbol"]), sender(ctx), string(args["to"]), args["amount"]); err != nil {
+		return nil, fmt.Errorf("transfer failed, err: %v", err)
+	}
+
+	ctx.AddResourceUsed(base.Limits{
+		XFee: tokenFee,
+	})
+
+	return &base.Response{
+		Status:  statusOK,
+		Message: "success",
+		Body:    nil,
+	}, nil
+}
+
+// Approve 授权spender可从发起者账户转出的额度，重复授权覆盖之前的额度
+func (t *KernMethod) Approve(ctx base.KContext) (*base.Response, error) {
+	args := ctx.Args()
+	asset, err := t.getAsset(ctx, string(args["symbol"]))
+	if err != nil {
+		return nil, fmt.Errorf("approve failed, err: %v", err)
+	}
+	spender := string(args["spender"])
+	if spender == "" {
+		return nil, fmt.Errorf("approve failed, spender is nil")
+	}
+	amount, ok := parseAmount(args["amount"])
+	if !ok {
+		return nil, fmt.Errorf("approve failed, invalid amount %s", args["amount"])
+	}
+	owner := sender(ctx)
+	err = ctx.Put(GetTokenBucket(), makeAllowanceKey(asset.Symbol, owner, spender), []byte(amount.String()))
+	if err != nil {
+		return nil, err
+	}
+	t.addEvent(ctx, EventApproval, &TokenEvent{Symbol: asset.Symbol, From: owner, To: spender, Amount: amount.String()})
+
+	ctx.AddResourceUsed(base.Limits{
+		XFee: tokenFee,
+	})
+
+	return &base.Response{
+		Status:  statusOK,
+		Message: "success",
+		Body:    nil,
+	}, nil
+}
+
+// TransferFrom spender在授权额度内从from账户转出
+func (t *KernMethod) TransferFrom(ctx base.KContext) (*base.Response, error) {
+	args := ctx.Args()
+	symbol := string(args["symbol"])
+	from := string(args["from"])
+	spender := sender(ctx)
+	amount, ok := parseAmount(args["amount"])
+	if !ok {
+		return nil, fmt.Errorf("transfer from failed, invalid amount %s", args["amount"])
+	}
+
+	allowanceKey := makeAllowanceKey(symbol, from, spender)
+	allowance := big.NewInt(0)
+	if allowanceBuf, err := ctx.Get(GetTokenBucket(), allowanceKey); err == nil {
+		allowance.SetString(string(allowanceBuf), 10)
+	}
+	if allowance.Cmp(amount) < 0 {
+		return nil, fmt.Errorf("transfer from failed, allowance %s insufficient", allowance.String())
+	}
+	if err := ctx.Put(GetTokenBucket(), allowanceKey, []byte(allowance.Sub(allowance, amount).String())); err != nil {
+		return nil, err
+	}
+	if err := t.transfer(ctx, symbol, from, string(args["to"]), args["amount"]); err != nil {
+		return nil, fmt.Errorf("transfer from failed, err: %v", err)
+	}
+
+	ctx.AddResourceUsed(base.Limits{
+		XFee: tokenFee,
+	})
+
+	return &base.Response{
+		Status:  statusOK,
+		Message: "success",
+		Body:    nil,
+	}, nil
+}
+
+func (t *KernMethod) QueryAsset(ctx base.KContext) (*base.Response, error) {
+	symbol := ctx.Args()["symbol"]
+	assetBuf, err := ctx.Get(GetTokenBucket(), makeAssetKey(string(symbol)))
+	if err != nil {
+		return nil, fmt.Errorf("query failed, asset %s not found", symbol)
+	}
+
+	return &base.Response{
+		Status:  statusOK,
+		Message: "success",
+		Body:    assetBuf,
+	}, nil
+}
+
+func (t *KernMethod) BalanceOf(ctx base.KContext) (*base.Response, error) {
+	args := ctx.Args()
+	asset, err := t.getAsset(ctx, string(args["symbol"]))
+	if err != nil {
+		return nil, fmt.Errorf("query balance failed, err: %v", err)
+	}
+	account := string(args["account"])
+	if account == "" {
+		account = sender(ctx)
+	}
+	balance, err := t.getBalance(ctx, asset.Symbol, account)
+	if err != nil {
+		return nil, err
+	}
+
+	return &base.Response{
+		Status:  statusOK,
+		Message: "success",
+		Body:    []byte(balance.String()),
+	}, nil
+}
+
+func (t *KernMethod) Allowance(ctx base.KContext) (*base.Response, error) {
+	args := ctx.Args()
+	allowance := []byte("0")
+	allowanceKey := makeAllowanceKey(string(args["symbol"]), string(args["owner"]), string(args["spender"]))
+	if allowanceBuf, err := ctx.Get(GetTokenBucket(), allowanceKey); err == nil {
+		allowance = allowanceBuf
+	}
+
+	return &base.Response{
+		Status:  statusOK,
+		Message: "success",
+		Body:    allowance,
+	}, nil
+}
+
+func (t *KernMethod) transfer(ctx base.KContext, symbol, from, to string, amountBuf []byte) error {
+	if _, err := t.getAsset(ctx, symbol); err != nil {
+		return err
+	}
+	if to == "" || to == from {
+		return fmt.Errorf("invalid receiver %s", to)
+	}
+	amount, ok := parseAmount(amountBuf)
+	if !ok || amount.Sign() == 0 {
+		return fmt.Errorf("invalid amount %s", amountBuf)
+	}
+	if err := t.subBalance(ctx, symbol, from, amount); err != nil {
+		return err
+	}
+	balance, err := t.getBalance(ctx, symbol, to)
+	if err != nil {
+		return err
+	}
+	if err := t.setBalance(ctx, symbol, to, balance.Add(balance, amount)); err != nil {
+		return err
+	}
+	t.addEvent(ctx, EventTransfer, &TokenEvent{Symbol: symbol, From: from, To: to, Amount: amount.String()})
+	return nil
+}
+
+func (t *KernMethod) subBalance(ctx base.KContext, symbol, account string, amount *big.Int) error {
+	balance, err := t.getBalance(ctx, symbol, account)
+	if err != nil {
+		return err
+	}
+	if balance.Cmp(amount) < 0 {
+		return fmt.Errorf("balance of %s insufficient", account)
+	}
+	return t.setBalance(ctx, symbol, account, balance.Sub(balance, amount))
+}
+
+func (t *KernMethod) getAsset(ctx base.KContext, symbol string) (*Asset, error) {
+	assetBuf, err := ctx.Get(GetTokenBucket(), makeAssetKey(symbol))
+	if err != nil {
+		return nil, fmt.Errorf("asset %s not found", symbol)
+	}
+	return parseAsset(assetBuf)
+}
+
+func (t *KernMethod) saveAsset(ctx base.KContext, asset *Asset) error {
+	assetBuf, err := json.Marshal(asset)
+	if err != nil {
+		return fmt.Errorf("save asset failed, marshal asset error")
+	}
+	return ctx.Put(GetTokenBucket(), makeAssetKey(asset.Symbol), assetBuf)
+}
+
+// getBalance 账户不存在时余额为0
+func (t *KernMethod) getBalance(ctx base.KContext, symbol, account string) (*big.Int, error) {
+	balanceBuf, err := ctx.Get(GetTokenBucket(), makeBalanceKey(symbol, account))
+	if err != nil || len(balanceBuf) == 0 {
+		return big.NewInt(0), nil
+	}
+	balance, ok := new(big.Int).SetString(string(balanceBuf), 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid balance of %s", account)
+	}
+	return balance, nil
+}
+
+func (t *KernMethod) setBalance(ctx base.KContext, symbol, account string, balance *big.Int) error {
+	return ctx.Put(GetTokenBucket(), makeBalanceKey(symbol, account), []byte(balance.String()))
+}
+
+func (t *KernMethod) addEvent(ctx base.KContext, name string, event *TokenEvent) {
+	body, _ := json.Marshal(event)
+	ctx.AddEvent(&protos.ContractEvent{
+		Contract: TokenKernelContract,
+		Name:     name,
+		Body:     body,
+	})
+}
+
+// sender 合约间调用时以调用合约作为资产账户
+func sender(ctx base.KContext) string {
+	if caller := ctx.Caller(); caller != "" {
+		return caller
+	}
+	return ctx.Initiator()
+}
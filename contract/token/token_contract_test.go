@@ -0,0 +1,205 @@
+package token
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/wooyang2018/corechain/contract/base"
+	"github.com/wooyang2018/corechain/protos"
+)
+
+type fakeKContext struct {
+	base.KContext
+	initiator string
+	caller    string
+	args      map[string][]byte
+	data      map[string][]byte
+	events    []*protos.ContractEvent
+}
+
+func newFakeKContext(initiator string) *fakeKContext {
+	return &fakeKContext{
+		initiator: initiator,
+		data:      make(map[string][]byte),
+	}
+}
+
+func (c *fakeKContext) Args() map[string][]byte { return c.args }
+
+func (c *fakeKContext) Initiator() string { return c.initiator }
+
+func (c *fakeKContext) Caller() string { return c.caller }
+
+func (c *fakeKContext) Get(bucket string, key []byte) ([]byte, error) {
+	value, ok := c.data[bucket+"/"+string(key)]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return value, nil
+}
+
+func (c *fakeKContext) Put(bucket string, key, value []byte) error {
+	c.data[bucket+"/"+string(key)] = value
+	return nil
+}
+
+func (c *fakeKContext) AddEvent(events ...*protos.ContractEvent) {
+	c.events = append(c.events, events...)
+}
+
+func (c *fakeKContext) AddResourceUsed(delta base.Limits) {}
+
+func balanceOf(t *testing.T, m *KernMethod, ctx *fakeKContext, account string) string {
+	ctx.args = map[string][]byte{"symbol": []byte("GOLD"), "account": []byte(account)}
+	resp, err := m.BalanceOf(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(resp.Body)
+}
+
+func TestTokenMintAndBurn(t *testing.T) {
+	ctx := newFakeKContext("alice")
+	m := NewKernContractMethod("corechain")
+
+	ctx.args = map[string][]byte{
+		"symbol":     []byte("gold"),
+		"decimals":   []byte("8"),
+		"supply":     []byte("100"),
+		"max_supply": []byte("150"),
+		"minters":    []byte(`["bob"]`),
+	}
+	if _, err := m.Create(ctx); err == nil {
+		t.Fatal("lower case symbol should fail")
+	}
+	ctx.args["symbol"] = []byte("GOLD")
+	if _, err := m.Create(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Create(ctx); err == nil {
+		t.Fatal("create an existing asset should fail")
+	}
+
+	ctx.initiator = "carol"
+	ctx.args = map[string][]byte{"symbol": []byte("GOLD"), "amount": []byte("10")}
+	if _, err := m.Mint(ctx); err == nil {
+		t.Fatal("carol has no authority to mint")
+	}
+	ctx.initiator = "bob"
+	if _, err := m.Mint(ctx); err != nil {
+		t.Fatal(err)
+	}
+	ctx.args["amount"] = []byte("41")
+	if _, err := m.Mint(ctx); err == nil {
+		t.Fatal("mint over max supply should fail")
+	}
+	ctx.args["amount"] = []byte("5")
+	if _, err := m.Burn(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Burn(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Burn(ctx); err == nil {
+		t.Fatal("burn over balance should fail")
+	}
+
+	asset, err := m.getAsset(ctx, "GOLD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if asset.TotalSupply != "100" || asset.Owner != "alice" || asset.Decimals != 8 {
+		t.Fatalf("unexpected asset %+v", asset)
+	}
+	if balance := balanceOf(t, m, ctx, "alice"); balance != "100" {
+		t.Fatalf("unexpected balance %s", balance)
+	}
+	if balance := balanceOf(t, m, ctx, "bob"); balance != "0" {
+		t.Fatalf("unexpected balance %s", balance)
+	}
+}
+
+func TestTokenTransferAndAllowance(t *testing.T) {
+	ctx := newFakeKContext("alice")
+	m := NewKernContractMethod("corechain")
+	ctx.args = map[string][]byte{
+		"symbol":   []byte("GOLD"),
+		"decimals": []byte("0"),
+		"supply":   []byte("100"),
+	}
+	if _, err := m.Create(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx.args = map[string][]byte{"symbol": []byte("GOLD"), "to": []byte("bob"), "amount": []byte("30")}
+	if _, err := m.Transfer(ctx); err != nil {
+		t.Fatal(err)
+	}
+	ctx.args["amount"] = []byte("71")
+	if _, err := m.Transfer(ctx); err == nil {
+		t.Fatal("transfer over balance should fail")
+	}
+
+	ctx.args = map[string][]byte{"symbol": []byte("GOLD"), "spender": []byte("carol"), "amount": []byte("20")}
+	if _, err := m.Approve(ctx); err != nil {
+		t.Fatal(err)
+	}
+	ctx.initiator = "carol"
+	ctx.args = map[string][]byte{
+		"symbol": []byte("GOLD"),
+		"from":   []byte("alice"),
+		"to":     []byte("dave"),
+		"amount": []byte("15"),
+	}
+	if _, err := m.TransferFrom(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.TransferFrom(ctx); err == nil {
+		t.Fatal("transfer from over allowance should fail")
+	}
+
+	ctx.args = map[string][]byte{"symbol": []byte("GOLD"), "owner": []byte("alice"), "spender": []byte("carol")}
+	resp, err := m.Allowance(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(resp.Body) != "5" {
+		t.Fatalf("unexpected allowance %s", resp.Body)
+	}
+	for account, expect := range map[string]string{"alice": "55", "bob": "30", "dave": "15"} {
+		if balance := balanceOf(t, m, ctx, account); balance != expect {
+			t.Fatalf("unexpected balance of %s: %s", account, balance)
+		}
+	}
+
+	// 合约调用时以调用合约作为转出方
+	ctx.initiator = "bob"
+	ctx.caller = "exchange"
+	ctx.args = map[string][]byte{"symbol": []byte("GOLD"), "to": []byte("alice"), "amount": []byte("1")}
+	if _, err := m.Transfer(ctx); err == nil {
+		t.Fatal("caller contract has no balance")
+	}
+
+	names := make([]string, 0, len(ctx.events))
+	for _, event := range ctx.events {
+		if event.Contract != TokenKernelContract {
+			t.Fatalf("unexpected event contract %s", event.Contract)
+		}
+		names = append(names, event.Name)
+	}
+	expect := []string{EventCreate, EventTransfer, EventApproval, EventTransfer}
+	if len(names) != len(expect) {
+		t.Fatalf("unexpected events %v", names)
+	}
+	for i := range expect {
+		if names[i] != expect[i] {
+			t.Fatalf("unexpected events %v", names)
+		}
+	}
+	last := &TokenEvent{}
+	json.Unmarshal(ctx.events[3].Body, last)
+	if last.From != "alice" || last.To != "dave" || last.Amount != "15" {
+		t.Fatalf("unexpected event %+v", last)
+	}
+}
@@ -0,0 +1,72 @@
+package token
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Manager manages all token releated data, providing read/write interface
+type Manager struct {
+	Ctx *TokenCtx
+}
+
+// NewTokenManager create instance of TokenManager
+func NewTokenManager(ctx *TokenCtx) (TokenManager, error) {
+	if ctx == nil || ctx.Ledger == nil || ctx.Contract == nil || ctx.BcName == "" {
+		return nil, fmt.Errorf("token ctx set error")
+	}
+
+	t := NewKernContractMethod(ctx.BcName)
+	register := ctx.Contract.GetKernRegistry()
+	register.RegisterKernMethod(TokenKernelContract, "Create", t.Create)
+	register.RegisterKernMethod(TokenKernelContract, "Mint", t.Mint)
+	register.RegisterKernMethod(TokenKernelContract, "Burn", t.Burn)
+	register.RegisterKernMethod(TokenKernelContract, "SetMinters", t.SetMinters)
+	register.RegisterKernMethod(TokenKernelContract, "Transfer", t.Transfer)
+	register.RegisterKernMethod(TokenKernelContract, "Approve", t.Approve)
+	register.RegisterKernMethod(TokenKernelContract, "TransferFrom", t.TransferFrom)
+	register.RegisterKernMethod(TokenKernelContract, "QueryAsset", t.QueryAsset)
+	register.RegisterKernMethod(TokenKernelContract, "BalanceOf", t.BalanceOf)
+	register.RegisterKernMethod(TokenKernelContract, "Allowance", t.Allowance)
+
+	mg := &Manager{
+		Ctx: ctx,
+	}
+
+	return mg, nil
+}
+
+// GetAsset get asset by symbol from the tip snapshot
+func (mgr *Manager) GetAsset(symbol string) (*Asset, error) {
+	reader, err := mgr.Ctx.Ledger.GetTipXMSnapshotReader()
+	if err != nil {
+		return nil, err
+	}
+	assetBuf, err := reader.Get(GetTokenBucket(), makeAssetKey(symbol))
+	if err != nil {
+		return nil, fmt.Errorf("query asset failed.err:%v", err)
+	}
+
+	return parseAsset(assetBuf)
+}
+
+// GetBalance get balance of account from the tip snapshot
+func (mgr *Manager) GetBalance(symbol, account string) (*big.Int, error) {
+	reader, err := mgr.Ctx.Ledger.GetTipXMSnapshotReader()
+	if err != nil {
+		return nil, err
+	}
+	balanceBuf, err := reader.Get(GetTokenBucket(), makeBalanceKey(symbol, account))
+	if err != nil {
+		return nil, fmt.Errorf("query balance failed.err:%v", err)
+	}
+	if len(balanceBuf) == 0 {
+		return big.NewInt(0), nil
+	}
+	balance, ok := new(big.Int).SetString(string(balanceBuf), 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid balance of %s", account)
+	}
+
+	return balance, nil
+}
@@ -0,0 +1,98 @@
+package token
+
+import (
+	"encoding/json"
+	"math/big"
+	"regexp"
+)
+
+const (
+	TokenKernelContract = "$token"
+
+	EventCreate   = "Create"
+	EventMint     = "Mint"
+	EventBurn     = "Burn"
+	EventTransfer = "Transfer"
+	EventApproval = "Approval"
+
+	statusOK = 200
+	// 创建资产消耗的手续费
+	createFee = 1000
+	// 其他写操作消耗的手续费
+	tokenFee = 100
+
+	maxDecimals = 18
+
+	assetPrefix     = "asset_"
+	balancePrefix   = "balance_"
+	allowancePrefix = "allowance_"
+	separator       = "_"
+)
+
+var symbolRegexp = regexp.MustCompile(`^[A-Z][A-Z0-9]{1,15}$`)
+
+// Asset 同质化资产，由Owner创建，Owner和Minters可以增发
+type Asset struct {
+	Symbol      string `json:"symbol"`
+	Name        string `json:"name"`
+	Decimals    uint32 `json:"decimals"`
+	TotalSupply string `json:"total_supply"`
+	// MaxSupply为空时不限制增发上限
+	MaxSupply string   `json:"max_supply,omitempty"`
+	Owner     string   `json:"owner"`
+	Minters   []string `json:"minters,omitempty"`
+}
+
+// TokenEvent 资产变动事件，Contract为$token，Name为事件类型，
+// 钱包可通过BlockFilter按contract和event_name订阅后跟踪余额
+type TokenEvent struct {
+	Symbol string `json:"symbol"`
+	From   string `json:"from,omitempty"`
+	To     string `json:"to,omitempty"`
+	Amount string `json:"amount"`
+}
+
+// GetTokenBucket return the bucket of token assets and balances
+func GetTokenBucket() string {
+	return TokenKernelContract
+}
+
+func (a *Asset) canMint(account string) bool {
+	if a.Owner == account {
+		return true
+	}
+	for _, minter := range a.Minters {
+		if minter == account {
+			return true
+		}
+	}
+	return false
+}
+
+func makeAssetKey(symbol string) []byte {
+	return []byte(assetPrefix + symbol)
+}
+
+func makeBalanceKey(symbol, account string) []byte {
+	return []byte(balancePrefix + symbol + separator + account)
+}
+
+func makeAllowanceKey(symbol, owner, spender string) []byte {
+	return []byte(allowancePrefix + symbol + separator + owner + separator + spender)
+}
+
+func parseAsset(buf []byte) (*Asset, error) {
+	asset := &Asset{}
+	if err := json.Unmarshal(buf, asset); err != nil {
+		return nil, err
+	}
+	return asset, nil
+}
+
+func parseAmount(buf []byte) (*big.Int, bool) {
+	amount, ok := new(big.Int).SetString(string(buf), 10)
+	if !ok || amount.Sign() < 0 {
+		return nil, false
+	}
+	return amount, true
+}
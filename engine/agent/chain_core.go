@@ -30,8 +30,9 @@ func (t *ChainCoreAgent) VerifyContractPermission(initiator string, authRequire
 }
 
 // VerifyContractOwnerPermission used to verify contract ownership permisson
-func (t *ChainCoreAgent) VerifyContractOwnerPermission(contractName string, authRequire []string) error {
-	return t.chainCtx.State.VerifyContractOwnerPermission(contractName, authRequire)
+func (t *ChainCoreAgent) VerifyContractOwnerPermission(contractName string, authRequire []string, initiator string,
+	req *protos.InvokeRequest) error {
+	return t.chainCtx.State.VerifyContractOwnerPermission(contractName, authRequire, initiator, req)
 }
 
 // QueryTransaction query confirmed tx
@@ -7,9 +7,11 @@ import (
 	"github.com/wooyang2018/corechain/consensus"
 	cbase "github.com/wooyang2018/corechain/consensus/base"
 	contractBase "github.com/wooyang2018/corechain/contract/base"
+	"github.com/wooyang2018/corechain/contract/htlc"
 	"github.com/wooyang2018/corechain/contract/proposal/govern"
 	"github.com/wooyang2018/corechain/contract/proposal/propose"
 	ptimer "github.com/wooyang2018/corechain/contract/proposal/timer"
+	"github.com/wooyang2018/corechain/contract/token"
 	cryptoClient "github.com/wooyang2018/corechain/crypto/client"
 	cryptoBase "github.com/wooyang2018/corechain/crypto/client/base"
 	engineBase "github.com/wooyang2018/corechain/engine/base"
@@ -175,3 +177,35 @@ func (t *ChainRelyAgentImpl) CreateTimerTask() (ptimer.TimerManager, error) {
 
 	return timerObj, nil
 }
+
+// CreateHTLC 创建哈希时间锁实例
+func (t *ChainRelyAgentImpl) CreateHTLC() (htlc.HTLCManager, error) {
+	legAgent := NewLedgerAgent(t.ctx)
+	htlcCtx, err := htlc.NewHTLCCtx(t.ctx.BcName, legAgent, t.ctx.Contract)
+	if err != nil {
+		return nil, fmt.Errorf("create htlc ctx failed.err:%v", err)
+	}
+
+	htlcObj, err := htlc.NewHTLCManager(htlcCtx)
+	if err != nil {
+		return nil, fmt.Errorf("create htlc instance failed.err:%v", err)
+	}
+
+	return htlcObj, nil
+}
+
+// CreateToken 创建多资产代币实例
+func (t *ChainRelyAgentImpl) CreateToken() (token.TokenManager, error) {
+	legAgent := NewLedgerAgent(t.ctx)
+	tokenCtx, err := token.NewTokenCtx(t.ctx.BcName, legAgent, t.ctx.Contract)
+	if err != nil {
+		return nil, fmt.Errorf("create token ctx failed.err:%v", err)
+	}
+
+	tokenObj, err := token.NewTokenManager(tokenCtx)
+	if err != nil {
+		return nil, fmt.Errorf("create token instance failed.err:%v", err)
+	}
+
+	return tokenObj, nil
+}
@@ -28,6 +28,8 @@ type EngineConf struct {
 	SyncBlockFilterMode int `yaml:"syncBlockFilterMode,omitempty"`
 	// SyncFactorForFactorBucketMode only use for SyncWithFactorBucket mode of SyncBlockFilterMode configuration item
 	SyncFactorForFactorBucketMode float64 `yaml:"SyncFactorForFactorBucketMode,omitempty"`
+	// CrossChainRelayInterval interval for relaying cross chain messages between local chains
+	CrossChainRelayInterval time.Duration `yaml:"crossChainRelayInterval,omitempty"`
 }
 
 func LoadEngineConf(cfgFile string) (*EngineConf, error) {
@@ -49,6 +51,7 @@ func GetDefEngineConf() *EngineConf {
 		MaxBlockQueueSize:             100,
 		SyncBlockFilterMode:           0,
 		SyncFactorForFactorBucketMode: 0.5,
+		CrossChainRelayInterval:       3 * time.Second,
 	}
 }
 
@@ -6,9 +6,11 @@ import (
 	xctx "github.com/wooyang2018/corechain/common/context"
 	"github.com/wooyang2018/corechain/consensus/base"
 	contractBase "github.com/wooyang2018/corechain/contract/base"
+	"github.com/wooyang2018/corechain/contract/htlc"
 	"github.com/wooyang2018/corechain/contract/proposal/govern"
 	"github.com/wooyang2018/corechain/contract/proposal/propose"
 	ptimer "github.com/wooyang2018/corechain/contract/proposal/timer"
+	"github.com/wooyang2018/corechain/contract/token"
 	cryptoBase "github.com/wooyang2018/corechain/crypto/client/base"
 	"github.com/wooyang2018/corechain/ledger"
 	netBase "github.com/wooyang2018/corechain/network/base"
@@ -56,8 +58,14 @@ type ChainCtx struct {
 	Proposal propose.ProposeManager
 	// 定时任务
 	TimerTask ptimer.TimerManager
+	// 哈希时间锁
+	HTLC htlc.HTLCManager
+	// 多资产代币
+	Token token.TokenManager
 	// 结点账户信息
 	Address *address.Address
 	// 异步任务
 	Asyncworker AsyncworkerAgent
+	// 私有交易
+	Privacy PrivacyAgent
 }
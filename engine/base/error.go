@@ -80,6 +80,7 @@ var (
 	ErrChainExist        = &Error{ErrStatusInternalErr, 50204, "chain already exists"}
 	ErrChainNotExist     = &Error{ErrStatusInternalErr, 50205, "chain not exist"}
 	ErrChainAlreadyExist = &Error{ErrStatusInternalErr, 50206, "chain already exist"}
+	ErrChainPaused       = &Error{ErrStatusInternalErr, 50207, "chain paused"}
 
 	// block
 	ErrBlockNotExist    = &Error{ErrStatusInternalErr, 50300, "block not exist"}
@@ -98,6 +99,7 @@ var (
 	ErrContractNewCtxFailed     = &Error{ErrStatusInternalErr, 50500, "contract new context failed"}
 	ErrContractInvokeFailed     = &Error{ErrStatusInternalErr, 50501, "contract invoke failed"}
 	ErrContractNewSandboxFailed = &Error{ErrStatusInternalErr, 50502, "contract new sandbox failed"}
+	ErrContractNotExist         = &Error{ErrStatusInternalErr, 50503, "contract not exist"}
 
 	// net
 	ErrNewNetEventFailed = &Error{ErrStatusInternalErr, 50600, "new net event failed"}
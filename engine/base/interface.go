@@ -5,9 +5,11 @@ import (
 	xctx "github.com/wooyang2018/corechain/common/context"
 	"github.com/wooyang2018/corechain/consensus/base"
 	contractBase "github.com/wooyang2018/corechain/contract/base"
+	"github.com/wooyang2018/corechain/contract/htlc"
 	"github.com/wooyang2018/corechain/contract/proposal/govern"
 	"github.com/wooyang2018/corechain/contract/proposal/propose"
 	ptimer "github.com/wooyang2018/corechain/contract/proposal/timer"
+	"github.com/wooyang2018/corechain/contract/token"
 	cryptoBase "github.com/wooyang2018/corechain/crypto/client/base"
 	"github.com/wooyang2018/corechain/ledger"
 	netBase "github.com/wooyang2018/corechain/network/base"
@@ -23,6 +25,10 @@ type Chain interface {
 	Start()
 	// 关闭链
 	Stop()
+	// 暂停出块，账本仍可查询
+	Pause()
+	// 恢复出块
+	Resume()
 	// 合约预执行
 	PreExec(xctx.Context, []*protos.InvokeRequest, string, []string) (*protos.InvokeResponse, error)
 	// 提交交易
@@ -62,6 +68,8 @@ type ChainRelyAgent interface {
 	CreateGovernToken() (govern.GovManager, error)
 	CreateProposal() (propose.ProposeManager, error)
 	CreateTimerTask() (ptimer.TimerManager, error)
+	CreateHTLC() (htlc.HTLCManager, error)
+	CreateToken() (token.TokenManager, error)
 }
 
 type ChainManager interface {
@@ -81,6 +89,17 @@ type AsyncworkerAgent interface {
 
 type TaskHandler func(ctx TaskContext) error
 
+// PrivacyAgent 私有交易管理，避免循环调用
+type PrivacyAgent interface {
+	// SendPrivateTx 加密后发往成员节点，返回需要上链的hash，initiator必须是本节点账户
+	SendPrivateTx(ctx xctx.Context, initiator string, members []string, payload *protos.PrivatePayload) ([]byte, error)
+	// GetPrivateTx 本节点是成员时解密私有交易，initiator必须是成员之一
+	GetPrivateTx(initiator string, hash []byte) (*protos.PrivatePayload, []string, error)
+	// GetExecResult 返回私有交易在本节点的执行结果
+	GetExecResult(hash []byte) (string, error)
+	Stop()
+}
+
 type TaskContext interface {
 	// ParseArgs 解析任务参数，参数为对应任务参数类型的指针
 	ParseArgs(v interface{}) error
@@ -96,3 +115,15 @@ type BlockStore interface {
 	// QueryBlockByHeight returns block at given height
 	QueryBlockByHeight(int64) (*protos.InternalBlock, error)
 }
+
+// TxPool is the interface of mempool event source
+type TxPool interface {
+	// WatchTxPool returns the channel of *protos.PendingTxEvent and the function to stop watching
+	WatchTxPool() (<-chan interface{}, func())
+}
+
+// StatusStore is the interface of chain status event source
+type StatusStore interface {
+	// WatchChainStatus returns the channel of *protos.ChainStatusEvent and the function to stop watching
+	WatchChainStatus() (<-chan interface{}, func())
+}
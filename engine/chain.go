@@ -14,8 +14,11 @@ import (
 	contractBase "github.com/wooyang2018/corechain/contract/base"
 	"github.com/wooyang2018/corechain/engine/agent"
 	"github.com/wooyang2018/corechain/engine/base"
+	"github.com/wooyang2018/corechain/engine/crosschain"
 	"github.com/wooyang2018/corechain/engine/miner"
+	"github.com/wooyang2018/corechain/engine/noderegistry"
 	"github.com/wooyang2018/corechain/engine/parachain"
+	"github.com/wooyang2018/corechain/engine/privacy"
 	ltx "github.com/wooyang2018/corechain/ledger/tx"
 	"github.com/wooyang2018/corechain/logger"
 	"github.com/wooyang2018/corechain/protos"
@@ -74,6 +77,20 @@ func LoadChain(engCtx *base.EngineCtx, bcName string) (*Chain, error) {
 		return nil, base.ErrNewChainCtxFailed.More("err:%v", err)
 	}
 
+	// 注册跨链消息合约
+	err = chainObj.CreateCrossChain()
+	if err != nil {
+		log.Error("create crosschain failed", "bcName", bcName, "err", err)
+		return nil, base.ErrNewChainCtxFailed.More("err:%v", err)
+	}
+
+	// 注册私有交易合约和网络消息
+	err = chainObj.CreatePrivacy()
+	if err != nil {
+		log.Error("create privacy failed", "bcName", bcName, "err", err)
+		return nil, base.ErrNewChainCtxFailed.More("err:%v", err)
+	}
+
 	// 创建矿工
 	chainObj.miner = miner.NewMiner(ctx)
 	chainObj.txIdCache = cache.New(TxIdCacheExpired, TxIdCacheGCInterval)
@@ -100,6 +117,9 @@ func (t *Chain) Start() {
 func (t *Chain) Stop() {
 	// 停止矿工等其余组件
 	t.miner.Stop()
+	if t.ctx.Privacy != nil {
+		t.ctx.Privacy.Stop()
+	}
 	t.ctx.Ledger.Close()
 	t.ctx.State.Close()
 	t.ctx = nil
@@ -107,6 +127,16 @@ func (t *Chain) Stop() {
 	t.txIdCache = nil
 }
 
+// 暂停出块，不再接收新交易，查询和预执行不受影响
+func (t *Chain) Pause() {
+	t.miner.Pause()
+}
+
+// 恢复出块
+func (t *Chain) Resume() {
+	t.miner.Resume()
+}
+
 func (t *Chain) Context() *base.ChainCtx {
 	return t.ctx
 }
@@ -251,6 +281,10 @@ func (t *Chain) SubmitTx(ctx xctx.Context, tx *protos.Transaction) error {
 	}
 	log := ctx.GetLog()
 
+	if t.miner.IsPaused() {
+		return base.ErrChainPaused
+	}
+
 	// 无币化
 	if len(tx.TxInputs) == 0 && !t.ctx.Ledger.GetNoFee() {
 		ctx.GetLog().Warn("PostTx TxInputs can not be null while need utxo")
@@ -432,6 +466,24 @@ func (t *Chain) initChainCtx() error {
 	// 设置timer manager到状态机
 	t.ctx.State.SetTimerTaskMG(t.ctx.TimerTask)
 	t.log.Debug("create timer_task succ", "bcName", t.ctx.BcName)
+
+	// 11.哈希时间锁
+	htlcObj, err := t.relyAgent.CreateHTLC()
+	if err != nil {
+		t.log.Error("create htlc error", "bcName", t.ctx.BcName, "err", err)
+		return fmt.Errorf("create htlc error")
+	}
+	t.ctx.HTLC = htlcObj
+	t.log.Debug("create htlc succ", "bcName", t.ctx.BcName)
+
+	// 12.多资产代币
+	tokenObj, err := t.relyAgent.CreateToken()
+	if err != nil {
+		t.log.Error("create token error", "bcName", t.ctx.BcName, "err", err)
+		return fmt.Errorf("create token error")
+	}
+	t.ctx.Token = tokenObj
+	t.log.Debug("create token succ", "bcName", t.ctx.BcName)
 	t.log.Debug("create chain succ", "bcName", t.ctx.BcName)
 	return nil
 }
@@ -448,3 +500,39 @@ func (t *Chain) CreateParaChain() error {
 	}
 	return nil
 }
+
+// 创建节点登记表实例
+func (t *Chain) CreateNodeRegistry() (*noderegistry.Registry, error) {
+	registry, err := noderegistry.NewNodeRegistry(t.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create node registry failed.err:%v", err)
+	}
+	return registry, nil
+}
+
+// 创建跨链消息实例
+func (t *Chain) CreateCrossChain() error {
+	crossChainCtx, err := crosschain.NewCrossChainCtx(t.ctx.BcName, t.ctx)
+	if err != nil {
+		return fmt.Errorf("create crosschain ctx failed.err:%v", err)
+	}
+	_, err = crosschain.NewCrossChainManager(crossChainCtx)
+	if err != nil {
+		return fmt.Errorf("create crosschain instance failed.err:%v", err)
+	}
+	return nil
+}
+
+// 创建私有交易实例
+func (t *Chain) CreatePrivacy() error {
+	privacyCtx, err := privacy.NewPrivacyCtx(t.ctx.BcName, t.ctx)
+	if err != nil {
+		return fmt.Errorf("create privacy ctx failed.err:%v", err)
+	}
+	privacyObj, err := privacy.NewPrivacyManager(privacyCtx)
+	if err != nil {
+		return fmt.Errorf("create privacy instance failed.err:%v", err)
+	}
+	t.ctx.Privacy = privacyObj
+	return nil
+}
@@ -0,0 +1,44 @@
+package crosschain
+
+import (
+	"fmt"
+
+	xctx "github.com/wooyang2018/corechain/common/context"
+	"github.com/wooyang2018/corechain/common/timer"
+	contractBase "github.com/wooyang2018/corechain/contract/base"
+	"github.com/wooyang2018/corechain/engine/base"
+	"github.com/wooyang2018/corechain/logger"
+)
+
+const (
+	CrossChainKernelContract = "$crosschain"
+)
+
+// CrossChainCtx 跨链消息上下文，每条链各有一个
+type CrossChainCtx struct {
+	// 基础上下文
+	xctx.BaseCtx
+	BcName   string
+	Contract contractBase.Manager
+	ChainCtx *base.ChainCtx
+}
+
+func NewCrossChainCtx(bcName string, cctx *base.ChainCtx) (*CrossChainCtx, error) {
+	if bcName == "" || cctx == nil {
+		return nil, fmt.Errorf("new crosschain ctx failed because param error")
+	}
+
+	log, err := logger.NewLogger("", CrossChainKernelContract)
+	if err != nil {
+		return nil, fmt.Errorf("new crosschain ctx failed because new logger error. err:%v", err)
+	}
+
+	ctx := new(CrossChainCtx)
+	ctx.XLog = log
+	ctx.Timer = timer.NewXTimer()
+	ctx.BcName = bcName
+	ctx.Contract = cctx.Contract
+	ctx.ChainCtx = cctx
+
+	return ctx, nil
+}
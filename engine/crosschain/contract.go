@@ -0,0 +1,391 @@
+package crosschain
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	contractBase "github.com/wooyang2018/corechain/contract/base"
+	"github.com/wooyang2018/corechain/contract/proposal/utils"
+	"github.com/wooyang2018/corechain/ledger"
+	"github.com/wooyang2018/corechain/protos"
+	"github.com/wooyang2018/corechain/state/txhash"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	ErrInvalidMessage  = errors.New("invalid cross chain message")
+	ErrInvalidProof    = errors.New("invalid cross chain proof")
+	ErrSeqMismatch     = errors.New("cross chain message sequence mismatch")
+	ErrNotIrreversible = errors.New("source block is not irreversible yet")
+	ErrNoConfig        = errors.New("cross chain config not set")
+	ErrInvalidConfig   = errors.New("invalid cross chain config")
+	ErrNotRelayer      = errors.New("initiator is not a cross chain relayer")
+	ErrInvalidAnchor   = errors.New("invalid cross chain anchor")
+	ErrAnchorConflict  = errors.New("cross chain anchor committed with another block")
+	ErrKernelTarget    = errors.New("cross chain message cannot call kernel contract")
+)
+
+const (
+	success = 200
+
+	// AnchorInterval 只锚定该间隔整数倍高度的区块，各中继对同一高度投票
+	AnchorInterval = 10
+	// maxProofHeaders 证明最多携带的区块头数
+	maxProofHeaders = 1000
+)
+
+// crossChainContract 合约执行只读取交易参数和本链状态，不读取本地其他链的账本
+type crossChainContract struct {
+	BcName string
+}
+
+func NewCrossChainContract(bcName string) *crossChainContract {
+	return &crossChainContract{
+		BcName: bcName,
+	}
+}
+
+// send 将消息写入发往目标链的发件箱，由中继在区块不可逆后投递
+func (c *crossChainContract) send(ctx contractBase.KContext) (*contractBase.Response, error) {
+	args := ctx.Args()
+	msg := &Message{
+		SrcChain: c.BcName,
+		DstChain: string(args["dst_chain"]),
+		Module:   string(args["module"]),
+		Contract: string(args["contract"]),
+		Method:   string(args["method"]),
+		Args:     make(map[string][]byte),
+	}
+	if msg.DstChain == "" || msg.DstChain == c.BcName || msg.Module == "" ||
+		msg.Contract == "" || msg.Method == "" {
+		return nil, ErrInvalidMessage
+	}
+	if isKernelTarget(msg) {
+		return nil, ErrKernelTarget
+	}
+	if argsBuf := args["args"]; len(argsBuf) > 0 {
+		callArgs := make(map[string]string)
+		if err := json.Unmarshal(argsBuf, &callArgs); err != nil {
+			return nil, fmt.Errorf("unmarshal call args failed, err:%v", err)
+		}
+		for k, v := range callArgs {
+			msg.Args[k] = []byte(v)
+		}
+	}
+
+	// 合约间调用时以调用合约作为发送者
+	msg.Sender = ctx.Caller()
+	if msg.Sender == "" {
+		msg.Sender = ctx.Initiator()
+	}
+
+	outSeqKey := []byte(MakeOutSeqKey(msg.DstChain))
+	seq, err := c.getSeq(ctx, outSeqKey)
+	if err != nil {
+		return nil, err
+	}
+	msg.Seq = seq + 1
+
+	msgBuf, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	if err := ctx.Put(CrossChainKernelContract, []byte(MakeOutboxKey(msg.DstChain, msg.Seq)), msgBuf); err != nil {
+		return nil, err
+	}
+	if err := ctx.Put(CrossChainKernelContract, outSeqKey, []byte(strconv.FormatInt(msg.Seq, 10))); err != nil {
+		return nil, err
+	}
+	ctx.AddEvent(&protos.ContractEvent{
+		Contract: CrossChainKernelContract,
+		Name:     sendEventName,
+		Body:     msgBuf,
+	})
+
+	return &contractBase.Response{
+		Status:  success,
+		Message: "success",
+		Body:    []byte(strconv.FormatInt(msg.Seq, 10)),
+	}, nil
+}
+
+// receive 校验源链区块头中包含消息后按序调用目标合约，调用失败则整笔交易失败，由中继重试
+func (c *crossChainContract) receive(ctx contractBase.KContext) (*contractBase.Response, error) {
+	args := ctx.Args()
+	srcChain := string(args["src_chain"])
+	seq, err := strconv.ParseInt(string(args["seq"]), 10, 64)
+	if srcChain == "" || srcChain == c.BcName || err != nil || len(args["proof"]) == 0 {
+		return nil, ErrInvalidMessage
+	}
+	proof := &Proof{}
+	if err := json.Unmarshal(args["proof"], proof); err != nil {
+		return nil, ErrInvalidProof
+	}
+
+	inSeqKey := []byte(MakeInSeqKey(srcChain))
+	lastSeq, err := c.getSeq(ctx, inSeqKey)
+	if err != nil {
+		return nil, err
+	}
+	if seq != lastSeq+1 {
+		return nil, ErrSeqMismatch
+	}
+
+	msgBuf, err := c.verifyProof(ctx, srcChain, MakeOutboxKey(c.BcName, seq), proof)
+	if err != nil {
+		return nil, err
+	}
+	msg := &Message{}
+	if err := json.Unmarshal(msgBuf, msg); err != nil {
+		return nil, ErrInvalidMessage
+	}
+	if msg.SrcChain != srcChain || msg.DstChain != c.BcName || msg.Seq != seq {
+		return nil, ErrInvalidMessage
+	}
+
+	if err := ctx.Put(CrossChainKernelContract, inSeqKey, []byte(strconv.FormatInt(seq, 10))); err != nil {
+		return nil, err
+	}
+
+	// 目标合约以中继账户为发起人执行，内核合约会从发起人扣款，只消耗序号不调用，避免阻塞后续消息
+	if isKernelTarget(msg) {
+		ctx.AddEvent(&protos.ContractEvent{
+			Contract: CrossChainKernelContract,
+			Name:     rejectEventName,
+			Body:     msgBuf,
+		})
+		return &contractBase.Response{
+			Status:  success,
+			Message: ErrKernelTarget.Error(),
+		}, nil
+	}
+
+	callArgs := make(map[string][]byte, len(msg.Args)+2)
+	for k, v := range msg.Args {
+		callArgs[k] = v
+	}
+	callArgs[srcChainArgKey] = []byte(msg.SrcChain)
+	callArgs[senderArgKey] = []byte(msg.Sender)
+	resp, err := ctx.Call(msg.Module, msg.Contract, msg.Method, callArgs)
+	if err != nil {
+		return nil, fmt.Errorf("call %s.%s failed, err:%v", msg.Contract, msg.Method, err)
+	}
+	if resp.Status >= contractBase.StatusErrorThreshold {
+		return nil, fmt.Errorf("call %s.%s failed, status:%d message:%s", msg.Contract, msg.Method, resp.Status, resp.Message)
+	}
+	ctx.AddEvent(&protos.ContractEvent{
+		Contract: CrossChainKernelContract,
+		Name:     recvEventName,
+		Body:     msgBuf,
+	})
+
+	return resp, nil
+}
+
+// isKernelTarget 内核合约从发起人扣款或以发起人身份执行，不允许跨链调用
+func isKernelTarget(msg *Message) bool {
+	return msg.Module == kernelModule || strings.HasPrefix(msg.Contract, "$")
+}
+
+// getMessage 查询发往目标链的第seq条消息
+func (c *crossChainContract) getMessage(ctx contractBase.KContext) (*contractBase.Response, error) {
+	args := ctx.Args()
+	dstChain := string(args["dst_chain"])
+	seq, err := strconv.ParseInt(string(args["seq"]), 10, 64)
+	if dstChain == "" || err != nil {
+		return nil, ErrInvalidMessage
+	}
+	msgBuf, err := ctx.Get(CrossChainKernelContract, []byte(MakeOutboxKey(dstChain, seq)))
+	if err != nil {
+		return nil, fmt.Errorf("message %d to %s not found", seq, dstChain)
+	}
+	return &contractBase.Response{
+		Status:  success,
+		Message: "success",
+		Body:    msgBuf,
+	}, nil
+}
+
+// verifyProof 校验源链交易被某区块包含，该区块经连续区块头链接到已锚定区块，且间隔不少于链上配置的确认数，
+// 返回交易写入发件箱的消息
+func (c *crossChainContract) verifyProof(ctx contractBase.KContext, srcChain, outboxKey string, proof *Proof) ([]byte, error) {
+	cfg, err := c.loadConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(proof.Headers) == 0 || len(proof.Headers) > maxProofHeaders {
+		return nil, ErrInvalidProof
+	}
+	tx := &protos.Transaction{}
+	if err := proto.Unmarshal(proof.Tx, tx); err != nil {
+		return nil, ErrInvalidProof
+	}
+	txid, err := txhash.MakeTxID(tx)
+	if err != nil || !bytes.Equal(txid, tx.Txid) {
+		return nil, ErrInvalidProof
+	}
+
+	// 区块id由区块头计算，逐个校验链接关系
+	var first, last *protos.InternalBlock
+	for _, buf := range proof.Headers {
+		header := &protos.InternalBlock{}
+		if err := proto.Unmarshal(buf, header); err != nil {
+			return nil, ErrInvalidProof
+		}
+		blockid, err := ledger.MakeBlockID(header)
+		if err != nil || !bytes.Equal(blockid, header.GetBlockid()) {
+			return nil, ErrInvalidProof
+		}
+		if last != nil && (header.GetHeight() != last.GetHeight()+1 || !bytes.Equal(header.GetPreHash(), last.GetBlockid())) {
+			return nil, ErrInvalidProof
+		}
+		if first == nil {
+			first = header
+		}
+		last = header
+	}
+
+	anchored, err := ctx.Get(CrossChainKernelContract, []byte(MakeAnchorKey(srcChain, last.GetHeight())))
+	if err != nil || len(anchored) == 0 {
+		return nil, ErrNotIrreversible
+	}
+	if !bytes.Equal(anchored, last.GetBlockid()) {
+		return nil, ErrInvalidProof
+	}
+	if last.GetHeight()-first.GetHeight() < cfg.ConfirmBlocks {
+		return nil, ErrNotIrreversible
+	}
+	if !ledger.VerifyMerkleProof(txid, first.GetMerkleRoot(), proof.TxIndex, proof.MerklePath) {
+		return nil, ErrInvalidProof
+	}
+
+	for _, output := range tx.GetTxOutputsExt() {
+		if output.GetBucket() == CrossChainKernelContract && string(output.GetKey()) == outboxKey {
+			return output.GetValue(), nil
+		}
+	}
+	return nil, ErrInvalidProof
+}
+
+// setConfig 由提案触发，参数args为Config的json
+func (c *crossChainContract) setConfig(ctx contractBase.KContext) (*contractBase.Response, error) {
+	if ctx.Caller() != utils.ProposalKernelContract {
+		return nil, fmt.Errorf("caller %s no authority to setConfig", ctx.Caller())
+	}
+	cfg := &Config{}
+	if err := json.Unmarshal(ctx.Args()["args"], cfg); err != nil {
+		return nil, ErrInvalidConfig
+	}
+	if cfg.ConfirmBlocks < 0 || cfg.ConfirmBlocks+AnchorInterval >= maxProofHeaders ||
+		cfg.Threshold <= 0 || cfg.Threshold > len(cfg.Relayers) {
+		return nil, ErrInvalidConfig
+	}
+	cfgBuf, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if err := ctx.Put(CrossChainKernelContract, []byte(configKey), cfgBuf); err != nil {
+		return nil, err
+	}
+
+	return &contractBase.Response{
+		Status:  success,
+		Message: "success",
+		Body:    cfgBuf,
+	}, nil
+}
+
+// getConfig 查询跨链参数
+func (c *crossChainContract) getConfig(ctx contractBase.KContext) (*contractBase.Response, error) {
+	cfgBuf, err := ctx.Get(CrossChainKernelContract, []byte(configKey))
+	if err != nil || len(cfgBuf) == 0 {
+		return nil, ErrNoConfig
+	}
+	return &contractBase.Response{
+		Status:  success,
+		Message: "success",
+		Body:    cfgBuf,
+	}, nil
+}
+
+// commitAnchor 中继账户提交源链height高度的主干区块id，提交的中继数达到阈值后成为锚点
+func (c *crossChainContract) commitAnchor(ctx contractBase.KContext) (*contractBase.Response, error) {
+	args := ctx.Args()
+	srcChain := string(args["src_chain"])
+	height, err := strconv.ParseInt(string(args["height"]), 10, 64)
+	blockid := args["blockid"]
+	if srcChain == "" || srcChain == c.BcName || err != nil || height <= 0 ||
+		height%AnchorInterval != 0 || len(blockid) == 0 {
+		return nil, ErrInvalidAnchor
+	}
+	cfg, err := c.loadConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	relayer := ctx.Initiator()
+	if !cfg.IsRelayer(relayer) {
+		return nil, ErrNotRelayer
+	}
+
+	anchorKey := []byte(MakeAnchorKey(srcChain, height))
+	if anchored, err := ctx.Get(CrossChainKernelContract, anchorKey); err == nil && len(anchored) > 0 {
+		if !bytes.Equal(anchored, blockid) {
+			return nil, ErrAnchorConflict
+		}
+		return &contractBase.Response{Status: success, Message: "anchored"}, nil
+	}
+
+	voteKey := []byte(MakeAnchorVoteKey(srcChain, height, blockid))
+	var voters []string
+	if voteBuf, err := ctx.Get(CrossChainKernelContract, voteKey); err == nil && len(voteBuf) > 0 {
+		if err := json.Unmarshal(voteBuf, &voters); err != nil {
+			return nil, err
+		}
+	}
+	for _, voter := range voters {
+		if voter == relayer {
+			return &contractBase.Response{Status: success, Message: "voted"}, nil
+		}
+	}
+	voters = append(voters, relayer)
+	voteBuf, err := json.Marshal(voters)
+	if err != nil {
+		return nil, err
+	}
+	if err := ctx.Put(CrossChainKernelContract, voteKey, voteBuf); err != nil {
+		return nil, err
+	}
+	if len(voters) < cfg.Threshold {
+		return &contractBase.Response{Status: success, Message: "voted"}, nil
+	}
+
+	if err := ctx.Put(CrossChainKernelContract, anchorKey, blockid); err != nil {
+		return nil, err
+	}
+	return &contractBase.Response{Status: success, Message: "anchored"}, nil
+}
+
+func (c *crossChainContract) loadConfig(ctx contractBase.KContext) (*Config, error) {
+	cfgBuf, err := ctx.Get(CrossChainKernelContract, []byte(configKey))
+	if err != nil || len(cfgBuf) == 0 {
+		return nil, ErrNoConfig
+	}
+	cfg := &Config{}
+	if err := json.Unmarshal(cfgBuf, cfg); err != nil {
+		return nil, ErrInvalidConfig
+	}
+	return cfg, nil
+}
+
+func (c *crossChainContract) getSeq(ctx contractBase.KContext, key []byte) (int64, error) {
+	buf, err := ctx.Get(CrossChainKernelContract, key)
+	if err != nil {
+		// 没找到，从0开始
+		return 0, nil
+	}
+	return parseSeq(buf)
+}
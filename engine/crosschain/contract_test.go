@@ -0,0 +1,326 @@
+package crosschain
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+
+	contractBase "github.com/wooyang2018/corechain/contract/base"
+	"github.com/wooyang2018/corechain/contract/proposal/utils"
+	"github.com/wooyang2018/corechain/ledger"
+	"github.com/wooyang2018/corechain/protos"
+	"github.com/wooyang2018/corechain/state/txhash"
+	"google.golang.org/protobuf/proto"
+)
+
+type fakeKContext struct {
+	contractBase.KContext
+	initiator string
+	caller    string
+	args      map[string][]byte
+	data      map[string][]byte
+	calls     []string
+}
+
+func newFakeKContext() *fakeKContext {
+	return &fakeKContext{initiator: "alice", data: make(map[string][]byte)}
+}
+
+func (c *fakeKContext) Args() map[string][]byte { return c.args }
+
+func (c *fakeKContext) Initiator() string { return c.initiator }
+
+func (c *fakeKContext) Caller() string { return c.caller }
+
+func (c *fakeKContext) Get(bucket string, key []byte) ([]byte, error) {
+	value, ok := c.data[bucket+"/"+string(key)]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return value, nil
+}
+
+func (c *fakeKContext) Put(bucket string, key, value []byte) error {
+	c.data[bucket+"/"+string(key)] = value
+	return nil
+}
+
+func (c *fakeKContext) AddEvent(events ...*protos.ContractEvent) {}
+
+func (c *fakeKContext) Call(module, contract, method string, args map[string][]byte) (*contractBase.Response, error) {
+	c.calls = append(c.calls, contract+"."+method+"@"+string(args[srcChainArgKey]))
+	return &contractBase.Response{Status: success}, nil
+}
+
+// makeHeaders 生成从height开始的n个相连区块头，第一个区块的merkle根为root
+func makeHeaders(t *testing.T, height int64, n int, root []byte) ([]*protos.InternalBlock, [][]byte) {
+	var blocks []*protos.InternalBlock
+	var bufs [][]byte
+	preHash := []byte("genesis")
+	for i := 0; i < n; i++ {
+		block := &protos.InternalBlock{
+			Version:    1,
+			Height:     height + int64(i),
+			PreHash:    preHash,
+			MerkleRoot: []byte(strconv.Itoa(i)),
+			Proposer:   []byte("miner"),
+		}
+		if i == 0 {
+			block.MerkleRoot = root
+		}
+		block.Blockid, _ = ledger.MakeBlockID(block)
+		preHash = block.Blockid
+		buf, err := proto.Marshal(block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		blocks = append(blocks, block)
+		bufs = append(bufs, buf)
+	}
+	return blocks, bufs
+}
+
+func setConfig(t *testing.T, c *crossChainContract, ctx *fakeKContext, cfg *Config) {
+	ctx.caller = utils.ProposalKernelContract
+	ctx.args = map[string][]byte{}
+	ctx.args["args"], _ = json.Marshal(cfg)
+	if _, err := c.setConfig(ctx); err != nil {
+		t.Fatal(err)
+	}
+	ctx.caller = ""
+}
+
+func TestSetConfig(t *testing.T) {
+	c := &crossChainContract{BcName: "para"}
+	ctx := newFakeKContext()
+	ctx.args = map[string][]byte{"args": []byte(`{"confirm_blocks":3,"relayers":["alice"],"threshold":1}`)}
+	if _, err := c.setConfig(ctx); err == nil {
+		t.Fatal("setConfig should only be called by proposal")
+	}
+	ctx.caller = utils.ProposalKernelContract
+	ctx.args["args"] = []byte(`{"confirm_blocks":3,"relayers":["alice"],"threshold":2}`)
+	if _, err := c.setConfig(ctx); err != ErrInvalidConfig {
+		t.Fatalf("expect ErrInvalidConfig, got %v", err)
+	}
+	ctx.args["args"] = []byte(`{"confirm_blocks":3,"relayers":["alice"],"threshold":1}`)
+	if _, err := c.setConfig(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if resp, err := c.getConfig(ctx); err != nil || len(resp.Body) == 0 {
+		t.Fatalf("get config failed, err:%v", err)
+	}
+}
+
+func TestCommitAnchor(t *testing.T) {
+	c := &crossChainContract{BcName: "para"}
+	ctx := newFakeKContext()
+	anchorArgs := map[string][]byte{
+		"src_chain": []byte("corechain"),
+		"height":    []byte("20"),
+		"blockid":   []byte("block20"),
+	}
+	ctx.args = anchorArgs
+	if _, err := c.commitAnchor(ctx); err != ErrNoConfig {
+		t.Fatalf("expect ErrNoConfig, got %v", err)
+	}
+	setConfig(t, c, ctx, &Config{ConfirmBlocks: 3, Relayers: []string{"alice", "bob", "carol"}, Threshold: 2})
+
+	ctx.args = map[string][]byte{"src_chain": []byte("corechain"), "height": []byte("21"), "blockid": []byte("block21")}
+	if _, err := c.commitAnchor(ctx); err != ErrInvalidAnchor {
+		t.Fatalf("expect ErrInvalidAnchor, got %v", err)
+	}
+	ctx.args = anchorArgs
+	ctx.initiator = "mallory"
+	if _, err := c.commitAnchor(ctx); err != ErrNotRelayer {
+		t.Fatalf("expect ErrNotRelayer, got %v", err)
+	}
+
+	// 同一中继重复提交不计票
+	anchorKey := CrossChainKernelContract + "/" + MakeAnchorKey("corechain", 20)
+	for _, relayer := range []string{"alice", "alice"} {
+		ctx.initiator = relayer
+		if _, err := c.commitAnchor(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, ok := ctx.data[anchorKey]; ok {
+		t.Fatal("anchor should not be committed below threshold")
+	}
+	ctx.initiator = "bob"
+	if _, err := c.commitAnchor(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if string(ctx.data[anchorKey]) != "block20" {
+		t.Fatal("anchor should be committed")
+	}
+
+	ctx.initiator = "carol"
+	ctx.args = map[string][]byte{"src_chain": []byte("corechain"), "height": []byte("20"), "blockid": []byte("other")}
+	if _, err := c.commitAnchor(ctx); err != ErrAnchorConflict {
+		t.Fatalf("expect ErrAnchorConflict, got %v", err)
+	}
+}
+
+func TestSendAndReceive(t *testing.T) {
+	// 源链发送消息
+	srcCtx := newFakeKContext()
+	srcCtx.args = map[string][]byte{
+		"dst_chain": []byte("para"),
+		"module":    []byte("wasm"),
+		"contract":  []byte("counter"),
+		"method":    []byte("increase"),
+		"args":      []byte(`{"key":"k1"}`),
+	}
+	src := &crossChainContract{BcName: "corechain"}
+	resp, err := src.send(srcCtx)
+	if err != nil || string(resp.Body) != "1" {
+		t.Fatalf("send failed, resp:%v err:%v", resp, err)
+	}
+	outboxKey := MakeOutboxKey("para", 1)
+	msgBuf := srcCtx.data[CrossChainKernelContract+"/"+outboxKey]
+
+	// 构造包含发件箱写集的源链交易及区块
+	tx := &protos.Transaction{
+		Desc: []byte("send"),
+		TxOutputsExt: []*protos.TxOutputExt{
+			{Bucket: CrossChainKernelContract, Key: []byte(outboxKey), Value: msgBuf},
+		},
+	}
+	tx.Txid, _ = txhash.MakeTxID(tx)
+	other := &protos.Transaction{Txid: []byte("other")}
+	tree := ledger.MakeMerkleTree([]*protos.Transaction{other, tx})
+	path, _ := ledger.MakeMerkleProof(tree, 1)
+	txBuf, _ := proto.Marshal(tx)
+	// 区块17到锚定高度20
+	blocks, headers := makeHeaders(t, 17, 4, tree[len(tree)-1])
+
+	dst := &crossChainContract{BcName: "para"}
+	dstCtx := newFakeKContext()
+	setConfig(t, dst, dstCtx, &Config{ConfirmBlocks: 3, Relayers: []string{"alice"}, Threshold: 1})
+	receiveArgs := func(proof *Proof) map[string][]byte {
+		proofBuf, _ := json.Marshal(proof)
+		return map[string][]byte{
+			"src_chain": []byte("corechain"),
+			"seq":       []byte("1"),
+			"proof":     proofBuf,
+		}
+	}
+	dstCtx.args = receiveArgs(&Proof{Tx: txBuf, TxIndex: 1, MerklePath: path, Headers: headers})
+	if _, err := dst.receive(dstCtx); err != ErrNotIrreversible {
+		t.Fatalf("expect ErrNotIrreversible, got %v", err)
+	}
+
+	// 锚定区块id不同
+	dstCtx.data[CrossChainKernelContract+"/"+MakeAnchorKey("corechain", 20)] = []byte("fork")
+	if _, err := dst.receive(dstCtx); err != ErrInvalidProof {
+		t.Fatalf("expect ErrInvalidProof, got %v", err)
+	}
+	dstCtx.data[CrossChainKernelContract+"/"+MakeAnchorKey("corechain", 20)] = blocks[3].Blockid
+
+	// 间隔不足确认数
+	setConfig(t, dst, dstCtx, &Config{ConfirmBlocks: 4, Relayers: []string{"alice"}, Threshold: 1})
+	dstCtx.args = receiveArgs(&Proof{Tx: txBuf, TxIndex: 1, MerklePath: path, Headers: headers})
+	if _, err := dst.receive(dstCtx); err != ErrNotIrreversible {
+		t.Fatalf("expect ErrNotIrreversible, got %v", err)
+	}
+	setConfig(t, dst, dstCtx, &Config{ConfirmBlocks: 3, Relayers: []string{"alice"}, Threshold: 1})
+
+	// 篡改中间区块头
+	forged := proto.Clone(blocks[1]).(*protos.InternalBlock)
+	forged.Timestamp = 1
+	forgedBuf, _ := proto.Marshal(forged)
+	dstCtx.args = receiveArgs(&Proof{Tx: txBuf, TxIndex: 1, MerklePath: path,
+		Headers: [][]byte{headers[0], forgedBuf, headers[2], headers[3]}})
+	if _, err := dst.receive(dstCtx); err != ErrInvalidProof {
+		t.Fatalf("expect ErrInvalidProof, got %v", err)
+	}
+
+	dstCtx.args = receiveArgs(&Proof{Tx: txBuf, TxIndex: 1, MerklePath: path, Headers: headers})
+	if _, err := dst.receive(dstCtx); err != nil {
+		t.Fatal(err)
+	}
+	if len(dstCtx.calls) != 1 || dstCtx.calls[0] != "counter.increase@corechain" {
+		t.Fatalf("unexpected calls %v", dstCtx.calls)
+	}
+	if seq, _ := strconv.Atoi(string(dstCtx.data[CrossChainKernelContract+"/"+MakeInSeqKey("corechain")])); seq != 1 {
+		t.Fatalf("unexpected in seq %d", seq)
+	}
+
+	// 重复投递
+	if _, err := dst.receive(dstCtx); err != ErrSeqMismatch {
+		t.Fatalf("expect ErrSeqMismatch, got %v", err)
+	}
+
+	// 篡改merkle路径
+	dstCtx.data[CrossChainKernelContract+"/"+MakeInSeqKey("corechain")] = []byte("0")
+	dstCtx.args = receiveArgs(&Proof{Tx: txBuf, TxIndex: 0, MerklePath: path, Headers: headers})
+	if _, err := dst.receive(dstCtx); err != ErrInvalidProof {
+		t.Fatalf("expect ErrInvalidProof, got %v", err)
+	}
+}
+
+// TestKernelTarget 目标合约以中继账户为发起人执行，跨链消息不能调用内核合约动用中继账户的资产
+func TestKernelTarget(t *testing.T) {
+	srcCtx := newFakeKContext()
+	srcCtx.args = map[string][]byte{
+		"dst_chain": []byte("para"),
+		"module":    []byte("xkernel"),
+		"contract":  []byte("$govern_token"),
+		"method":    []byte("Transfer"),
+		"args":      []byte(`{"to":"mallory","amount":"100"}`),
+	}
+	src := &crossChainContract{BcName: "corechain"}
+	if _, err := src.send(srcCtx); err != ErrKernelTarget {
+		t.Fatalf("expect ErrKernelTarget, got %v", err)
+	}
+
+	// 绕过send直接写入发件箱的内核调用消息
+	outboxKey := MakeOutboxKey("para", 1)
+	msgBuf, _ := json.Marshal(&Message{
+		SrcChain: "corechain",
+		DstChain: "para",
+		Seq:      1,
+		Sender:   "mallory",
+		Module:   "xkernel",
+		Contract: "$govern_token",
+		Method:   "Transfer",
+		Args:     map[string][]byte{"to": []byte("mallory"), "amount": []byte("100")},
+	})
+	tx := &protos.Transaction{
+		TxOutputsExt: []*protos.TxOutputExt{
+			{Bucket: CrossChainKernelContract, Key: []byte(outboxKey), Value: msgBuf},
+		},
+	}
+	tx.Txid, _ = txhash.MakeTxID(tx)
+	tree := ledger.MakeMerkleTree([]*protos.Transaction{tx})
+	path, _ := ledger.MakeMerkleProof(tree, 0)
+	txBuf, _ := proto.Marshal(tx)
+	blocks, headers := makeHeaders(t, 17, 4, tree[len(tree)-1])
+	proofBuf, _ := json.Marshal(&Proof{Tx: txBuf, TxIndex: 0, MerklePath: path, Headers: headers})
+
+	dst := &crossChainContract{BcName: "para"}
+	dstCtx := newFakeKContext()
+	dstCtx.initiator = "relayer"
+	setConfig(t, dst, dstCtx, &Config{ConfirmBlocks: 3, Relayers: []string{"relayer"}, Threshold: 1})
+	dstCtx.data[CrossChainKernelContract+"/"+MakeAnchorKey("corechain", 20)] = blocks[3].Blockid
+	dstCtx.args = map[string][]byte{
+		"src_chain": []byte("corechain"),
+		"seq":       []byte("1"),
+		"proof":     proofBuf,
+	}
+	resp, err := dst.receive(dstCtx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dstCtx.calls) != 0 {
+		t.Fatalf("kernel contract should not be called, calls %v", dstCtx.calls)
+	}
+	if resp.Message != ErrKernelTarget.Error() {
+		t.Fatalf("unexpected response %v", resp)
+	}
+	// 拒绝的消息同样消耗序号，不阻塞后续消息
+	if seq, _ := strconv.Atoi(string(dstCtx.data[CrossChainKernelContract+"/"+MakeInSeqKey("corechain")])); seq != 1 {
+		t.Fatalf("unexpected in seq %d", seq)
+	}
+}
@@ -0,0 +1,42 @@
+package crosschain
+
+import (
+	"fmt"
+
+	contractBase "github.com/wooyang2018/corechain/contract/base"
+)
+
+// Manager
+type Manager struct {
+	Ctx *CrossChainCtx
+}
+
+// NewCrossChainManager create instance of CrossChain, 每条链都注册跨链收发件合约
+func NewCrossChainManager(ctx *CrossChainCtx) (*Manager, error) {
+	if ctx == nil || ctx.Contract == nil || ctx.BcName == "" {
+		return nil, fmt.Errorf("crosschain ctx set error")
+	}
+
+	t := NewCrossChainContract(ctx.BcName)
+	register := ctx.Contract.GetKernRegistry()
+	// 注册合约方法
+	kMethods := map[string]contractBase.KernMethod{
+		"send":         t.send,
+		"receive":      t.receive,
+		"getMessage":   t.getMessage,
+		"setConfig":    t.setConfig,
+		"getConfig":    t.getConfig,
+		"commitAnchor": t.commitAnchor,
+	}
+	for method, f := range kMethods {
+		if _, err := register.GetKernMethod(CrossChainKernelContract, method); err != nil {
+			register.RegisterKernMethod(CrossChainKernelContract, method, f)
+		}
+	}
+
+	mg := &Manager{
+		Ctx: ctx,
+	}
+
+	return mg, nil
+}
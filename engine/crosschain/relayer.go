@@ -0,0 +1,373 @@
+package crosschain
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+
+	xctx "github.com/wooyang2018/corechain/common/context"
+	"github.com/wooyang2018/corechain/common/timer"
+	"github.com/wooyang2018/corechain/common/utils"
+	"github.com/wooyang2018/corechain/engine/base"
+	"github.com/wooyang2018/corechain/ledger"
+	"github.com/wooyang2018/corechain/logger"
+	"github.com/wooyang2018/corechain/network"
+	"github.com/wooyang2018/corechain/protos"
+	"github.com/wooyang2018/corechain/state/txhash"
+	"github.com/wooyang2018/corechain/state/utxo"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// 每轮每个链对最多中继的消息数
+	maxRelayPerRound = 100
+)
+
+// Relayer 在同一引擎的链之间中继跨链消息，节点账户是目标链中继账户时提交源链锚点，
+// 并携带消息所在区块到锚点的区块头在目标链发起receive交易
+type Relayer struct {
+	engCtx *base.EngineCtx
+	log    logger.Logger
+	exitCh chan struct{}
+}
+
+func NewRelayer(engCtx *base.EngineCtx) *Relayer {
+	return &Relayer{
+		engCtx: engCtx,
+		log:    engCtx.XLog,
+		exitCh: make(chan struct{}),
+	}
+}
+
+// Start 阻塞运行，直到Stop
+func (r *Relayer) Start() {
+	ticker := time.NewTicker(r.engCtx.EngCfg.CrossChainRelayInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.exitCh:
+			return
+		case <-ticker.C:
+			r.relayOnce()
+		}
+	}
+}
+
+func (r *Relayer) Stop() {
+	close(r.exitCh)
+}
+
+func (r *Relayer) relayOnce() {
+	chainNames := r.engCtx.ChainM.GetChains()
+	for _, srcName := range chainNames {
+		for _, dstName := range chainNames {
+			if srcName == dstName {
+				continue
+			}
+			src, err := r.engCtx.ChainM.Get(srcName)
+			if err != nil {
+				continue
+			}
+			dst, err := r.engCtx.ChainM.Get(dstName)
+			if err != nil {
+				continue
+			}
+			if err := r.anchor(src, dst); err != nil {
+				r.log.Warn("commit cross chain anchor failed", "src", srcName, "dst", dstName, "err", err)
+			}
+			if err := r.relay(src, dst); err != nil {
+				r.log.Warn("relay cross chain message failed", "src", srcName, "dst", dstName, "err", err)
+			}
+		}
+	}
+}
+
+// relay 按序投递src发往dst的消息，遇到未确认或投递失败的消息即停止，下一轮重试
+func (r *Relayer) relay(src, dst base.Chain) error {
+	srcCtx, dstCtx := src.Context(), dst.Context()
+	if srcCtx == nil || dstCtx == nil {
+		return nil
+	}
+	srcSnapshot, err := srcCtx.State.GetTipSnapshot()
+	if err != nil {
+		return err
+	}
+	outSeq, err := getSeqFromReader(srcSnapshot, MakeOutSeqKey(dstCtx.BcName))
+	if err != nil || outSeq == 0 {
+		return err
+	}
+	// 目标链包含未确认交易的状态，已提交未上链的消息不会重复中继
+	dstReader := dstCtx.State.CreateXMReader()
+	cfg, err := getConfigFromReader(dstReader)
+	if err != nil {
+		// 目标链未配置跨链参数
+		return nil
+	}
+	inSeq, err := getSeqFromReader(dstReader, MakeInSeqKey(srcCtx.BcName))
+	if err != nil {
+		return err
+	}
+
+	for seq := inSeq + 1; seq <= outSeq && seq <= inSeq+maxRelayPerRound; seq++ {
+		proof, err := r.makeProof(srcCtx, srcSnapshot, dstReader, cfg, MakeOutboxKey(dstCtx.BcName, seq))
+		if err != nil {
+			return err
+		}
+		proofBuf, err := json.Marshal(proof)
+		if err != nil {
+			return err
+		}
+		req := &protos.InvokeRequest{
+			ModuleName:   "xkernel",
+			ContractName: CrossChainKernelContract,
+			MethodName:   "receive",
+			Args: map[string][]byte{
+				"src_chain": []byte(srcCtx.BcName),
+				"seq":       []byte(strconv.FormatInt(seq, 10)),
+				"proof":     proofBuf,
+			},
+		}
+		if err := r.submit(dst, req); err != nil {
+			return fmt.Errorf("submit message %d failed, err:%v", seq, err)
+		}
+		r.log.Debug("relay cross chain message", "src", srcCtx.BcName, "dst", dstCtx.BcName, "seq", seq)
+	}
+	return nil
+}
+
+// anchor 本节点账户是目标链的中继账户时，提交源链最近一个锚定高度的主干区块
+func (r *Relayer) anchor(src, dst base.Chain) error {
+	srcCtx, dstCtx := src.Context(), dst.Context()
+	if srcCtx == nil || dstCtx == nil {
+		return nil
+	}
+	dstReader := dstCtx.State.CreateXMReader()
+	cfg, err := getConfigFromReader(dstReader)
+	if err != nil || !cfg.IsRelayer(dstCtx.Address.Address) {
+		return nil
+	}
+
+	tipHeight := srcCtx.Ledger.GetMeta().GetTrunkHeight()
+	height := tipHeight - tipHeight%AnchorInterval
+	if height <= 0 {
+		return nil
+	}
+	if anchored, err := getValueFromReader(dstReader, MakeAnchorKey(srcCtx.BcName, height)); err != nil || len(anchored) > 0 {
+		return err
+	}
+	header, err := srcCtx.Ledger.QueryBlockHeaderByHeight(height)
+	if err != nil {
+		return err
+	}
+	voteBuf, err := getValueFromReader(dstReader, MakeAnchorVoteKey(srcCtx.BcName, height, header.Blockid))
+	if err != nil {
+		return err
+	}
+	var voters []string
+	if len(voteBuf) > 0 {
+		if err := json.Unmarshal(voteBuf, &voters); err != nil {
+			return err
+		}
+	}
+	for _, voter := range voters {
+		if voter == dstCtx.Address.Address {
+			return nil
+		}
+	}
+
+	req := &protos.InvokeRequest{
+		ModuleName:   "xkernel",
+		ContractName: CrossChainKernelContract,
+		MethodName:   "commitAnchor",
+		Args: map[string][]byte{
+			"src_chain": []byte(srcCtx.BcName),
+			"height":    []byte(strconv.FormatInt(height, 10)),
+			"blockid":   header.Blockid,
+		},
+	}
+	if err := r.submit(dst, req); err != nil {
+		return err
+	}
+	r.log.Debug("commit cross chain anchor", "src", srcCtx.BcName, "dst", dstCtx.BcName, "height", height)
+	return nil
+}
+
+// makeProof 定位写入发件箱的交易及区块，并携带到最近锚点的区块头，锚点间隔不足确认数时返回错误
+func (r *Relayer) makeProof(srcCtx *base.ChainCtx, reader, dstReader ledger.XReader, cfg *Config,
+	outboxKey string) (*Proof, error) {
+	verData, err := reader.Get(CrossChainKernelContract, []byte(outboxKey))
+	if err != nil || len(verData.GetPureData().GetValue()) == 0 {
+		return nil, fmt.Errorf("message %s not found", outboxKey)
+	}
+	tx, err := srcCtx.Ledger.QueryTransaction(verData.RefTxid)
+	if err != nil {
+		return nil, err
+	}
+	block, err := srcCtx.Ledger.QueryBlock(tx.Blockid)
+	if err != nil {
+		return nil, err
+	}
+	if !block.InTrunk {
+		return nil, ErrNotIrreversible
+	}
+
+	// 不低于block.Height+ConfirmBlocks的最近锚点
+	tipHeight := srcCtx.Ledger.GetMeta().GetTrunkHeight()
+	minHeight := block.Height + cfg.ConfirmBlocks
+	anchorHeight := (minHeight + AnchorInterval - 1) / AnchorInterval * AnchorInterval
+	var anchored []byte
+	for ; anchorHeight <= tipHeight; anchorHeight += AnchorInterval {
+		anchored, err = getValueFromReader(dstReader, MakeAnchorKey(srcCtx.BcName, anchorHeight))
+		if err != nil {
+			return nil, err
+		}
+		if len(anchored) > 0 {
+			break
+		}
+	}
+	if len(anchored) == 0 {
+		return nil, ErrNotIrreversible
+	}
+	if anchorHeight-block.Height >= maxProofHeaders {
+		return nil, fmt.Errorf("anchor %d too far from block %d", anchorHeight, block.Height)
+	}
+
+	headers := make([][]byte, 0, anchorHeight-block.Height+1)
+	for height := block.Height; height <= anchorHeight; height++ {
+		header, err := srcCtx.Ledger.QueryBlockHeaderByHeight(height)
+		if err != nil {
+			return nil, err
+		}
+		if height == anchorHeight && !bytes.Equal(header.Blockid, anchored) {
+			return nil, fmt.Errorf("anchor %d mismatch with local trunk", anchorHeight)
+		}
+		header = proto.Clone(header).(*protos.InternalBlock)
+		header.Transactions = nil
+		headerBuf, err := proto.Marshal(header)
+		if err != nil {
+			return nil, err
+		}
+		headers = append(headers, headerBuf)
+	}
+
+	index := -1
+	for i, blockTx := range block.Transactions {
+		if bytes.Equal(blockTx.Txid, tx.Txid) {
+			index = i
+			break
+		}
+	}
+	path, err := ledger.MakeMerkleProof(ledger.MakeMerkleTree(block.Transactions), index)
+	if err != nil {
+		return nil, err
+	}
+	txBuf, err := proto.Marshal(tx)
+	if err != nil {
+		return nil, err
+	}
+	return &Proof{
+		Tx:         txBuf,
+		TxIndex:    index,
+		MerklePath: path,
+		Headers:    headers,
+	}, nil
+}
+
+// submit 预执行后以节点账户签名提交交易，并广播到网络
+func (r *Relayer) submit(dst base.Chain, req *protos.InvokeRequest) error {
+	cctx := dst.Context()
+	addr := cctx.Address
+	ctx := &xctx.BaseCtx{XLog: cctx.XLog, Timer: timer.NewXTimer()}
+	resp, err := dst.PreExec(ctx, []*protos.InvokeRequest{req}, addr.Address, []string{addr.Address})
+	if err != nil {
+		return err
+	}
+
+	tx := &protos.Transaction{
+		Desc:             []byte("cross chain message relay"),
+		Nonce:            utils.GenNonce(),
+		Timestamp:        time.Now().UnixNano(),
+		Version:          utxo.TxVersion,
+		Initiator:        addr.Address,
+		AuthRequire:      []string{addr.Address},
+		TxInputsExt:      resp.GetInputs(),
+		TxOutputsExt:     resp.GetOutputs(),
+		ContractRequests: resp.GetRequests(),
+	}
+	tx.TxInputs = append(tx.TxInputs, resp.GetUtxoInputs()...)
+	tx.TxOutputs = append(tx.TxOutputs, resp.GetUtxoOutputs()...)
+
+	// 交易必须包含utxo输入，gas由节点账户支付，找零给自己
+	gasUsed := big.NewInt(resp.GetGasUsed())
+	need := new(big.Int).Set(gasUsed)
+	if need.Sign() == 0 {
+		need.SetInt64(1)
+	}
+	inputs, _, total, err := cctx.State.SelectUtxos(addr.Address, need, true, false)
+	if err != nil {
+		return err
+	}
+	tx.TxInputs = append(tx.TxInputs, inputs...)
+	if gasUsed.Sign() > 0 {
+		tx.TxOutputs = append(tx.TxOutputs, &protos.TxOutput{ToAddr: []byte("$"), Amount: gasUsed.Bytes()})
+	}
+	if change := total.Sub(total, gasUsed); change.Sign() > 0 {
+		tx.TxOutputs = append(tx.TxOutputs, &protos.TxOutput{ToAddr: []byte(addr.Address), Amount: change.Bytes()})
+	}
+
+	sign, err := txhash.ProcessSignTx(cctx.Crypto, tx, []byte(addr.PrivateKeyStr))
+	if err != nil {
+		return err
+	}
+	signInfo := &protos.SignatureInfo{
+		PublicKey: addr.PublicKeyStr,
+		Sign:      sign,
+	}
+	tx.InitiatorSigns = []*protos.SignatureInfo{signInfo}
+	tx.AuthRequireSigns = []*protos.SignatureInfo{signInfo}
+	tx.Txid, err = txhash.MakeTxID(tx)
+	if err != nil {
+		return err
+	}
+
+	if err := dst.SubmitTx(ctx, tx); err != nil {
+		return err
+	}
+	msg := network.NewMessage(protos.CoreMessage_POSTTX, tx, network.WithBCName(cctx.BcName))
+	go r.engCtx.Net.SendMessage(ctx, msg)
+	return nil
+}
+
+func getSeqFromReader(reader ledger.XReader, key string) (int64, error) {
+	value, err := getValueFromReader(reader, key)
+	if err != nil {
+		return 0, err
+	}
+	return parseSeq(value)
+}
+
+func getConfigFromReader(reader ledger.XReader) (*Config, error) {
+	value, err := getValueFromReader(reader, configKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(value) == 0 {
+		return nil, ErrNoConfig
+	}
+	cfg := &Config{}
+	if err := json.Unmarshal(value, cfg); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func getValueFromReader(reader ledger.XReader, key string) ([]byte, error) {
+	verData, err := reader.Get(CrossChainKernelContract, []byte(key))
+	if err != nil {
+		return nil, err
+	}
+	return verData.GetPureData().GetValue(), nil
+}
@@ -0,0 +1,98 @@
+package crosschain
+
+import (
+	"fmt"
+	"strconv"
+)
+
+const (
+	outboxPrefix     = "out_"
+	outSeqPrefix     = "outseq_"
+	inSeqPrefix      = "inseq_"
+	anchorPrefix     = "anchor_"
+	anchorVotePrefix = "anchorvote_"
+	configKey        = "config"
+	keySeparator     = "_"
+	sendEventName    = "SendMessage"
+	recvEventName    = "ReceiveMessage"
+	rejectEventName  = "RejectMessage"
+	kernelModule     = "xkernel"
+	srcChainArgKey   = "$src_chain"
+	senderArgKey     = "$src_sender"
+)
+
+// Message 跨链消息，由源链send写入发件箱，中继后在目标链receive时调用目标合约
+type Message struct {
+	SrcChain string            `json:"src_chain"`
+	DstChain string            `json:"dst_chain"`
+	Seq      int64             `json:"seq"`
+	Sender   string            `json:"sender"`
+	Module   string            `json:"module"`
+	Contract string            `json:"contract"`
+	Method   string            `json:"method"`
+	Args     map[string][]byte `json:"args"`
+}
+
+// Config 目标链上的跨链参数，只能由提案修改，未设置时不接收跨链消息
+type Config struct {
+	// ConfirmBlocks 消息所在区块到锚定区块至少间隔的区块数
+	ConfirmBlocks int64 `json:"confirm_blocks"`
+	// Relayers 可以提交源链锚点的账户
+	Relayers []string `json:"relayers"`
+	// Threshold 同一锚点需要的中继账户数
+	Threshold int `json:"threshold"`
+}
+
+// IsRelayer 判断账户是否为中继账户
+func (c *Config) IsRelayer(account string) bool {
+	for _, relayer := range c.Relayers {
+		if relayer == account {
+			return true
+		}
+	}
+	return false
+}
+
+// Proof 消息所在交易被源链区块包含，且该区块是已锚定区块祖先的证明
+type Proof struct {
+	// Tx 写入发件箱的源链交易，protobuf编码
+	Tx []byte `json:"tx"`
+	// TxIndex 交易在区块中的位置
+	TxIndex int `json:"tx_index"`
+	// MerklePath 交易到区块merkle根的路径
+	MerklePath [][]byte `json:"merkle_path"`
+	// Headers 从包含交易的区块到锚定区块的连续区块头，protobuf编码，不含交易
+	Headers [][]byte `json:"headers"`
+}
+
+// MakeOutboxKey 发件箱中发往dstChain第seq条消息的key，seq定长保证按序遍历
+func MakeOutboxKey(dstChain string, seq int64) string {
+	return fmt.Sprintf("%s%s%s%020d", outboxPrefix, dstChain, keySeparator, seq)
+}
+
+// MakeOutSeqKey 记录已发往dstChain的最大序号
+func MakeOutSeqKey(dstChain string) string {
+	return outSeqPrefix + dstChain
+}
+
+// MakeInSeqKey 记录已从srcChain收到的最大序号
+func MakeInSeqKey(srcChain string) string {
+	return inSeqPrefix + srcChain
+}
+
+// MakeAnchorKey 记录源链height高度已锚定的区块id，height为AnchorInterval的整数倍
+func MakeAnchorKey(srcChain string, height int64) string {
+	return fmt.Sprintf("%s%s%s%020d", anchorPrefix, srcChain, keySeparator, height)
+}
+
+// MakeAnchorVoteKey 记录提交了同一锚点的中继账户
+func MakeAnchorVoteKey(srcChain string, height int64, blockid []byte) string {
+	return fmt.Sprintf("%s%s%s%020d%s%x", anchorVotePrefix, srcChain, keySeparator, height, keySeparator, blockid)
+}
+
+func parseSeq(buf []byte) (int64, error) {
+	if len(buf) == 0 {
+		return 0, nil
+	}
+	return strconv.ParseInt(string(buf), 10, 64)
+}
@@ -10,6 +10,7 @@ import (
 	xconf "github.com/wooyang2018/corechain/common/config"
 	"github.com/wooyang2018/corechain/common/timer"
 	"github.com/wooyang2018/corechain/engine/base"
+	"github.com/wooyang2018/corechain/engine/crosschain"
 	"github.com/wooyang2018/corechain/engine/net"
 	"github.com/wooyang2018/corechain/engine/parachain"
 	"github.com/wooyang2018/corechain/engine/worker"
@@ -29,6 +30,8 @@ type Engine struct {
 	chainManager base.ChainManager
 	// p2p网络事件处理
 	netEvent *net.NetEvent
+	// 跨链消息中继
+	relayer *crosschain.Relayer
 	// 确保Exit调用幂等
 	exitOnce sync.Once
 }
@@ -103,6 +106,9 @@ func (t *Engine) Init(envCfg *xconf.EnvConf) error {
 	t.netEvent = netEvent
 	t.log.Debug("init register subscriber network event succeeded")
 
+	// 初始化跨链消息中继
+	t.relayer = crosschain.NewRelayer(t.engCtx)
+
 	t.log.Debug("init engine succeeded")
 	return nil
 }
@@ -135,6 +141,13 @@ func (t *Engine) Run() {
 		t.netEvent.Start()
 	}()
 
+	// 启动跨链消息中继
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		t.relayer.Start()
+	}()
+
 	// 遍历启动每条链
 	t.chainManager.StartChains()
 
@@ -216,6 +229,15 @@ func (t *Engine) loadChains() error {
 				t.log.Error("create parachain mgmt error", "bcName", rootChain, "err", err)
 				return fmt.Errorf("create parachain error")
 			}
+			registry, err := chain.CreateNodeRegistry()
+			if err != nil {
+				t.log.Error("create node registry error", "bcName", rootChain, "err", err)
+				return fmt.Errorf("create node registry error")
+			}
+			// 网络层按节点登记表做准入检查
+			if aware, ok := t.engCtx.Net.(netBase.AdmissionAware); ok {
+				aware.SetNodeRegistry(registry)
+			}
 			time.Sleep(time.Second * 5) //确保所有RegisterHandler处理完毕
 			aw.Start()
 		}
@@ -265,6 +287,10 @@ func (t *Engine) loadChains() error {
 			continue
 		}
 		t.log.Debug("load chain from data dir succ", "chain", fInfo.Name())
+		// 暂停的平行链只提供查询，不参与出块
+		if parachain.IsParaChainPaused(group) {
+			chain.Pause()
+		}
 
 		// 记录链实例
 		t.chainManager.Put(fInfo.Name(), chain)
@@ -305,6 +331,11 @@ func (t *Engine) createEngCtx(envCfg *xconf.EnvConf) (*base.EngineCtx, error) {
 }
 
 func (t *Engine) exit() {
+	// 先关闭跨链消息中继，避免访问已关闭的账本
+	if t.relayer != nil {
+		t.relayer.Stop()
+	}
+
 	// 关闭矿工
 	wg := &sync.WaitGroup{}
 	t.chainManager.StopChains()
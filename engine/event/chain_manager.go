@@ -10,6 +10,10 @@ import (
 type ChainManager interface {
 	// GetBlockStore get BlockStore base bcname(the name of block chain)
 	GetBlockStore(bcname string) (base.BlockStore, error)
+	// GetTxPool get TxPool base bcname
+	GetTxPool(bcname string) (base.TxPool, error)
+	// GetStatusStore get StatusStore base bcname
+	GetStatusStore(bcname string) (base.StatusStore, error)
 }
 
 type chainManager struct {
@@ -31,3 +35,21 @@ func (c *chainManager) GetBlockStore(bcname string) (base.BlockStore, error) {
 
 	return NewBlockStore(chain.Context().Ledger, chain.Context().State), nil
 }
+
+func (c *chainManager) GetTxPool(bcname string) (base.TxPool, error) {
+	chain, err := c.engine.Get(bcname)
+	if err != nil {
+		return nil, fmt.Errorf("chain %s not found", bcname)
+	}
+
+	return chain.Context().State, nil
+}
+
+func (c *chainManager) GetStatusStore(bcname string) (base.StatusStore, error) {
+	chain, err := c.engine.Get(bcname)
+	if err != nil {
+		return nil, fmt.Errorf("chain %s not found", bcname)
+	}
+
+	return chain.Context().State, nil
+}
@@ -0,0 +1,68 @@
+package event
+
+import (
+	"errors"
+
+	"github.com/wooyang2018/corechain/protos"
+	"google.golang.org/protobuf/proto"
+)
+
+// chainStatusTopic handles new tip, fork switch and irreversible height events
+type chainStatusTopic struct {
+	chainmg ChainManager
+}
+
+var _ Topic = (*chainStatusTopic)(nil)
+
+// NewChainStatusTopic instances chainStatusTopic from ChainManager
+func NewChainStatusTopic(chainmg ChainManager) *chainStatusTopic {
+	return &chainStatusTopic{
+		chainmg: chainmg,
+	}
+}
+
+// ParseFilter 从指定的bytes buffer反序列化topic过滤器
+// 返回的参数会作为入参传递给NewIterator的filter参数
+func (c *chainStatusTopic) ParseFilter(buf []byte) (interface{}, error) {
+	pbfilter := new(protos.ChainStatusFilter)
+	err := proto.Unmarshal(buf, pbfilter)
+	if err != nil {
+		return nil, err
+	}
+	return pbfilter, nil
+}
+
+// MarshalEvent encode event payload returns from Iterator.Data()
+func (c *chainStatusTopic) MarshalEvent(x interface{}) ([]byte, error) {
+	msg := x.(proto.Message)
+	return proto.Marshal(msg)
+}
+
+// NewIterator make a new Iterator base on filter
+func (c *chainStatusTopic) NewIterator(ifilter interface{}) (Iterator, error) {
+	pbfilter, ok := ifilter.(*protos.ChainStatusFilter)
+	if !ok {
+		return nil, errors.New("bad filter type for chain status event")
+	}
+	types := make(map[protos.ChainStatusType]bool, len(pbfilter.GetTypes()))
+	for _, tp := range pbfilter.GetTypes() {
+		types[tp] = true
+	}
+
+	statusStore, err := c.chainmg.GetStatusStore(pbfilter.GetBcName())
+	if err != nil {
+		return nil, err
+	}
+
+	events, cancel := statusStore.WatchChainStatus()
+	return newWatchIterator(events, cancel, func(x interface{}) (interface{}, bool) {
+		event, ok := x.(*protos.ChainStatusEvent)
+		if !ok {
+			return nil, false
+		}
+		if len(types) != 0 && !types[event.GetType()] {
+			return nil, false
+		}
+		return event, true
+	}), nil
+}
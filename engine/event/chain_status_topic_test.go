@@ -0,0 +1,57 @@
+package event
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/wooyang2018/corechain/engine/mock"
+	"github.com/wooyang2018/corechain/protos"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestChainStatusTopic(t *testing.T) {
+	ledger := mock.NewMockBlockStore()
+	router := NewRounterFromChainMG(ledger)
+
+	buf, err := proto.Marshal(&protos.ChainStatusFilter{
+		Types: []protos.ChainStatusType{protos.ChainStatusType_NEW_TIP},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	encfunc, iter, err := router.Subscribe(protos.SubscribeType_CHAIN_STATUS, buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer iter.Close()
+
+	block := mock.NewBlockBuilder().Block()
+	ledger.AppendBlock(block)
+	if !iter.Next() {
+		t.Fatal("expect chain status event")
+	}
+	event := iter.Data().(*protos.ChainStatusEvent)
+	if event.GetBlockid() != hex.EncodeToString(block.GetBlockid()) {
+		t.Fatalf("expect %x got %s", block.GetBlockid(), event.GetBlockid())
+	}
+	if _, err := encfunc(event); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestChainStatusTopicTypeFilter(t *testing.T) {
+	ledger := mock.NewMockBlockStore()
+	topic := NewChainStatusTopic(ledger)
+	iter, err := topic.NewIterator(&protos.ChainStatusFilter{
+		Types: []protos.ChainStatusType{protos.ChainStatusType_FORK_SWITCH},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ledger.AppendBlock(mock.NewBlockBuilder().Block())
+	go iter.Close()
+	if iter.Next() {
+		t.Fatal("expect new tip event filtered")
+	}
+}
@@ -0,0 +1,86 @@
+package event
+
+import (
+	"errors"
+
+	"github.com/wooyang2018/corechain/protos"
+	"google.golang.org/protobuf/proto"
+)
+
+// pendingTxTopic handles mempool tx admission and eviction events
+type pendingTxTopic struct {
+	chainmg ChainManager
+}
+
+var _ Topic = (*pendingTxTopic)(nil)
+
+// NewPendingTxTopic instances pendingTxTopic from ChainManager
+func NewPendingTxTopic(chainmg ChainManager) *pendingTxTopic {
+	return &pendingTxTopic{
+		chainmg: chainmg,
+	}
+}
+
+// ParseFilter 从指定的bytes buffer反序列化topic过滤器
+// 返回的参数会作为入参传递给NewIterator的filter参数
+func (p *pendingTxTopic) ParseFilter(buf []byte) (interface{}, error) {
+	pbfilter := new(protos.PendingTxFilter)
+	err := proto.Unmarshal(buf, pbfilter)
+	if err != nil {
+		return nil, err
+	}
+	return pbfilter, nil
+}
+
+// MarshalEvent encode event payload returns from Iterator.Data()
+func (p *pendingTxTopic) MarshalEvent(x interface{}) ([]byte, error) {
+	msg := x.(proto.Message)
+	return proto.Marshal(msg)
+}
+
+// NewIterator make a new Iterator base on filter
+func (p *pendingTxTopic) NewIterator(ifilter interface{}) (Iterator, error) {
+	pbfilter, ok := ifilter.(*protos.PendingTxFilter)
+	if !ok {
+		return nil, errors.New("bad filter type for pending tx event")
+	}
+	// 交易过滤规则与区块事件一致，复用blockFilter
+	filter, err := newBlockFilter(&protos.BlockFilter{
+		BcName:      pbfilter.GetBcName(),
+		Contract:    pbfilter.GetContract(),
+		Initiator:   pbfilter.GetInitiator(),
+		AuthRequire: pbfilter.GetAuthRequire(),
+		FromAddr:    pbfilter.GetFromAddr(),
+		ToAddr:      pbfilter.GetToAddr(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	txPool, err := p.chainmg.GetTxPool(pbfilter.GetBcName())
+	if err != nil {
+		return nil, err
+	}
+
+	events, cancel := txPool.WatchTxPool()
+	return newWatchIterator(events, cancel, func(x interface{}) (interface{}, bool) {
+		event, ok := x.(*protos.PendingTxEvent)
+		if !ok {
+			return nil, false
+		}
+		if pbfilter.GetExcludeEvicted() && event.GetAction() == protos.PendingTxAction_EVICTED {
+			return nil, false
+		}
+		if !matchTx(filter, event.GetTx()) {
+			return nil, false
+		}
+		if pbfilter.GetExcludeTx() {
+			return &protos.PendingTxEvent{
+				Bcname: event.GetBcname(),
+				Txid:   event.GetTxid(),
+				Action: event.GetAction(),
+			}, true
+		}
+		return event, true
+	}), nil
+}
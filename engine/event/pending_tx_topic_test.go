@@ -0,0 +1,77 @@
+package event
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/wooyang2018/corechain/engine/mock"
+	"github.com/wooyang2018/corechain/protos"
+)
+
+func TestPendingTxTopicFilter(t *testing.T) {
+	ledger := mock.NewMockBlockStore()
+	topic := NewPendingTxTopic(ledger)
+	iter, err := topic.NewIterator(&protos.PendingTxFilter{
+		Contract:       "counter",
+		ExcludeEvicted: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer iter.Close()
+
+	transfer := mock.NewTxBuilder().Transfer("alice", "bob", "10").Tx()
+	invoke := mock.NewTxBuilder().Invoke("counter", "increase").Tx()
+	ledger.PutTx(transfer, false)
+	ledger.PutTx(invoke, true)
+	ledger.PutTx(invoke, false)
+
+	if !iter.Next() {
+		t.Fatal("expect pending tx event")
+	}
+	event := iter.Data().(*protos.PendingTxEvent)
+	if event.GetTxid() != hex.EncodeToString(invoke.GetTxid()) {
+		t.Fatalf("expect %x got %s", invoke.GetTxid(), event.GetTxid())
+	}
+	if event.GetAction() != protos.PendingTxAction_ADMITTED {
+		t.Fatalf("expect admitted got %s", event.GetAction())
+	}
+}
+
+func TestPendingTxTopicExcludeTx(t *testing.T) {
+	ledger := mock.NewMockBlockStore()
+	topic := NewPendingTxTopic(ledger)
+	iter, err := topic.NewIterator(&protos.PendingTxFilter{
+		ExcludeTx: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer iter.Close()
+
+	tx := mock.NewTxBuilder().Initiator("alice").Tx()
+	ledger.PutTx(tx, true)
+	if !iter.Next() {
+		t.Fatal("expect pending tx event")
+	}
+	event := iter.Data().(*protos.PendingTxEvent)
+	if event.GetTx() != nil {
+		t.Fatal("expect tx excluded")
+	}
+	if event.GetAction() != protos.PendingTxAction_EVICTED {
+		t.Fatalf("expect evicted got %s", event.GetAction())
+	}
+}
+
+func TestPendingTxTopicClose(t *testing.T) {
+	ledger := mock.NewMockBlockStore()
+	topic := NewPendingTxTopic(ledger)
+	iter, err := topic.NewIterator(&protos.PendingTxFilter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	iter.Close()
+	if iter.Next() {
+		t.Fatal("expect no event after close")
+	}
+}
@@ -14,11 +14,12 @@ type Router struct {
 
 // NewRounterFromChainMG instance Router from ChainManager
 func NewRounterFromChainMG(chainmg ChainManager) *Router {
-	blockTopic := NewBlockTopic(chainmg)
 	r := &Router{
 		topics: make(map[protos.SubscribeType]Topic),
 	}
-	r.topics[protos.SubscribeType_BLOCK] = blockTopic
+	r.topics[protos.SubscribeType_BLOCK] = NewBlockTopic(chainmg)
+	r.topics[protos.SubscribeType_PENDING_TX] = NewPendingTxTopic(chainmg)
+	r.topics[protos.SubscribeType_CHAIN_STATUS] = NewChainStatusTopic(chainmg)
 
 	return r
 }
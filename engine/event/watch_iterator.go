@@ -0,0 +1,62 @@
+package event
+
+import "sync"
+
+var _ Iterator = (*watchIterator)(nil)
+
+// watchFunc 过滤并转换事件，返回false表示丢弃该事件
+type watchFunc func(x interface{}) (interface{}, bool)
+
+// watchIterator wraps around a event channel as a iterator style interface
+type watchIterator struct {
+	events <-chan interface{}
+	cancel func()
+	filter watchFunc
+	data   interface{}
+
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func newWatchIterator(events <-chan interface{}, cancel func(), filter watchFunc) *watchIterator {
+	return &watchIterator{
+		events: events,
+		cancel: cancel,
+		filter: filter,
+		done:   make(chan struct{}),
+	}
+}
+
+func (w *watchIterator) Next() bool {
+	for {
+		select {
+		case <-w.done:
+			return false
+		case x, ok := <-w.events:
+			if !ok {
+				return false
+			}
+			data, match := w.filter(x)
+			if !match {
+				continue
+			}
+			w.data = data
+			return true
+		}
+	}
+}
+
+func (w *watchIterator) Data() interface{} {
+	return w.data
+}
+
+func (w *watchIterator) Error() error {
+	return nil
+}
+
+func (w *watchIterator) Close() {
+	w.closeOnce.Do(func() {
+		close(w.done)
+		w.cancel()
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math/big"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/patrickmn/go-cache"
@@ -62,6 +63,8 @@ type Miner struct {
 
 	// 标记是否退出运行
 	isExit bool
+	// 标记是否暂停出块，暂停期间不出块也不同步，账本仍可查询
+	isPaused int32
 	// 用户等待退出
 	exitWG sync.WaitGroup
 }
@@ -127,6 +130,20 @@ func (t *Miner) IsExit() bool {
 	return t.isExit
 }
 
+// Pause 暂停出块
+func (t *Miner) Pause() {
+	atomic.StoreInt32(&t.isPaused, 1)
+}
+
+// Resume 恢复出块
+func (t *Miner) Resume() {
+	atomic.StoreInt32(&t.isPaused, 0)
+}
+
+func (t *Miner) IsPaused() bool {
+	return atomic.LoadInt32(&t.isPaused) == 1
+}
+
 func traceMiner() func(string) {
 	last := time.Now()
 	return func(action string) {
@@ -138,6 +155,11 @@ func traceMiner() func(string) {
 // step 用于推动节点循环进行一次动作，可以是一次出块动作(矿工角色)，也可以是一次区块同步（非矿工）
 // 在此期间可能会发生节点角色变更。
 func (t *Miner) step() error {
+	if t.IsPaused() {
+		time.Sleep(time.Second)
+		return nil
+	}
+
 	ledgerTipId := t.ctx.Ledger.GetMeta().TipBlockid
 	ledgerTipHeight := t.ctx.Ledger.GetMeta().TrunkHeight
 	stateTipId := t.ctx.State.GetLatestBlockid()
@@ -350,7 +372,7 @@ func (t *Miner) packBlock(ctx xctx.Context, height int64,
 	ctx.GetLog().Debug("pack block get timer tx succ", "auto tx", autoTx)
 
 	// 2.选择本次要打包的tx
-	generalTxList, err := t.getUnconfirmedTx(sizeLimit)
+	generalTxList, err := t.getUnconfirmedTx(height, now, sizeLimit)
 	if err != nil {
 		return nil, err
 	}
@@ -417,12 +439,14 @@ func (t *Miner) getTimerTx(height int64) (*protos.Transaction, error) {
 	return autoTx, nil
 }
 
-func (t *Miner) getUnconfirmedTx(sizeLimit int) ([]*protos.Transaction, error) {
+// getUnconfirmedTx 回滚恢复的交易可能在本区块高度下时间锁尚未到期，打包前过滤掉，
+// 与区块校验时使用相同的高度和时间
+func (t *Miner) getUnconfirmedTx(height int64, now time.Time, sizeLimit int) ([]*protos.Transaction, error) {
 	unconfirmedTxs, err := t.ctx.State.GetUnconfirmedTx(false, sizeLimit)
 	if err != nil {
 		return nil, err
 	}
-	return unconfirmedTxs, nil
+	return ltx.FilterMatureTxs(unconfirmedTxs, height, now.Unix()), nil
 	// txList := make([]*protos.Transaction, 0)
 	// for _, tx := range unconfirmedTxs {
 	// 	size := proto.Size(tx)
@@ -3,6 +3,7 @@ package miner
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -313,8 +314,21 @@ func (t *Miner) getBlocksByHeight(ctx xctx.Context, height int64, size int) ([]*
 }
 
 func (t *Miner) fillBlockTxs(ctx xctx.Context, block *protos.InternalBlock) error {
+	return FillBlockTxs(ctx, t.ctx, block, block.GetMerkleTree()[:block.GetTxCount()])
+}
+
+// WithSyncPeers 指定补全区块交易时下载缺失交易的节点
+func WithSyncPeers(ctx xctx.Context, peers []string) xctx.Context {
+	return xctx.WithNewContext(ctx, context.WithValue(ctx, peersKey, peers))
+}
+
+// FillBlockTxs 从未确认交易池补全区块交易，缺失的交易从其他节点下载
+// txids为区块内各交易的ID，也可以是交易ID的前缀(紧凑区块的短ID)
+func FillBlockTxs(ctx xctx.Context, chainCtx *base.ChainCtx, block *protos.InternalBlock, txids [][]byte) error {
 	trace := traceSync()
-	txids := block.GetMerkleTree()[:block.GetTxCount()]
+	if len(txids) != int(block.GetTxCount()) {
+		return fmt.Errorf("txids count mismatch, got:%d, expect:%d", len(txids), block.GetTxCount())
+	}
 
 	blockTxs := make([]*protos.Transaction, len(txids))
 	if len(block.Transactions) > 0 && block.Transactions[0] != nil {
@@ -322,12 +336,20 @@ func (t *Miner) fillBlockTxs(ctx xctx.Context, block *protos.InternalBlock) erro
 		blockTxs[0] = block.Transactions[0]
 	}
 
+	var shortTxs map[string]*protos.Transaction
 	var missingTxIdx []int32
 	for idx, txid := range txids {
 		if blockTxs[idx] != nil {
 			continue
 		}
-		tx, ok := t.ctx.State.GetUnconfirmedTxFromId(txid)
+		tx, ok := chainCtx.State.GetUnconfirmedTxFromId(txid)
+		if !ok && len(txid) < sha256.Size {
+			if shortTxs == nil {
+				shortTxs = indexUnconfirmedTxs(chainCtx.State, len(txid))
+			}
+			tx = shortTxs[string(txid)]
+			ok = tx != nil
+		}
 		if !ok {
 			missingTxIdx = append(missingTxIdx, int32(idx))
 			continue
@@ -336,12 +358,15 @@ func (t *Miner) fillBlockTxs(ctx xctx.Context, block *protos.InternalBlock) erro
 	}
 	trace("fillUnconfirmed")
 	ctx.GetLog().Info("fillBlockTxs", "total", int(block.GetTxCount()), "missing", len(missingTxIdx))
-	missingTxs, err := t.downloadMissingTxs(ctx, block.Blockid, missingTxIdx)
+	missingTxs, err := downloadMissingTxs(ctx, chainCtx, block.Blockid, missingTxIdx)
 	if err != nil {
 		return err
 	}
+	if len(missingTxs) != len(missingTxIdx) {
+		return fmt.Errorf("download txs count mismatch, got:%d, expect:%d", len(missingTxs), len(missingTxIdx))
+	}
 	for i, idx := range missingTxIdx {
-		if !bytes.Equal(txids[idx], missingTxs[i].Txid) {
+		if !bytes.HasPrefix(missingTxs[i].Txid, txids[idx]) {
 			return fmt.Errorf("download tx for %x error, got:%x", txids[idx], missingTxs[i].Txid)
 		}
 		blockTxs[idx] = missingTxs[i]
@@ -351,12 +376,30 @@ func (t *Miner) fillBlockTxs(ctx xctx.Context, block *protos.InternalBlock) erro
 	return nil
 }
 
-func (t *Miner) downloadMissingTxs(ctx xctx.Context, blockid []byte, txidx []int32) ([]*protos.Transaction, error) {
+// indexUnconfirmedTxs 按交易ID前缀索引未确认交易，前缀冲突的交易不参与匹配，需重新下载
+func indexUnconfirmedTxs(st *state.State, size int) map[string]*protos.Transaction {
+	txs, _ := st.GetUnconfirmedTx(false, 0)
+	index := make(map[string]*protos.Transaction, len(txs))
+	for _, tx := range txs {
+		if len(tx.Txid) < size {
+			continue
+		}
+		key := string(tx.Txid[:size])
+		if _, ok := index[key]; ok {
+			index[key] = nil
+			continue
+		}
+		index[key] = tx
+	}
+	return index
+}
+
+func downloadMissingTxs(ctx xctx.Context, chainCtx *base.ChainCtx, blockid []byte, txidx []int32) ([]*protos.Transaction, error) {
 	if len(txidx) == 0 {
 		return nil, nil
 	}
 	input := &protos.GetBlockTxsRequest{
-		Bcname:  t.ctx.BcName,
+		Bcname:  chainCtx.BcName,
 		Blockid: blockid,
 		Txs:     txidx,
 	}
@@ -371,8 +414,8 @@ func (t *Miner) downloadMissingTxs(ctx xctx.Context, blockid []byte, txidx []int
 		opts = append(opts, netBase.WithFilter([]netBase.FilterStrategy{netBase.NearestBucketStrategy}))
 	}
 
-	msg := network.NewMessage(protos.CoreMessage_GET_BLOCK_TXS, input, network.WithBCName(t.ctx.BcName))
-	responses, err := t.ctx.EngCtx.Net.SendMessageWithResponse(ctx, msg, opts...)
+	msg := network.NewMessage(protos.CoreMessage_GET_BLOCK_TXS, input, network.WithBCName(chainCtx.BcName))
+	responses, err := chainCtx.EngCtx.Net.SendMessageWithResponse(ctx, msg, opts...)
 	if err != nil {
 		ctx.GetLog().Warn("confirm block chain status error", "err", err)
 		return nil, err
@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"encoding/hex"
 	"errors"
 	"sync"
 
@@ -15,11 +16,15 @@ type mockBlockStore struct {
 	blocks []*protos.InternalBlock
 
 	heightNotifier *state.BlockHeightNotifier
+	txEvents       *state.EventNotifier
+	statusEvents   *state.EventNotifier
 }
 
 func NewMockBlockStore() *mockBlockStore {
 	return &mockBlockStore{
 		heightNotifier: state.NewBlockHeightNotifier(),
+		txEvents:       state.NewEventNotifier(0),
+		statusEvents:   state.NewEventNotifier(0),
 	}
 }
 
@@ -55,9 +60,47 @@ func (m *mockBlockStore) AppendBlock(block *protos.InternalBlock) {
 	nblock.Height = int64(len(m.blocks))
 	m.blocks = append(m.blocks, &nblock)
 	m.heightNotifier.UpdateHeight(nblock.Height)
+	m.statusEvents.Notify(&protos.ChainStatusEvent{
+		Type:        protos.ChainStatusType_NEW_TIP,
+		Blockid:     hex.EncodeToString(nblock.GetBlockid()),
+		BlockHeight: nblock.Height,
+	})
+}
+
+// PutTx notify the admission or eviction of tx
+func (m *mockBlockStore) PutTx(tx *protos.Transaction, evicted bool) {
+	action := protos.PendingTxAction_ADMITTED
+	if evicted {
+		action = protos.PendingTxAction_EVICTED
+	}
+	m.txEvents.Notify(&protos.PendingTxEvent{
+		Txid:   hex.EncodeToString(tx.GetTxid()),
+		Action: action,
+		Tx:     tx,
+	})
+}
+
+// WatchTxPool returns the channel of *protos.PendingTxEvent and the function to stop watching
+func (m *mockBlockStore) WatchTxPool() (<-chan interface{}, func()) {
+	return m.txEvents.Subscribe()
+}
+
+// WatchChainStatus returns the channel of *protos.ChainStatusEvent and the function to stop watching
+func (m *mockBlockStore) WatchChainStatus() (<-chan interface{}, func()) {
+	return m.statusEvents.Subscribe()
 }
 
 // GetBlockStore get BlockStore base bcname(the name of block chain)
 func (m *mockBlockStore) GetBlockStore(bcname string) (base.BlockStore, error) {
 	return m, nil
 }
+
+// GetTxPool get TxPool base bcname
+func (m *mockBlockStore) GetTxPool(bcname string) (base.TxPool, error) {
+	return m, nil
+}
+
+// GetStatusStore get StatusStore base bcname
+func (m *mockBlockStore) GetStatusStore(bcname string) (base.StatusStore, error) {
+	return m, nil
+}
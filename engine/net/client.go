@@ -35,9 +35,11 @@ func (t *NetEvent) GetBlock(ctx xctx.Context, request *protos.CoreMessage) (*pro
 		err := network.Unmarshal(response, &block)
 		if err != nil {
 			ctx.GetLog().Warn("GetBlock unmarshal error", "error", err, "from", response.GetHeader().GetFrom())
+			t.engine.Context().Net.ReportPeer(response.GetHeader().GetFrom(), netBase.PeerEventInvalidMessage)
 			continue
 		}
 
+		t.engine.Context().Net.ReportPeer(response.GetHeader().GetFrom(), netBase.PeerEventUsefulResponse)
 		return &block, nil
 	}
 
@@ -0,0 +1,81 @@
+package net
+
+import (
+	"bytes"
+
+	xctx "github.com/wooyang2018/corechain/common/context"
+	"github.com/wooyang2018/corechain/engine/base"
+	"github.com/wooyang2018/corechain/engine/miner"
+	"github.com/wooyang2018/corechain/ledger"
+	"github.com/wooyang2018/corechain/protos"
+)
+
+const (
+	// 紧凑区块中交易短ID的长度，取交易ID前缀
+	ShortTxIDSize = 8
+)
+
+// isCompactBlockEnabled 网络配置中按链开启紧凑区块广播
+func (t *NetEvent) isCompactBlockEnabled(bcname string) bool {
+	return t.engine.Context().Net.Context().P2PConf.CompactBlock[bcname]
+}
+
+// isCompactBlock 紧凑区块只携带coinbase交易和各交易的短ID，完整区块不带短ID
+func isCompactBlock(block *protos.InternalBlock) bool {
+	return len(block.ShortTxids) > 0
+}
+
+// NewCompactBlock 由完整区块生成紧凑区块：区块头、coinbase交易和交易短ID
+func NewCompactBlock(block *protos.InternalBlock) *protos.InternalBlock {
+	compact := &protos.InternalBlock{
+		Version:     block.Version,
+		Nonce:       block.Nonce,
+		Blockid:     block.Blockid,
+		PreHash:     block.PreHash,
+		Proposer:    block.Proposer,
+		Sign:        block.Sign,
+		Pubkey:      block.Pubkey,
+		MerkleRoot:  block.MerkleRoot,
+		Height:      block.Height,
+		Timestamp:   block.Timestamp,
+		TxCount:     block.TxCount,
+		CurTerm:     block.CurTerm,
+		CurBlockNum: block.CurBlockNum,
+		FailedTxs:   block.FailedTxs,
+		TargetBits:  block.TargetBits,
+		Justify:     block.Justify,
+	}
+	if len(block.Transactions) > 0 {
+		compact.Transactions = block.Transactions[:1]
+	}
+	compact.ShortTxids = make([][]byte, 0, len(block.Transactions))
+	for _, tx := range block.Transactions {
+		shortID := tx.Txid
+		if len(shortID) > ShortTxIDSize {
+			shortID = shortID[:ShortTxIDSize]
+		}
+		compact.ShortTxids = append(compact.ShortTxids, shortID)
+	}
+	return compact
+}
+
+// fillCompactBlock 从未确认交易池还原紧凑区块，缺失的交易向广播该区块的节点下载
+func (t *NetEvent) fillCompactBlock(ctx xctx.Context, chain base.Chain, block *protos.InternalBlock, from string) error {
+	// chain已经Stop
+	if chain.Context() == nil {
+		return base.ErrChainNotExist
+	}
+
+	ctx = miner.WithSyncPeers(ctx, []string{from})
+	if err := miner.FillBlockTxs(ctx, chain.Context(), block, block.ShortTxids); err != nil {
+		ctx.GetLog().Warn("fill compact block txs error", "blockid", block.Blockid, "error", err)
+		return base.ErrBlockNotExist
+	}
+
+	block.ShortTxids = nil
+	block.MerkleTree = ledger.MakeMerkleTree(block.Transactions)
+	if !bytes.Equal(block.MerkleTree[len(block.MerkleTree)-1], block.MerkleRoot) {
+		return base.ErrParameter.More("compact block merkle root mismatch")
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package net
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	"github.com/wooyang2018/corechain/ledger"
+	"github.com/wooyang2018/corechain/protos"
+)
+
+func TestCompactBlock(t *testing.T) {
+	txs := make([]*protos.Transaction, 0, 5)
+	for i := 0; i < 5; i++ {
+		txid := sha256.Sum256([]byte(fmt.Sprintf("tx%d", i)))
+		txs = append(txs, &protos.Transaction{Txid: txid[:]})
+	}
+	tree := ledger.MakeMerkleTree(txs)
+	block := &protos.InternalBlock{
+		Blockid:      []byte("blockid"),
+		Height:       10,
+		Transactions: txs,
+		TxCount:      int32(len(txs)),
+		MerkleTree:   tree,
+		MerkleRoot:   tree[len(tree)-1],
+	}
+	if isCompactBlock(block) {
+		t.Fatal("full block detected as compact block")
+	}
+
+	compact := NewCompactBlock(block)
+	if !isCompactBlock(compact) {
+		t.Fatal("compact block not detected")
+	}
+	if len(compact.Transactions) != 1 || !bytes.Equal(compact.Transactions[0].Txid, txs[0].Txid) {
+		t.Fatal("compact block should carry coinbase tx only")
+	}
+	if len(compact.MerkleTree) != 0 || len(compact.ShortTxids) != len(txs) {
+		t.Fatal("compact block should carry short ids only")
+	}
+	for i, shortID := range compact.ShortTxids {
+		if len(shortID) != ShortTxIDSize || !bytes.HasPrefix(txs[i].Txid, shortID) {
+			t.Fatalf("bad short id at %d: %x", i, shortID)
+		}
+	}
+	if !bytes.Equal(compact.MerkleRoot, block.MerkleRoot) || compact.Height != block.Height {
+		t.Fatal("compact block header mismatch")
+	}
+	// 生成紧凑区块不能修改原区块
+	if len(block.MerkleTree) != len(tree) || len(block.Transactions) != len(txs) {
+		t.Fatal("origin block modified")
+	}
+}
@@ -12,6 +12,17 @@ var errorType = map[error]protos.CoreMessage_ErrorType{
 	base.ErrParameter:     protos.CoreMessage_UNMARSHAL_MSG_BODY_ERROR,
 }
 
+// isInvalidTx 交易本身不合法时才计入对端信誉分，重复交易等无法归责于对端的错误不计入
+func isInvalidTx(err error) bool {
+	e := base.CastError(err)
+	return e.Equal(base.ErrParameter) || e.Equal(base.ErrTxVerifyFailed) || e.Equal(base.ErrTxNotEnough)
+}
+
+// isInvalidBlock 区块本身不合法时才计入对端信誉分，本地未同步等原因导致的处理失败不计入
+func isInvalidBlock(err error) bool {
+	return base.CastError(err).Equal(base.ErrParameter)
+}
+
 func ErrorType(err error) protos.CoreMessage_ErrorType {
 	if err == nil {
 		return protos.CoreMessage_SUCCESS
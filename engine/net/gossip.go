@@ -0,0 +1,138 @@
+package net
+
+import (
+	"bytes"
+
+	"github.com/wooyang2018/corechain/engine/base"
+	"github.com/wooyang2018/corechain/network"
+	netBase "github.com/wooyang2018/corechain/network/base"
+	"github.com/wooyang2018/corechain/permission"
+	permBase "github.com/wooyang2018/corechain/permission/base"
+	"github.com/wooyang2018/corechain/protos"
+	"github.com/wooyang2018/corechain/state"
+	"github.com/wooyang2018/corechain/state/txhash"
+)
+
+// registerGossipValidators 开启gossip时，交易和区块在转发前先校验id和签名，避免伪造的消息在全网扩散
+func (t *NetEvent) registerGossipValidators() {
+	aware, ok := t.engine.Context().Net.(netBase.GossipAware)
+	if !ok {
+		return
+	}
+
+	validators := map[protos.CoreMessage_MessageType]netBase.GossipValidator{
+		protos.CoreMessage_POSTTX:      t.validateGossipTx,
+		protos.CoreMessage_BATCHPOSTTX: t.validateGossipTxs,
+		protos.CoreMessage_SENDBLOCK:   t.validateGossipBlock,
+		protos.CoreMessage_NEW_BLOCKID: t.validateGossipBlockID,
+	}
+	for typ, validator := range validators {
+		if err := aware.AddGossipValidator(typ, validator); err != nil {
+			t.log.Debug("gossip validator not registered", "type", typ, "error", err)
+			return
+		}
+	}
+}
+
+// gossipChain 本节点未加载的链不做校验也不转发，但不视为对端作恶
+func (t *NetEvent) gossipChain(msg *protos.CoreMessage) (base.Chain, error) {
+	chain, err := t.engine.Get(msg.GetHeader().GetBcname())
+	if err != nil || chain.Context() == nil {
+		return nil, network.ErrGossipIgnore
+	}
+	return chain, nil
+}
+
+func (t *NetEvent) validateGossipTx(msg *protos.CoreMessage) error {
+	var tx protos.Transaction
+	if err := network.Unmarshal(msg, &tx); err != nil {
+		return err
+	}
+	if _, err := t.gossipChain(msg); err != nil {
+		return err
+	}
+	return verifyGossipTx(&tx)
+}
+
+func (t *NetEvent) validateGossipTxs(msg *protos.CoreMessage) error {
+	var txs protos.Transactions
+	if err := network.Unmarshal(msg, &txs); err != nil {
+		return err
+	}
+	if _, err := t.gossipChain(msg); err != nil {
+		return err
+	}
+	for _, tx := range txs.Txs {
+		if err := verifyGossipTx(tx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *NetEvent) validateGossipBlock(msg *protos.CoreMessage) error {
+	var block protos.InternalBlock
+	if err := network.Unmarshal(msg, &block); err != nil {
+		return err
+	}
+	if err := validateSendBlock(&block); err != nil {
+		return err
+	}
+	chain, err := t.gossipChain(msg)
+	if err != nil {
+		return err
+	}
+
+	// 紧凑区块没有完整交易，只校验区块头
+	ledger := chain.Context().Ledger
+	verify := ledger.VerifyBlock
+	if isCompactBlock(&block) {
+		verify = ledger.VerifyBlockHeader
+	}
+	if ok, _ := verify(&block, msg.GetHeader().GetLogid()); !ok {
+		return ErrBlockInvalid
+	}
+	return nil
+}
+
+func (t *NetEvent) validateGossipBlockID(msg *protos.CoreMessage) error {
+	var block protos.InternalBlock
+	if err := network.Unmarshal(msg, &block); err != nil {
+		return err
+	}
+	if err := validateSendBlock(&block); err != nil {
+		return err
+	}
+	_, err := t.gossipChain(msg)
+	return err
+}
+
+// verifyGossipTx 与ImmediateVerifyTx一致校验txid，地址发起的交易还需校验发起人签名，账户的签名依赖状态在提交时校验
+func verifyGossipTx(tx *protos.Transaction) error {
+	if err := validatePostTx(tx); err != nil {
+		return err
+	}
+	if tx.Version <= state.RootTxVersion {
+		return nil
+	}
+
+	txid, err := txhash.MakeTxID(tx)
+	if err != nil || !bytes.Equal(txid, tx.Txid) {
+		return ErrTxIDInvalid
+	}
+
+	if tx.GetXuperSign() != nil || permBase.IsAccount(tx.Initiator) == 1 {
+		return nil
+	}
+	if len(tx.InitiatorSigns) < 1 {
+		return ErrTxSignInvalid
+	}
+	digestHash, err := txhash.MakeTxDigestHash(tx)
+	if err != nil {
+		return ErrTxSignInvalid
+	}
+	if ok, err := permission.IdentifyAK(tx.Initiator, tx.InitiatorSigns[0], digestHash); err != nil || !ok {
+		return ErrTxSignInvalid
+	}
+	return nil
+}
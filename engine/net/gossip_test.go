@@ -0,0 +1,39 @@
+package net
+
+import (
+	"testing"
+
+	"github.com/wooyang2018/corechain/protos"
+	"github.com/wooyang2018/corechain/state/txhash"
+)
+
+func TestVerifyGossipTx(t *testing.T) {
+	tx := &protos.Transaction{
+		Version:   1,
+		Desc:      []byte("gossip"),
+		Initiator: "TeyyPLpp9L7QAcxHangtcHTu7HUZ6iydY",
+	}
+	tx.Txid = []byte("forged")
+	if err := verifyGossipTx(tx); err != ErrTxIDInvalid {
+		t.Fatalf("expect txid invalid, got: %v", err)
+	}
+
+	tx.Txid, _ = txhash.MakeTxID(tx)
+	if err := verifyGossipTx(tx); err != ErrTxSignInvalid {
+		t.Fatalf("expect sign invalid, got: %v", err)
+	}
+
+	tx.InitiatorSigns = []*protos.SignatureInfo{{PublicKey: "{}", Sign: []byte("sign")}}
+	tx.Txid, _ = txhash.MakeTxID(tx)
+	if err := verifyGossipTx(tx); err != ErrTxSignInvalid {
+		t.Fatalf("expect forged sign invalid, got: %v", err)
+	}
+
+	// 账户的签名需要读取状态中的ACL，留到提交交易时校验
+	tx.Initiator = "XC1111111111111111@corechain"
+	tx.InitiatorSigns = nil
+	tx.Txid, _ = txhash.MakeTxID(tx)
+	if err := verifyGossipTx(tx); err != nil {
+		t.Fatalf("account initiator should pass, got: %v", err)
+	}
+}
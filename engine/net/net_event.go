@@ -15,6 +15,7 @@ import (
 	"github.com/wooyang2018/corechain/engine/reader"
 	"github.com/wooyang2018/corechain/logger"
 	"github.com/wooyang2018/corechain/network"
+	netBase "github.com/wooyang2018/corechain/network/base"
 	"github.com/wooyang2018/corechain/protos"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/protobuf/proto"
@@ -105,6 +106,7 @@ func (t *NetEvent) Subscriber() error {
 	}
 
 	t.log.Debug("register subscriber succ")
+	t.registerGossipValidators()
 	return nil
 }
 
@@ -149,6 +151,7 @@ func (t *NetEvent) handlePostTx(ctx xctx.Context, request *protos.CoreMessage) {
 	var tx protos.Transaction
 	if err := network.Unmarshal(request, &tx); err != nil {
 		ctx.GetLog().Warn("handlePostTx Unmarshal request error", "error", err)
+		t.reportPeer(request, netBase.PeerEventInvalidMessage)
 		return
 	}
 
@@ -161,6 +164,8 @@ func (t *NetEvent) handlePostTx(ctx xctx.Context, request *protos.CoreMessage) {
 	err = t.PostTx(ctx, chain, &tx)
 	if err == nil {
 		go t.engine.Context().Net.SendMessage(ctx, request)
+	} else if isInvalidTx(err) {
+		t.reportPeer(request, netBase.PeerEventInvalidTx)
 	}
 }
 
@@ -168,6 +173,7 @@ func (t *NetEvent) handleBatchPostTx(ctx xctx.Context, request *protos.CoreMessa
 	var input protos.Transactions
 	if err := network.Unmarshal(request, &input); err != nil {
 		ctx.GetLog().Warn("handleBatchPostTx Unmarshal request error", "error", err)
+		t.reportPeer(request, netBase.PeerEventInvalidMessage)
 		return
 	}
 
@@ -182,6 +188,9 @@ func (t *NetEvent) handleBatchPostTx(ctx xctx.Context, request *protos.CoreMessa
 		err := t.PostTx(ctx, chain, tx)
 		if err != nil {
 			ctx.GetLog().Warn("post tx error", "bcName", request.GetHeader().GetBcname(), "error", err)
+			if isInvalidTx(err) {
+				t.reportPeer(request, netBase.PeerEventInvalidTx)
+			}
 			return
 		}
 
@@ -197,7 +206,7 @@ func (t *NetEvent) handleBatchPostTx(ctx xctx.Context, request *protos.CoreMessa
 func (t *NetEvent) PostTx(ctx xctx.Context, chain base.Chain, tx *protos.Transaction) error {
 	if err := validatePostTx(tx); err != nil {
 		ctx.GetLog().Debug("PostTx validate param errror", "error", err)
-		return base.CastError(err)
+		return base.ErrParameter.More("%v", err)
 	}
 
 	// chain已经Stop
@@ -217,6 +226,7 @@ func (t *NetEvent) handleSendBlock(ctx xctx.Context, request *protos.CoreMessage
 	var block protos.InternalBlock
 	if err := network.Unmarshal(request, &block); err != nil {
 		ctx.GetLog().Warn("handleSendBlock Unmarshal request error", "error", err)
+		t.reportPeer(request, netBase.PeerEventInvalidMessage)
 		return
 	}
 
@@ -226,12 +236,31 @@ func (t *NetEvent) handleSendBlock(ctx xctx.Context, request *protos.CoreMessage
 		return
 	}
 
+	if isCompactBlock(&block) {
+		if err := t.fillCompactBlock(ctx, chain, &block, request.GetHeader().GetFrom()); err != nil {
+			if isInvalidBlock(err) {
+				t.reportPeer(request, netBase.PeerEventInvalidBlock)
+			}
+			return
+		}
+	}
+
 	if err := t.SendBlock(ctx, chain, &block); err != nil {
+		if isInvalidBlock(err) {
+			t.reportPeer(request, netBase.PeerEventInvalidBlock)
+		}
 		return
 	}
+	t.reportPeer(request, netBase.PeerEventUsefulResponse)
 
 	net := t.engine.Context().Net
 	if t.engine.Context().EngCfg.BlockBroadcastMode == base.FullBroadCastMode {
+		if t.isCompactBlockEnabled(request.Header.Bcname) && block.TxCount > 1 {
+			msg := network.NewMessage(protos.CoreMessage_SENDBLOCK, NewCompactBlock(&block),
+				network.WithBCName(request.Header.Bcname), network.WithLogId(request.Header.Logid))
+			go net.SendMessage(ctx, msg)
+			return
+		}
 		go net.SendMessage(ctx, request)
 	} else {
 		blockID := &protos.InternalBlock{
@@ -251,11 +280,14 @@ func (t *NetEvent) handleNewBlockID(ctx xctx.Context, request *protos.CoreMessag
 
 	block, err := t.GetBlock(ctx, request)
 	if err != nil {
-		ctx.GetLog().Warn("GetBlock error", "error", err, "blockId", block.Blockid)
+		ctx.GetLog().Warn("GetBlock error", "error", err, "from", request.GetHeader().GetFrom())
 		return
 	}
 
 	if err := t.SendBlock(ctx, chain, block); err != nil {
+		if isInvalidBlock(err) {
+			t.reportPeer(request, netBase.PeerEventInvalidBlock)
+		}
 		return
 	}
 
@@ -266,7 +298,7 @@ func (t *NetEvent) handleNewBlockID(ctx xctx.Context, request *protos.CoreMessag
 func (t *NetEvent) SendBlock(ctx xctx.Context, chain base.Chain, in *protos.InternalBlock) error {
 	if err := validateSendBlock(in); err != nil {
 		ctx.GetLog().Debug("SendBlock validate param error", "error", err)
-		return err
+		return base.ErrParameter.More("%v", err)
 	}
 
 	if err := chain.ProcBlock(ctx, in); err != nil {
@@ -321,6 +353,7 @@ func (t *NetEvent) handleGetBlock(ctx xctx.Context,
 	err := network.Unmarshal(request, &input)
 	if err != nil {
 		ctx.GetLog().Error("unmarshal error", "bcName", bcName, "error", err)
+		t.reportPeer(request, netBase.PeerEventInvalidMessage)
 		return response(base.ErrParameter)
 	}
 
@@ -367,6 +400,7 @@ func (t *NetEvent) handleGetBlockHeaders(ctx xctx.Context,
 	err := network.Unmarshal(request, &input)
 	if err != nil {
 		ctx.GetLog().Error("unmarshal error", "bcName", bcName, "error", err)
+		t.reportPeer(request, netBase.PeerEventInvalidMessage)
 		return response(base.ErrParameter)
 	}
 
@@ -453,6 +487,7 @@ func (t *NetEvent) handleGetBlockTxs(ctx xctx.Context,
 	err := network.Unmarshal(request, &input)
 	if err != nil {
 		ctx.GetLog().Error("unmarshal error", "bcName", bcName, "error", err)
+		t.reportPeer(request, netBase.PeerEventInvalidMessage)
 		return response(base.ErrParameter)
 	}
 
@@ -534,6 +569,7 @@ func (t *NetEvent) handleConfirmChainStatus(ctx xctx.Context, request *protos.Co
 	err := network.Unmarshal(request, &input)
 	if err != nil {
 		ctx.GetLog().Error("unmarshal error", "bcName", bcName, "error", err)
+		t.reportPeer(request, netBase.PeerEventInvalidMessage)
 		return response(base.ErrParameter)
 	}
 
@@ -559,3 +595,8 @@ func (t *NetEvent) handleConfirmChainStatus(ctx xctx.Context, request *protos.Co
 
 	return response(nil)
 }
+
+// reportPeer 上报对端消息的处理结果，网络层据此调整对端信誉分
+func (t *NetEvent) reportPeer(request *protos.CoreMessage, event netBase.PeerEvent) {
+	t.engine.Context().Net.ReportPeer(request.GetHeader().GetFrom(), event)
+}
@@ -18,6 +18,12 @@ var (
 	ErrBlockNil = errors.New("validation error: validateSendBlock Block.Block can't be null")
 	// ErrTxInvalid is returned when tx invaild
 	ErrTxInvalid = errors.New("validation error: tx info is invaild")
+	// ErrTxIDInvalid is returned when txid not match tx content
+	ErrTxIDInvalid = errors.New("validation error: txid not match tx content")
+	// ErrTxSignInvalid is returned when initiator sign invalid
+	ErrTxSignInvalid = errors.New("validation error: tx initiator sign invalid")
+	// ErrBlockInvalid is returned when blockid or proposer sign invalid
+	ErrBlockInvalid = errors.New("validation error: blockid or sign invalid")
 )
 
 func validatePostTx(tx *protos.Transaction) error {
@@ -0,0 +1,110 @@
+package noderegistry
+
+import (
+	"errors"
+
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/peer"
+	contractBase "github.com/wooyang2018/corechain/contract/base"
+)
+
+const (
+	NodeKernelContract = "$node"
+
+	// 账户当前登记的节点ID
+	accountPrefix = "account_"
+	// 节点ID对应的账户
+	peerPrefix = "peer_"
+
+	success = 200
+)
+
+var (
+	ErrInvalidPeerID  = errors.New("peer_id should be a valid p2p peer id")
+	ErrInvalidPubKey  = errors.New("pubkey should be the marshaled public key of peer_id")
+	ErrInvalidSign    = errors.New("sign should be made by the peer key over account and peer_id")
+	ErrPeerRegistered = errors.New("peer id already registered by other account")
+	ErrNotRegistered  = errors.New("not registered")
+)
+
+func MakeAccountKey(account string) []byte {
+	return []byte(accountPrefix + account)
+}
+
+func MakePeerKey(peerID string) []byte {
+	return []byte(peerPrefix + peerID)
+}
+
+// RegisterMessage 节点私钥对该消息签名，证明登记者持有节点私钥
+func RegisterMessage(account, peerID string) []byte {
+	return []byte(NodeKernelContract + "/register/" + account + "/" + peerID)
+}
+
+// nodeContract 账户登记自己的节点ID，密钥轮换后重新登记即可覆盖旧的节点ID
+type nodeContract struct{}
+
+func NewNodeContract() *nodeContract {
+	return &nodeContract{}
+}
+
+// register 以交易发起者账户登记节点ID，参数pubkey为节点公钥，sign为节点私钥对RegisterMessage的签名
+func (c *nodeContract) register(ctx contractBase.KContext) (*contractBase.Response, error) {
+	args := ctx.Args()
+	peerID := string(args["peer_id"])
+	id, err := peer.Decode(peerID)
+	if err != nil {
+		return nil, ErrInvalidPeerID
+	}
+	pubKey, err := crypto.UnmarshalPublicKey(args["pubkey"])
+	if err != nil || !id.MatchesPublicKey(pubKey) {
+		return nil, ErrInvalidPubKey
+	}
+	account := ctx.Initiator()
+	if ok, err := pubKey.Verify(RegisterMessage(account, peerID), args["sign"]); err != nil || !ok {
+		return nil, ErrInvalidSign
+	}
+	if owner, err := ctx.Get(NodeKernelContract, MakePeerKey(peerID)); err == nil &&
+		len(owner) > 0 && string(owner) != account {
+		return nil, ErrPeerRegistered
+	}
+
+	// 删除轮换前的节点ID
+	if old, err := ctx.Get(NodeKernelContract, MakeAccountKey(account)); err == nil &&
+		len(old) > 0 && string(old) != peerID {
+		if err := ctx.Del(NodeKernelContract, MakePeerKey(string(old))); err != nil {
+			return nil, err
+		}
+	}
+	if err := ctx.Put(NodeKernelContract, MakeAccountKey(account), []byte(peerID)); err != nil {
+		return nil, err
+	}
+	if err := ctx.Put(NodeKernelContract, MakePeerKey(peerID), []byte(account)); err != nil {
+		return nil, err
+	}
+
+	return &contractBase.Response{
+		Status:  success,
+		Message: "success",
+		Body:    []byte(peerID),
+	}, nil
+}
+
+// query 按account或peer_id查询登记信息
+func (c *nodeContract) query(ctx contractBase.KContext) (*contractBase.Response, error) {
+	var key []byte
+	if account := ctx.Args()["account"]; len(account) > 0 {
+		key = MakeAccountKey(string(account))
+	} else {
+		key = MakePeerKey(string(ctx.Args()["peer_id"]))
+	}
+	value, err := ctx.Get(NodeKernelContract, key)
+	if err != nil || len(value) == 0 {
+		return nil, ErrNotRegistered
+	}
+
+	return &contractBase.Response{
+		Status:  success,
+		Message: "success",
+		Body:    value,
+	}, nil
+}
@@ -0,0 +1,99 @@
+package noderegistry
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/peer"
+	contractBase "github.com/wooyang2018/corechain/contract/base"
+)
+
+type fakeKContext struct {
+	contractBase.KContext
+	initiator string
+	args      map[string][]byte
+	data      map[string][]byte
+}
+
+func (c *fakeKContext) Args() map[string][]byte { return c.args }
+
+func (c *fakeKContext) Initiator() string { return c.initiator }
+
+func (c *fakeKContext) Get(bucket string, key []byte) ([]byte, error) {
+	return c.data[bucket+"/"+string(key)], nil
+}
+
+func (c *fakeKContext) Put(bucket string, key, value []byte) error {
+	c.data[bucket+"/"+string(key)] = value
+	return nil
+}
+
+func (c *fakeKContext) Del(bucket string, key []byte) error {
+	delete(c.data, bucket+"/"+string(key))
+	return nil
+}
+
+func genPeer(t *testing.T) (crypto.PrivKey, string) {
+	priv, _, err := crypto.GenerateKeyPairWithReader(crypto.RSA, 2048, rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := peer.IDFromPrivateKey(priv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return priv, id.Pretty()
+}
+
+func registerArgs(t *testing.T, priv crypto.PrivKey, account, peerID string) map[string][]byte {
+	pubKey, err := crypto.MarshalPublicKey(priv.GetPublic())
+	if err != nil {
+		t.Fatal(err)
+	}
+	sign, err := priv.Sign(RegisterMessage(account, peerID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return map[string][]byte{"peer_id": []byte(peerID), "pubkey": pubKey, "sign": sign}
+}
+
+func TestRegister(t *testing.T) {
+	c := NewNodeContract()
+	data := make(map[string][]byte)
+	priv1, peer1 := genPeer(t)
+	priv2, peer2 := genPeer(t)
+
+	// 没有节点私钥签名无法抢注他人的节点ID
+	ctx := &fakeKContext{initiator: "mallory", data: data, args: registerArgs(t, priv2, "mallory", peer2)}
+	ctx.args["peer_id"] = []byte(peer1)
+	if _, err := c.register(ctx); err != ErrInvalidPubKey {
+		t.Fatalf("expect %v, got %v", ErrInvalidPubKey, err)
+	}
+	ctx.args = registerArgs(t, priv1, "alice", peer1)
+	if _, err := c.register(ctx); err != ErrInvalidSign {
+		t.Fatalf("expect %v, got %v", ErrInvalidSign, err)
+	}
+
+	ctx = &fakeKContext{initiator: "alice", data: data, args: registerArgs(t, priv1, "alice", peer1)}
+	if _, err := c.register(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if string(data[NodeKernelContract+"/"+string(MakePeerKey(peer1))]) != "alice" {
+		t.Fatal("peer not registered")
+	}
+
+	// 密钥轮换后重新登记，旧节点ID失效
+	ctx.args = registerArgs(t, priv2, "alice", peer2)
+	if _, err := c.register(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := data[NodeKernelContract+"/"+string(MakePeerKey(peer1))]; ok {
+		t.Fatal("old peer id should be deleted")
+	}
+
+	ctx = &fakeKContext{initiator: "bob", data: data, args: registerArgs(t, priv2, "bob", peer2)}
+	if _, err := c.register(ctx); err != ErrPeerRegistered {
+		t.Fatalf("expect %v, got %v", ErrPeerRegistered, err)
+	}
+}
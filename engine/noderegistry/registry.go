@@ -0,0 +1,52 @@
+package noderegistry
+
+import (
+	"fmt"
+
+	contractBase "github.com/wooyang2018/corechain/contract/base"
+	"github.com/wooyang2018/corechain/engine/base"
+	netBase "github.com/wooyang2018/corechain/network/base"
+)
+
+// Registry 主链上的节点登记表，供网络层做节点准入检查
+type Registry struct {
+	ctx *base.ChainCtx
+}
+
+var _ netBase.NodeRegistry = (*Registry)(nil)
+
+// NewNodeRegistry 注册$node合约，只在主链上创建
+func NewNodeRegistry(ctx *base.ChainCtx) (*Registry, error) {
+	if ctx == nil || ctx.Contract == nil || ctx.State == nil {
+		return nil, fmt.Errorf("node registry ctx set error")
+	}
+
+	t := NewNodeContract()
+	register := ctx.Contract.GetKernRegistry()
+	kMethods := map[string]contractBase.KernMethod{
+		"register": t.register,
+		"query":    t.query,
+	}
+	for method, f := range kMethods {
+		if _, err := register.GetKernMethod(NodeKernelContract, method); err != nil {
+			register.RegisterKernMethod(NodeKernelContract, method, f)
+		}
+	}
+	return &Registry{ctx: ctx}, nil
+}
+
+// GetAccountByPeerID 从最新状态读取节点ID登记的账户
+func (t *Registry) GetAccountByPeerID(peerID string) (string, error) {
+	reader, err := t.ctx.State.GetTipXMSnapshotReader()
+	if err != nil {
+		return "", err
+	}
+	account, err := reader.Get(NodeKernelContract, MakePeerKey(peerID))
+	if err != nil {
+		return "", err
+	}
+	if len(account) == 0 {
+		return "", ErrNotRegistered
+	}
+	return string(account), nil
+}
@@ -15,8 +15,10 @@ const (
 )
 
 const (
-	ParaChainStatusStart = 0
-	ParaChainStatusStop  = 1
+	ParaChainStatusStart   = 0
+	ParaChainStatusStop    = 1
+	ParaChainStatusPause   = 2
+	ParaChainStatusArchive = 3
 )
 
 // ParaChainCtx 这个可能和ChainCtx重复了
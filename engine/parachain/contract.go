@@ -22,6 +22,8 @@ var (
 	ErrBcNameEmpty      = errors.New("block chain name is empty")
 	ErrBcDataEmpty      = errors.New("first block data is empty")
 	ErrAdminEmpty       = errors.New("no administrator")
+	ErrChainArchived    = errors.New("chain has been archived")
+	ErrInvalidStatus    = errors.New("invalid chain status for this operation")
 )
 
 const (
@@ -124,7 +126,10 @@ func (p *paraChainContract) handleRefreshChain(ctx engineBase.TaskContext) error
 	// 根据当前节点目前是否有权限获取该链，决定当前是停掉链还是加载链
 	haveAccess := isContain(args.Group.Admin, p.ChainCtx.Address.Address) || isContain(args.Group.Identities, p.ChainCtx.Address.Address)
 	if haveAccess && IsParaChainEnable(args.Group) {
-		return p.doCreateChain(args.BcName, args.GenesisConfig)
+		if err := p.doCreateChain(args.BcName, args.GenesisConfig); err != nil {
+			return err
+		}
+		return p.doPauseChain(args.BcName, IsParaChainPaused(args.Group))
 	}
 	return p.doStopChain(args.BcName)
 }
@@ -168,6 +173,9 @@ func (p *paraChainContract) createChain(ctx contractBase.KContext) (*contractBas
 		[]byte(genesisConfigPrefix+bcName), []byte(bcData)); err != nil {
 		return newContractErrResponse(internalServerErr, err.Error()), err
 	}
+	if err := recordAudit(ctx, auditActionCreate, group); err != nil {
+		return newContractErrResponse(internalServerErr, err.Error()), err
+	}
 
 	// 2. 群组注册完毕后，再进行异步事件调用
 	// 当该Tx被打包上链时，将运行CreateBlockChain注册的handler，并输入参数
@@ -224,6 +232,10 @@ func (p *paraChainContract) stopChain(ctx contractBase.KContext) (*contractBase.
 		return newContractErrResponse(unAuthorized, ErrUnAuthorized.Error()), ErrUnAuthorized
 	}
 
+	if chainGroup.Status == ParaChainStatusArchive {
+		return newContractErrResponse(unAuthorized, ErrChainArchived.Error()), ErrChainArchived
+	}
+
 	// 4. 记录群组运行状态，并写入账本
 	chainGroup.Status = ParaChainStatusStop
 	rawBytes, err := json.Marshal(chainGroup)
@@ -235,6 +247,9 @@ func (p *paraChainContract) stopChain(ctx contractBase.KContext) (*contractBase.
 		[]byte(bcName), rawBytes); err != nil {
 		return newContractErrResponse(internalServerErr, err.Error()), err
 	}
+	if err := recordAudit(ctx, auditActionStop, &chainGroup); err != nil {
+		return newContractErrResponse(internalServerErr, err.Error()), err
+	}
 
 	// 5. 将该链停掉
 	message := stopChainMessage{
@@ -332,11 +347,15 @@ func (p *paraChainContract) editGroup(ctx contractBase.KContext) (*contractBase.
 	if !isContain(chainGroup.Admin, ctx.Initiator()) {
 		return newContractErrResponse(unAuthorized, ErrUnAuthorized.Error()), ErrUnAuthorized
 	}
+	if chainGroup.Status == ParaChainStatusArchive {
+		return newContractErrResponse(unAuthorized, ErrChainArchived.Error()), ErrChainArchived
+	}
 
-	// 3. 发起修改
+	// 3. 发起修改，成员变更不改变链的运行状态
 	if group.Admin == nil { // 必须要有admin权限
 		group.Admin = chainGroup.Admin
 	}
+	group.Status = chainGroup.Status
 	rawBytes, err := json.Marshal(group)
 	if err != nil {
 		return newContractErrResponse(internalServerErr, err.Error()), err
@@ -344,6 +363,9 @@ func (p *paraChainContract) editGroup(ctx contractBase.KContext) (*contractBase.
 	if err := ctx.Put(ParaChainKernelContract, []byte(group.GroupID), rawBytes); err != nil {
 		return newContractErrResponse(internalServerErr, err.Error()), err
 	}
+	if err := recordAudit(ctx, auditActionEdit, group); err != nil {
+		return newContractErrResponse(internalServerErr, err.Error()), err
+	}
 
 	// 4. 通知event
 	e := protos.ContractEvent{
@@ -467,9 +489,14 @@ func GetParaChainGroup(reader ledger.SnapshotReader, bcname string) (Group, erro
 	return group, nil
 }
 
+// IsParaChainEnable 暂停的平行链仍需加载以提供查询服务
 func IsParaChainEnable(g Group) bool {
-	if g.Status == ParaChainStatusStart {
+	if g.Status == ParaChainStatusStart || g.Status == ParaChainStatusPause {
 		return true
 	}
 	return false
 }
+
+func IsParaChainPaused(g Group) bool {
+	return g.Status == ParaChainStatusPause
+}
@@ -0,0 +1,238 @@
+package parachain
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	contractBase "github.com/wooyang2018/corechain/contract/base"
+	engineBase "github.com/wooyang2018/corechain/engine/base"
+	"github.com/wooyang2018/corechain/ledger/utils"
+)
+
+const (
+	auditPrefix    = "$AU_"
+	auditSeqPrefix = "$AS_"
+
+	auditActionCreate  = "create"
+	auditActionEdit    = "edit"
+	auditActionStop    = "stop"
+	auditActionPause   = "pause"
+	auditActionResume  = "resume"
+	auditActionArchive = "archive"
+)
+
+// AuditEntry 平行链群组成员及状态的一次变更记录
+type AuditEntry struct {
+	Seq        int64    `json:"seq"`
+	Operator   string   `json:"operator"`
+	Action     string   `json:"action"`
+	Admin      []string `json:"admin,omitempty"`
+	Identities []string `json:"identities,omitempty"`
+	Status     int      `json:"status"`
+}
+
+// pauseChain 暂停平行链出块，链仍被加载并提供查询
+func (p *paraChainContract) pauseChain(ctx contractBase.KContext) (*contractBase.Response, error) {
+	return p.changeStatus(ctx, ParaChainStatusStart, ParaChainStatusPause, auditActionPause, "PauseBlockChain")
+}
+
+// resumeChain 恢复已暂停平行链的出块
+func (p *paraChainContract) resumeChain(ctx contractBase.KContext) (*contractBase.Response, error) {
+	return p.changeStatus(ctx, ParaChainStatusPause, ParaChainStatusStart, auditActionResume, "ResumeBlockChain")
+}
+
+// archiveChain 归档平行链，卸载链并将数据目录移出，归档后不可再修改
+func (p *paraChainContract) archiveChain(ctx contractBase.KContext) (*contractBase.Response, error) {
+	return p.changeStatus(ctx, -1, ParaChainStatusArchive, auditActionArchive, "ArchiveBlockChain")
+}
+
+// changeStatus 管理员将平行链从from状态切换为to状态，from为-1时允许除归档外的任意状态
+func (p *paraChainContract) changeStatus(ctx contractBase.KContext, from, to int, action, task string) (*contractBase.Response, error) {
+	if p.BcName != p.ChainCtx.EngCtx.EngCfg.RootChain {
+		return nil, ErrUnAuthorized
+	}
+	bcName := string(ctx.Args()["name"])
+	if bcName == "" {
+		return nil, ErrBcNameEmpty
+	}
+
+	groupBytes, err := ctx.Get(ParaChainKernelContract, []byte(bcName))
+	if err != nil {
+		return newContractErrResponse(targetNotFound, ErrChainNotFound.Error()), ErrChainNotFound
+	}
+	chainGroup := Group{}
+	if err := json.Unmarshal(groupBytes, &chainGroup); err != nil {
+		return newContractErrResponse(internalServerErr, err.Error()), err
+	}
+	if !isContain(chainGroup.Admin, ctx.Initiator()) {
+		return newContractErrResponse(unAuthorized, ErrUnAuthorized.Error()), ErrUnAuthorized
+	}
+	if chainGroup.Status == ParaChainStatusArchive {
+		return newContractErrResponse(unAuthorized, ErrChainArchived.Error()), ErrChainArchived
+	}
+	if from >= 0 && chainGroup.Status != from {
+		return newContractErrResponse(unAuthorized, ErrInvalidStatus.Error()), ErrInvalidStatus
+	}
+
+	chainGroup.Status = to
+	rawBytes, err := json.Marshal(chainGroup)
+	if err != nil {
+		return newContractErrResponse(internalServerErr, err.Error()), err
+	}
+	if err := ctx.Put(ParaChainKernelContract, []byte(bcName), rawBytes); err != nil {
+		return newContractErrResponse(internalServerErr, err.Error()), err
+	}
+	if err := recordAudit(ctx, action, &chainGroup); err != nil {
+		return newContractErrResponse(internalServerErr, err.Error()), err
+	}
+
+	if err := ctx.EmitAsyncTask(task, stopChainMessage{BcName: bcName}); err != nil {
+		return newContractErrResponse(internalServerErr, err.Error()), err
+	}
+
+	ctx.AddResourceUsed(contractBase.Limits{
+		XFee: p.MinNewChainAmount,
+	})
+	return &contractBase.Response{
+		Status: success,
+		Body:   []byte(task + " success"),
+	}, nil
+}
+
+// getGroupAudit 按顺序返回平行链群组的变更记录，仅群组成员可读
+func (p *paraChainContract) getGroupAudit(ctx contractBase.KContext) (*contractBase.Response, error) {
+	bcName := string(ctx.Args()["name"])
+	if bcName == "" {
+		return nil, ErrBcNameEmpty
+	}
+	groupBytes, err := ctx.Get(ParaChainKernelContract, []byte(bcName))
+	if err != nil {
+		return newContractErrResponse(targetNotFound, ErrGroupNotFound.Error()), err
+	}
+	group := Group{}
+	if err := json.Unmarshal(groupBytes, &group); err != nil {
+		return newContractErrResponse(internalServerErr, err.Error()), err
+	}
+	if !isContain(group.Admin, ctx.Initiator()) && !isContain(group.Identities, ctx.Initiator()) {
+		return newContractErrResponse(unAuthorized, ErrUnAuthorized.Error()), nil
+	}
+
+	seq, err := getAuditSeq(ctx, bcName)
+	if err != nil {
+		return newContractErrResponse(internalServerErr, err.Error()), err
+	}
+	entries := make([]*AuditEntry, 0, seq)
+	for i := int64(1); i <= seq; i++ {
+		buf, err := ctx.Get(ParaChainKernelContract, []byte(makeAuditKey(bcName, i)))
+		if err != nil {
+			return newContractErrResponse(internalServerErr, err.Error()), err
+		}
+		entry := &AuditEntry{}
+		if err := json.Unmarshal(buf, entry); err != nil {
+			return newContractErrResponse(internalServerErr, err.Error()), err
+		}
+		entries = append(entries, entry)
+	}
+	body, err := json.Marshal(entries)
+	if err != nil {
+		return newContractErrResponse(internalServerErr, err.Error()), err
+	}
+	return &contractBase.Response{
+		Status: success,
+		Body:   body,
+	}, nil
+}
+
+// handlePauseChain 暂停或恢复本节点已加载的平行链，需幂等
+func (p *paraChainContract) handlePauseChain(ctx engineBase.TaskContext) error {
+	var args stopChainMessage
+	if err := ctx.ParseArgs(&args); err != nil {
+		return err
+	}
+	return p.doPauseChain(args.BcName, true)
+}
+
+func (p *paraChainContract) handleResumeChain(ctx engineBase.TaskContext) error {
+	var args stopChainMessage
+	if err := ctx.ParseArgs(&args); err != nil {
+		return err
+	}
+	return p.doPauseChain(args.BcName, false)
+}
+
+func (p *paraChainContract) doPauseChain(bcName string, pause bool) error {
+	chain, err := p.ChainCtx.EngCtx.ChainM.Get(bcName)
+	if err != nil {
+		p.ChainCtx.XLog.Warn("Chain hasn't been loaded yet", "chain", bcName)
+		return nil
+	}
+	if pause {
+		chain.Pause()
+	} else {
+		chain.Resume()
+	}
+	return nil
+}
+
+// handleArchiveChain 卸载平行链并归档数据目录，需幂等
+func (p *paraChainContract) handleArchiveChain(ctx engineBase.TaskContext) error {
+	var args stopChainMessage
+	if err := ctx.ParseArgs(&args); err != nil {
+		return err
+	}
+	if err := p.doStopChain(args.BcName); err != nil {
+		return err
+	}
+	dir, err := utils.ArchiveLedger(args.BcName, p.ChainCtx.EngCtx.EnvCfg)
+	if err == utils.ErrBlockChainNotExist {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	p.ChainCtx.XLog.Info("Chain archived", "chain", args.BcName, "dir", dir)
+	return nil
+}
+
+// recordAudit 追加一条群组变更记录
+func recordAudit(ctx contractBase.KContext, action string, group *Group) error {
+	seq, err := getAuditSeq(ctx, group.GroupID)
+	if err != nil {
+		return err
+	}
+	entry := &AuditEntry{
+		Seq:        seq + 1,
+		Operator:   ctx.Initiator(),
+		Action:     action,
+		Admin:      group.Admin,
+		Identities: group.Identities,
+		Status:     group.Status,
+	}
+	buf, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Put(ParaChainKernelContract, []byte(makeAuditKey(group.GroupID, entry.Seq)), buf); err != nil {
+		return err
+	}
+	return ctx.Put(ParaChainKernelContract, []byte(auditSeqPrefix+group.GroupID),
+		[]byte(strconv.FormatInt(entry.Seq, 10)))
+}
+
+func getAuditSeq(ctx contractBase.KContext, bcName string) (int64, error) {
+	buf, err := ctx.Get(ParaChainKernelContract, []byte(auditSeqPrefix+bcName))
+	if err != nil || len(buf) == 0 {
+		// 没有记录，从0开始
+		return 0, nil
+	}
+	seq, err := strconv.ParseInt(string(buf), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid audit seq %s", buf)
+	}
+	return seq, nil
+}
+
+func makeAuditKey(bcName string, seq int64) string {
+	return fmt.Sprintf("%s%s_%020d", auditPrefix, bcName, seq)
+}
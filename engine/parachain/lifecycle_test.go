@@ -0,0 +1,102 @@
+package parachain
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	contractBase "github.com/wooyang2018/corechain/contract/base"
+	engineBase "github.com/wooyang2018/corechain/engine/base"
+	"github.com/wooyang2018/corechain/protos"
+)
+
+type fakeKContext struct {
+	contractBase.KContext
+	initiator string
+	args      map[string][]byte
+	data      map[string][]byte
+	tasks     []string
+}
+
+func (c *fakeKContext) Args() map[string][]byte { return c.args }
+
+func (c *fakeKContext) Initiator() string { return c.initiator }
+
+func (c *fakeKContext) Get(bucket string, key []byte) ([]byte, error) {
+	value, ok := c.data[bucket+"/"+string(key)]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return value, nil
+}
+
+func (c *fakeKContext) Put(bucket string, key, value []byte) error {
+	c.data[bucket+"/"+string(key)] = value
+	return nil
+}
+
+func (c *fakeKContext) AddEvent(events ...*protos.ContractEvent) {}
+
+func (c *fakeKContext) AddResourceUsed(delta contractBase.Limits) {}
+
+func (c *fakeKContext) EmitAsyncTask(event string, args interface{}) error {
+	c.tasks = append(c.tasks, event)
+	return nil
+}
+
+func TestChainLifecycle(t *testing.T) {
+	chainCtx := &engineBase.ChainCtx{
+		EngCtx: &engineBase.EngineCtx{EngCfg: &engineBase.EngineConf{RootChain: "corechain"}},
+	}
+	p := NewParaChainContract("corechain", 100, chainCtx)
+	ctx := &fakeKContext{
+		initiator: "alice",
+		data:      make(map[string][]byte),
+	}
+	group := &Group{GroupID: "para", Admin: []string{"alice"}}
+	groupBuf, _ := json.Marshal(group)
+	ctx.Put(ParaChainKernelContract, []byte("para"), groupBuf)
+
+	ctx.args = map[string][]byte{"name": []byte("para")}
+	if _, err := p.resumeChain(ctx); err != ErrInvalidStatus {
+		t.Fatalf("resume a running chain expect ErrInvalidStatus, got %v", err)
+	}
+	if _, err := p.pauseChain(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.resumeChain(ctx); err != nil {
+		t.Fatal(err)
+	}
+	ctx.initiator = "bob"
+	if _, err := p.archiveChain(ctx); err != ErrUnAuthorized {
+		t.Fatalf("expect ErrUnAuthorized, got %v", err)
+	}
+	ctx.initiator = "alice"
+	if _, err := p.archiveChain(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.pauseChain(ctx); err != ErrChainArchived {
+		t.Fatalf("expect ErrChainArchived, got %v", err)
+	}
+
+	resp, err := p.getGroupAudit(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var entries []*AuditEntry
+	if err := json.Unmarshal(resp.Body, &entries); err != nil {
+		t.Fatal(err)
+	}
+	actions := []string{auditActionPause, auditActionResume, auditActionArchive}
+	if len(entries) != len(actions) {
+		t.Fatalf("unexpected audit entries %d", len(entries))
+	}
+	for i, entry := range entries {
+		if entry.Seq != int64(i+1) || entry.Action != actions[i] || entry.Operator != "alice" {
+			t.Fatalf("unexpected audit entry %+v", entry)
+		}
+	}
+	if entries[2].Status != ParaChainStatusArchive {
+		t.Fatalf("unexpected status %d", entries[2].Status)
+	}
+}
@@ -40,10 +40,14 @@ func NewParaChainManager(ctx *ParaChainCtx) (*Manager, error) {
 	register := ctx.Contract.GetKernRegistry()
 	// 注册合约方法
 	kMethods := map[string]contractBase.KernMethod{
-		"createChain": t.createChain,
-		"editGroup":   t.editGroup,
-		"getGroup":    t.getGroup,
-		"stopChain":   t.stopChain,
+		"createChain":   t.createChain,
+		"editGroup":     t.editGroup,
+		"getGroup":      t.getGroup,
+		"stopChain":     t.stopChain,
+		"pauseChain":    t.pauseChain,
+		"resumeChain":   t.resumeChain,
+		"archiveChain":  t.archiveChain,
+		"getGroupAudit": t.getGroupAudit,
 	}
 	for method, f := range kMethods {
 		if _, err := register.GetKernMethod(ParaChainKernelContract, method); err != nil {
@@ -56,6 +60,9 @@ func NewParaChainManager(ctx *ParaChainCtx) (*Manager, error) {
 		"CreateBlockChain":  t.handleCreateChain,
 		"StopBlockChain":    t.handleStopChain,
 		"RefreshBlockChain": t.handleRefreshChain,
+		"PauseBlockChain":   t.handlePauseChain,
+		"ResumeBlockChain":  t.handleResumeChain,
+		"ArchiveBlockChain": t.handleArchiveChain,
 	}
 	for task, f := range asyncTask {
 		ctx.ChainCtx.Asyncworker.RegisterHandler(ParaChainKernelContract, task, f)
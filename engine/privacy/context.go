@@ -0,0 +1,44 @@
+package privacy
+
+import (
+	"fmt"
+
+	xctx "github.com/wooyang2018/corechain/common/context"
+	"github.com/wooyang2018/corechain/common/timer"
+	contractBase "github.com/wooyang2018/corechain/contract/base"
+	"github.com/wooyang2018/corechain/engine/base"
+	"github.com/wooyang2018/corechain/logger"
+)
+
+const (
+	PrivacyKernelContract = "$privacy"
+)
+
+// PrivacyCtx 私有交易上下文，每条链各有一个
+type PrivacyCtx struct {
+	// 基础上下文
+	xctx.BaseCtx
+	BcName   string
+	Contract contractBase.Manager
+	ChainCtx *base.ChainCtx
+}
+
+func NewPrivacyCtx(bcName string, cctx *base.ChainCtx) (*PrivacyCtx, error) {
+	if bcName == "" || cctx == nil {
+		return nil, fmt.Errorf("new privacy ctx failed because param error")
+	}
+
+	log, err := logger.NewLogger("", PrivacyKernelContract)
+	if err != nil {
+		return nil, fmt.Errorf("new privacy ctx failed because new logger error. err:%v", err)
+	}
+
+	ctx := new(PrivacyCtx)
+	ctx.XLog = log
+	ctx.Timer = timer.NewXTimer()
+	ctx.BcName = bcName
+	ctx.Contract = cctx.Contract
+	ctx.ChainCtx = cctx
+
+	return ctx, nil
+}
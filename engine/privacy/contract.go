@@ -0,0 +1,75 @@
+package privacy
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	contractBase "github.com/wooyang2018/corechain/contract/base"
+	cryptoBase "github.com/wooyang2018/corechain/crypto/client/base"
+)
+
+const (
+	success = 200
+)
+
+// privacyContract 链上只记录私有交易hash和发送方签名，明文只在成员节点间传播
+type privacyContract struct {
+	crypto cryptoBase.CryptoClient
+}
+
+func NewPrivacyContract(crypto cryptoBase.CryptoClient) *privacyContract {
+	return &privacyContract{crypto: crypto}
+}
+
+// commit 记录私有交易hash，发起者必须是私有交易发送方并提供对hash的签名
+// 以发送方和hash作为key，其他账户抢先提交同一hash不影响发送方
+func (c *privacyContract) commit(ctx contractBase.KContext) (*contractBase.Response, error) {
+	args := ctx.Args()
+	hash, err := parseHash(args["hash"])
+	if err != nil {
+		return nil, err
+	}
+	if err := VerifySenderSign(c.crypto, ctx.Initiator(), string(args["pubkey"]), args["sign"], hash); err != nil {
+		return nil, err
+	}
+	key := []byte(MakeCommitKey(ctx.Initiator(), hash))
+	if sign, err := ctx.Get(PrivacyKernelContract, key); err == nil && len(sign) > 0 {
+		return nil, ErrHashCommitted
+	}
+	if err := ctx.Put(PrivacyKernelContract, key, args["sign"]); err != nil {
+		return nil, err
+	}
+
+	return &contractBase.Response{
+		Status:  success,
+		Message: "success",
+		Body:    []byte(hex.EncodeToString(hash)),
+	}, nil
+}
+
+// query 返回发送方提交私有交易hash时的签名
+func (c *privacyContract) query(ctx contractBase.KContext) (*contractBase.Response, error) {
+	hash, err := parseHash(ctx.Args()["hash"])
+	if err != nil {
+		return nil, err
+	}
+	sender := string(ctx.Args()["sender"])
+	sign, err := ctx.Get(PrivacyKernelContract, []byte(MakeCommitKey(sender, hash)))
+	if err != nil || len(sign) == 0 {
+		return nil, ErrHashNotCommitted
+	}
+
+	return &contractBase.Response{
+		Status:  success,
+		Message: "success",
+		Body:    []byte(hex.EncodeToString(sign)),
+	}, nil
+}
+
+func parseHash(buf []byte) ([]byte, error) {
+	hash, err := hex.DecodeString(string(buf))
+	if err != nil || len(hash) != 32 {
+		return nil, fmt.Errorf("hash should be hex encoded sha256 digest")
+	}
+	return hash, nil
+}
@@ -0,0 +1,127 @@
+package privacy
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"sort"
+	"time"
+
+	"github.com/wooyang2018/corechain/common/address"
+	cryptoBase "github.com/wooyang2018/corechain/crypto/client/base"
+	"github.com/wooyang2018/corechain/crypto/core/aes"
+	"github.com/wooyang2018/corechain/protos"
+	"google.golang.org/protobuf/proto"
+)
+
+// MakePrivateTxHash 私有交易hash为密文的sha256，链上只记录该hash
+func MakePrivateTxHash(cipherText []byte) []byte {
+	sum := sha256.Sum256(cipherText)
+	return sum[:]
+}
+
+// SealPrivateTx 使用随机AES密钥加密明文，再用每个成员的公钥加密AES密钥
+func SealPrivateTx(crypto cryptoBase.CryptoClient, sender *address.Address, bcName string,
+	memberKeys map[string]*ecdsa.PublicKey, payload *protos.PrivatePayload) (*protos.PrivateTx, error) {
+	if len(memberKeys) == 0 {
+		return nil, ErrNoMembers
+	}
+	plainText, err := proto.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	key := make([]byte, aesKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	cipherText, err := aes.Encrypt(plainText, key)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := &protos.PrivateTx{
+		Bcname:       bcName,
+		Sender:       sender.Address,
+		SenderPubkey: sender.PublicKeyStr,
+		Keys:         make(map[string][]byte, len(memberKeys)),
+		Payload:      cipherText,
+		Timestamp:    time.Now().UnixNano(),
+	}
+	for member, pub := range memberKeys {
+		encKey, err := crypto.EncryptByEcdsaKey(pub, key)
+		if err != nil {
+			return nil, err
+		}
+		tx.Members = append(tx.Members, member)
+		tx.Keys[member] = encKey
+	}
+	sort.Strings(tx.Members)
+
+	tx.Hash = MakePrivateTxHash(cipherText)
+	tx.Sign, err = crypto.SignECDSA(sender.PrivateKey, tx.Hash)
+	if err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// VerifyPrivateTx 校验hash和发送方签名
+func VerifyPrivateTx(crypto cryptoBase.CryptoClient, tx *protos.PrivateTx) error {
+	if tx == nil || len(tx.GetKeys()) == 0 ||
+		!bytes.Equal(tx.GetHash(), MakePrivateTxHash(tx.GetPayload())) {
+		return ErrInvalidPrivateTx
+	}
+	pub, err := crypto.GetEcdsaPublicKeyFromJsonStr(tx.GetSenderPubkey())
+	if err != nil {
+		return ErrInvalidPrivateTx
+	}
+	if ok, _ := crypto.VerifyAddressUsingPublicKey(tx.GetSender(), pub); !ok {
+		return ErrInvalidPrivateTx
+	}
+	if ok, err := crypto.VerifyECDSA(pub, tx.GetSign(), tx.GetHash()); err != nil || !ok {
+		return ErrInvalidPrivateTx
+	}
+	return nil
+}
+
+// VerifySenderSign 校验sender对hash的签名，公钥必须与sender地址匹配
+func VerifySenderSign(crypto cryptoBase.CryptoClient, sender, pubKey string, sign, hash []byte) error {
+	pub, err := crypto.GetEcdsaPublicKeyFromJsonStr(pubKey)
+	if err != nil {
+		return ErrInvalidSign
+	}
+	if ok, _ := crypto.VerifyAddressUsingPublicKey(sender, pub); !ok {
+		return ErrInvalidSign
+	}
+	if ok, err := crypto.VerifyECDSA(pub, sign, hash); err != nil || !ok {
+		return ErrInvalidSign
+	}
+	return nil
+}
+
+// OpenPrivateTx 成员使用自己的私钥解密
+func OpenPrivateTx(crypto cryptoBase.CryptoClient, member *address.Address,
+	tx *protos.PrivateTx) (*protos.PrivatePayload, error) {
+	encKey, ok := tx.GetKeys()[member.Address]
+	if !ok {
+		return nil, ErrNotMember
+	}
+	key, err := crypto.DecryptByEcdsaKey(member.PrivateKey, encKey)
+	if err != nil {
+		return nil, err
+	}
+	// 密文长度不是分组长度的整数倍时aes解密会panic
+	if len(key) != aesKeySize || len(tx.GetPayload()) == 0 || len(tx.GetPayload())%aesBlockSize != 0 {
+		return nil, ErrInvalidPrivateTx
+	}
+	plainText, err := aes.Decrypt(tx.GetPayload(), key)
+	if err != nil {
+		return nil, err
+	}
+	payload := new(protos.PrivatePayload)
+	if err := proto.Unmarshal(plainText, payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
@@ -0,0 +1,164 @@
+package privacy
+
+import (
+	"crypto/ecdsa"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/wooyang2018/corechain/common/address"
+	contractBase "github.com/wooyang2018/corechain/contract/base"
+	"github.com/wooyang2018/corechain/crypto/client"
+	cryptoBase "github.com/wooyang2018/corechain/crypto/client/base"
+	"github.com/wooyang2018/corechain/protos"
+)
+
+func newTestAddress(t *testing.T, crypto cryptoBase.CryptoClient, seed string) *address.Address {
+	priv, err := crypto.GenerateKeyBySeed([]byte(seed + "-private-tx-test-seed-0123456789"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr, err := crypto.GetAddressFromPublicKey(&priv.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubStr, err := crypto.GetEcdsaPublicKeyJsonFormatStr(priv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &address.Address{
+		Address:      addr,
+		PrivateKey:   priv,
+		PublicKey:    &priv.PublicKey,
+		PublicKeyStr: pubStr,
+	}
+}
+
+func TestSealAndOpenPrivateTx(t *testing.T) {
+	crypto, err := client.CreateCryptoClient(client.CryptoTypeDefault)
+	if err != nil {
+		t.Fatal(err)
+	}
+	alice := newTestAddress(t, crypto, "alice")
+	bob := newTestAddress(t, crypto, "bob")
+	eve := newTestAddress(t, crypto, "eve")
+
+	payload := &protos.PrivatePayload{
+		ContractRequests: []*protos.InvokeRequest{{
+			ModuleName:   "wasm",
+			ContractName: "counter",
+			MethodName:   "increase",
+			Args:         map[string][]byte{"key": []byte("secret")},
+		}},
+		TxOutputsExt: []*protos.TxOutputExt{{Bucket: "counter", Key: []byte("secret"), Value: []byte("1")}},
+	}
+	memberKeys := map[string]*ecdsa.PublicKey{alice.Address: alice.PublicKey, bob.Address: bob.PublicKey}
+	tx, err := SealPrivateTx(crypto, alice, "xuper", memberKeys, payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyPrivateTx(crypto, tx); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := OpenPrivateTx(crypto, bob, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got.ContractRequests[0].Args["key"]) != "secret" || string(got.TxOutputsExt[0].Value) != "1" {
+		t.Fatal("payload mismatch")
+	}
+	if _, err := OpenPrivateTx(crypto, eve, tx); err != ErrNotMember {
+		t.Fatalf("expect not member, got: %v", err)
+	}
+
+	// 篡改密文或发送方都无法通过校验
+	tx.Payload[0] ^= 0xff
+	if err := VerifyPrivateTx(crypto, tx); err != ErrInvalidPrivateTx {
+		t.Fatalf("expect invalid private tx, got: %v", err)
+	}
+	tx.Payload[0] ^= 0xff
+	tx.Sender = eve.Address
+	if err := VerifyPrivateTx(crypto, tx); err != ErrInvalidPrivateTx {
+		t.Fatalf("expect invalid private tx, got: %v", err)
+	}
+}
+
+type fakeKContext struct {
+	contractBase.KContext
+	initiator string
+	args      map[string][]byte
+	data      map[string][]byte
+}
+
+func (c *fakeKContext) Args() map[string][]byte { return c.args }
+
+func (c *fakeKContext) Initiator() string { return c.initiator }
+
+func (c *fakeKContext) Get(bucket string, key []byte) ([]byte, error) {
+	value, ok := c.data[bucket+"/"+string(key)]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return value, nil
+}
+
+func (c *fakeKContext) Put(bucket string, key, value []byte) error {
+	c.data[bucket+"/"+string(key)] = value
+	return nil
+}
+
+func commitArgs(t *testing.T, crypto cryptoBase.CryptoClient, sender *address.Address, hash []byte) map[string][]byte {
+	sign, err := crypto.SignECDSA(sender.PrivateKey, hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return map[string][]byte{
+		"hash":   []byte(hex.EncodeToString(hash)),
+		"pubkey": []byte(sender.PublicKeyStr),
+		"sign":   sign,
+		"sender": []byte(sender.Address),
+	}
+}
+
+func TestPrivacyContract(t *testing.T) {
+	crypto, err := client.CreateCryptoClient(client.CryptoTypeDefault)
+	if err != nil {
+		t.Fatal(err)
+	}
+	alice := newTestAddress(t, crypto, "alice")
+	eve := newTestAddress(t, crypto, "eve")
+	c := NewPrivacyContract(crypto)
+	hash := MakePrivateTxHash([]byte("cipher"))
+	data := make(map[string][]byte)
+	ctx := &fakeKContext{initiator: alice.Address, args: commitArgs(t, crypto, alice, hash), data: data}
+
+	if _, err := c.query(ctx); err != ErrHashNotCommitted {
+		t.Fatalf("expect not committed, got: %v", err)
+	}
+	// 冒用发送方的签名提交
+	evil := &fakeKContext{initiator: eve.Address, args: commitArgs(t, crypto, alice, hash), data: data}
+	if _, err := c.commit(evil); err != ErrInvalidSign {
+		t.Fatalf("expect invalid sign, got: %v", err)
+	}
+	// 他人用自己的签名抢先提交，不影响发送方提交
+	evil.args = commitArgs(t, crypto, eve, hash)
+	if _, err := c.commit(evil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.commit(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.commit(ctx); err != ErrHashCommitted {
+		t.Fatalf("expect committed, got: %v", err)
+	}
+	resp, err := c.query(ctx)
+	if err != nil || string(resp.Body) != hex.EncodeToString(ctx.args["sign"]) {
+		t.Fatalf("query error, resp:%v err:%v", resp, err)
+	}
+
+	ctx.args["hash"] = []byte("not hex")
+	if _, err := c.commit(ctx); err == nil {
+		t.Fatal("invalid hash committed")
+	}
+}
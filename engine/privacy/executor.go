@@ -0,0 +1,194 @@
+package privacy
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"time"
+
+	contractBase "github.com/wooyang2018/corechain/contract/base"
+	"github.com/wooyang2018/corechain/contract/sandbox"
+	"github.com/wooyang2018/corechain/ledger"
+	"github.com/wooyang2018/corechain/protos"
+	"github.com/wooyang2018/corechain/storage"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// 私有交易在提交区块之后再确认若干区块才执行，避免分叉导致成员间私有状态不一致
+	execConfirmBlocks = 3
+	execInterval      = time.Second
+	// 私有交易不允许调用内核合约
+	kernelModule = "xkernel"
+)
+
+// execLoop 按区块顺序执行已上链提交的私有交易，所有成员以相同顺序更新私有状态
+func (t *Manager) execLoop() {
+	ticker := time.NewTicker(execInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-t.exit:
+			return
+		case <-ticker.C:
+			tipHeight := t.Ctx.ChainCtx.Ledger.GetMeta().GetTrunkHeight()
+			if err := t.execBlocks(tipHeight - execConfirmBlocks); err != nil {
+				t.Ctx.GetLog().Warn("exec private tx failed", "err", err)
+			}
+		}
+	}
+}
+
+// execBlocks 执行游标之后直到height的区块中提交的私有交易
+func (t *Manager) execBlocks(height int64) error {
+	cursor, err := t.loadCursor()
+	if err != nil {
+		return err
+	}
+	for h := cursor + 1; h <= height; h++ {
+		block, err := t.Ctx.ChainCtx.Ledger.QueryBlockByHeight(h)
+		if err != nil {
+			return err
+		}
+		for _, tx := range block.GetTransactions() {
+			for _, req := range tx.GetContractRequests() {
+				if req.GetContractName() != PrivacyKernelContract || req.GetMethodName() != "commit" {
+					continue
+				}
+				if err := t.execCommitted(block.GetBlockid(), tx.GetInitiator(), req.GetArgs()); err != nil {
+					return err
+				}
+			}
+		}
+		if err := t.store.Put([]byte(execCursorKey), []byte(strconv.FormatInt(h, 10))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// execCommitted 本节点是成员时执行私有交易，执行结果和私有状态原子写入
+// 公开状态读取提交所在区块的快照，各成员执行时的链高度不同也能得到相同的结果
+func (t *Manager) execCommitted(blockid []byte, initiator string, args map[string][]byte) error {
+	hash, err := parseHash(args["hash"])
+	if err != nil {
+		return nil
+	}
+	buf, err := t.store.Get(makePrivateTxKey(hash))
+	if err != nil {
+		// 非成员节点没有密文
+		return nil
+	}
+	if ok, _ := t.store.Has(makeExecResultKey(hash)); ok {
+		return nil
+	}
+	tx := new(protos.PrivateTx)
+	if err := proto.Unmarshal(buf, tx); err != nil {
+		return nil
+	}
+	// 只执行发送方本人提交的hash，他人抢先提交的同一hash直接忽略
+	if initiator != tx.GetSender() ||
+		VerifySenderSign(t.Ctx.ChainCtx.Crypto, tx.GetSender(), tx.GetSenderPubkey(), args["sign"], hash) != nil {
+		t.Ctx.GetLog().Warn("ignore private tx committed by other account", "hash", fmt.Sprintf("%x", hash),
+			"sender", tx.GetSender(), "initiator", initiator)
+		return nil
+	}
+
+	public, err := t.public.CreateSnapshot(blockid)
+	if err != nil {
+		return err
+	}
+	batch := t.store.NewBatch()
+	result := "success"
+	if err := t.execute(batch, tx, public); err != nil {
+		batch.Reset()
+		result = err.Error()
+		t.Ctx.GetLog().Warn("exec private tx failed", "hash", fmt.Sprintf("%x", hash), "err", err)
+	}
+	if err := batch.Put(makeExecResultKey(hash), []byte(result)); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+// execute 在私有状态上执行合约调用，写集与发送方给出的写集不一致时整笔交易不生效
+// 公开状态的快照不支持Select，遍历公开状态的合约调用会执行失败
+func (t *Manager) execute(batch storage.Batch, tx *protos.PrivateTx, public ledger.XReader) error {
+	payload, err := OpenPrivateTx(t.Ctx.ChainCtx.Crypto, t.Ctx.ChainCtx.Address, tx)
+	if err != nil {
+		return err
+	}
+
+	state := newPrivateState(t.store, public)
+	sandBox, err := t.Ctx.Contract.NewStateSandbox(&contractBase.SandboxConfig{
+		XMReader:   state,
+		UTXOReader: sandbox.NewUTXOReaderFromInput(nil),
+	})
+	if err != nil {
+		return err
+	}
+	for _, req := range payload.GetContractRequests() {
+		if req.GetModuleName() == kernelModule {
+			return ErrInvalidPrivateTx
+		}
+		ctx, err := t.Ctx.Contract.NewContext(&contractBase.ContextConfig{
+			State:          sandBox,
+			Initiator:      tx.GetSender(),
+			AuthRequire:    []string{tx.GetSender()},
+			Module:         req.GetModuleName(),
+			ContractName:   req.GetContractName(),
+			ResourceLimits: contractBase.MaxLimits,
+		})
+		if err != nil {
+			return err
+		}
+		resp, err := ctx.Invoke(req.GetMethodName(), req.GetArgs())
+		ctx.Release()
+		if err != nil {
+			return err
+		}
+		if resp.Status >= 400 {
+			return fmt.Errorf("contract response status %d, message:%s", resp.Status, resp.Message)
+		}
+	}
+
+	wset := sandBox.RWSet().WSet
+	if outputs := payload.GetTxOutputsExt(); len(outputs) > 0 && !matchWriteSet(wset, outputs) {
+		return ErrWriteSetMismatch
+	}
+	return state.apply(batch, wset)
+}
+
+func (t *Manager) loadCursor() (int64, error) {
+	buf, err := t.store.Get([]byte(execCursorKey))
+	if err != nil {
+		if storage.ErrNotFound(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return strconv.ParseInt(string(buf), 10, 64)
+}
+
+// GetExecResult 返回私有交易在本节点的执行结果
+func (t *Manager) GetExecResult(hash []byte) (string, error) {
+	buf, err := t.store.Get(makeExecResultKey(hash))
+	if err != nil {
+		return "", ErrNotExecuted
+	}
+	return string(buf), nil
+}
+
+func matchWriteSet(wset []*ledger.PureData, outputs []*protos.TxOutputExt) bool {
+	if len(wset) != len(outputs) {
+		return false
+	}
+	for i, data := range wset {
+		output := outputs[i]
+		if data.Bucket != output.GetBucket() || !bytes.Equal(data.Key, output.GetKey()) ||
+			!bytes.Equal(data.Value, output.GetValue()) {
+			return false
+		}
+	}
+	return true
+}
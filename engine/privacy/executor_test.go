@@ -0,0 +1,154 @@
+package privacy
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/wooyang2018/corechain/common/context"
+	contractBase "github.com/wooyang2018/corechain/contract/base"
+	"github.com/wooyang2018/corechain/contract/sandbox"
+	"github.com/wooyang2018/corechain/crypto/client"
+	"github.com/wooyang2018/corechain/engine/base"
+	"github.com/wooyang2018/corechain/ledger"
+	"github.com/wooyang2018/corechain/logger"
+	mock "github.com/wooyang2018/corechain/mock/config"
+	"github.com/wooyang2018/corechain/protos"
+	"github.com/wooyang2018/corechain/storage/leveldb"
+)
+
+// fakeSnapshots 按区块保存公开状态
+type fakeSnapshots map[string]*sandbox.MemXModel
+
+func (s fakeSnapshots) CreateSnapshot(blkId []byte) (ledger.XReader, error) {
+	state, ok := s[string(blkId)]
+	if !ok {
+		return nil, fmt.Errorf("block %s not found", blkId)
+	}
+	return state, nil
+}
+
+// fakeContractManager sync方法将公开状态中的价格写入私有状态，copy方法复制私有状态中的价格
+type fakeContractManager struct{}
+
+func (m *fakeContractManager) NewContext(cfg *contractBase.ContextConfig) (contractBase.VMContext, error) {
+	return &fakeVMContext{state: cfg.State}, nil
+}
+
+func (m *fakeContractManager) NewStateSandbox(cfg *contractBase.SandboxConfig) (contractBase.StateSandbox, error) {
+	return sandbox.NewXModelCache(cfg), nil
+}
+
+func (m *fakeContractManager) GetKernRegistry() contractBase.KernRegistry {
+	return nil
+}
+
+type fakeVMContext struct {
+	state contractBase.StateSandbox
+}
+
+func (c *fakeVMContext) Invoke(method string, args map[string][]byte) (*contractBase.Response, error) {
+	from, to := []string{"oracle", "price"}, []string{"counter", "price"}
+	if method == "copy" {
+		from, to = to, []string{"counter", "copy"}
+	}
+	price, err := c.state.Get(from[0], []byte(from[1]))
+	if err != nil {
+		return nil, err
+	}
+	if err := c.state.Put(to[0], []byte(to[1]), price); err != nil {
+		return nil, err
+	}
+	return &contractBase.Response{Status: 200}, nil
+}
+
+func (c *fakeVMContext) ResourceUsed() contractBase.Limits {
+	return contractBase.Limits{}
+}
+
+func (c *fakeVMContext) Release() error {
+	return nil
+}
+
+func TestExecCommittedAtCommitBlock(t *testing.T) {
+	crypto, err := client.CreateCryptoClient(client.CryptoTypeDefault)
+	if err != nil {
+		t.Fatal(err)
+	}
+	alice := newTestAddress(t, crypto, "alice")
+	store, err := leveldb.CreateKVInstance(&leveldb.KVParameter{
+		DBPath:                filepath.Join(t.TempDir(), storeDirName),
+		KVEngineType:          leveldb.KVEngineType,
+		StorageType:           leveldb.StorageTypeSingle,
+		MemCacheSize:          ledger.MemCacheSize,
+		FileHandlersCacheSize: ledger.FileHandlersCacheSize,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	econf, err := mock.GetMockEnvConf()
+	if err != nil {
+		t.Fatal(err)
+	}
+	logger.InitMLog(econf.GenConfFilePath(econf.LogConf), econf.GenDirAbsPath(econf.LogDir))
+	log, err := logger.NewLogger("", PrivacyKernelContract)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 提交私有交易的区块之后，公开状态中的价格被修改
+	snapshots := fakeSnapshots{}
+	for blockid, price := range map[string]string{"commit": "1", "tip": "2"} {
+		state := sandbox.NewMemXModel()
+		state.Put("oracle", []byte("price"), &ledger.VersionedData{
+			RefTxid:  []byte("tx" + price),
+			PureData: &ledger.PureData{Bucket: "oracle", Key: []byte("price"), Value: []byte(price)},
+		})
+		snapshots[blockid] = state
+	}
+	mg := &Manager{
+		Ctx: &PrivacyCtx{
+			BaseCtx:  context.BaseCtx{XLog: log},
+			Contract: &fakeContractManager{},
+			ChainCtx: &base.ChainCtx{Crypto: crypto, Address: alice},
+		},
+		store:  store,
+		public: snapshots,
+	}
+
+	members := map[string]*ecdsa.PublicKey{alice.Address: alice.PublicKey}
+	exec := func(blockid, method, key string) {
+		payload := &protos.PrivatePayload{
+			ContractRequests: []*protos.InvokeRequest{{
+				ModuleName:   "wasm",
+				ContractName: "counter",
+				MethodName:   method,
+			}},
+			TxOutputsExt: []*protos.TxOutputExt{{Bucket: "counter", Key: []byte(key), Value: []byte("1")}},
+		}
+		tx, err := SealPrivateTx(crypto, alice, "xuper", members, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := mg.save(tx); err != nil {
+			t.Fatal(err)
+		}
+		if err := mg.execCommitted([]byte(blockid), alice.Address, commitArgs(t, crypto, alice, tx.Hash)); err != nil {
+			t.Fatal(err)
+		}
+		if result, err := mg.GetExecResult(tx.Hash); err != nil || result != "success" {
+			t.Fatalf("unexpected exec result of %s: %s %v", method, result, err)
+		}
+	}
+
+	// 按提交区块的公开状态执行，写集与发送方给出的一致
+	exec("commit", "sync", "price")
+	// 之后的私有交易能读到已写入的私有状态
+	exec("tip", "copy", "copy")
+	value, err := store.Get(makePrivateStateKey("counter", []byte("copy")))
+	if err != nil || string(value) != "1" {
+		t.Fatalf("unexpected private state: %s %v", value, err)
+	}
+}
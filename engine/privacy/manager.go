@@ -0,0 +1,319 @@
+package privacy
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+	xctx "github.com/wooyang2018/corechain/common/context"
+	contractBase "github.com/wooyang2018/corechain/contract/base"
+	"github.com/wooyang2018/corechain/engine/base"
+	"github.com/wooyang2018/corechain/ledger"
+	"github.com/wooyang2018/corechain/network"
+	netBase "github.com/wooyang2018/corechain/network/base"
+	"github.com/wooyang2018/corechain/protos"
+	"github.com/wooyang2018/corechain/storage"
+	"github.com/wooyang2018/corechain/storage/leveldb"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// 成员公钥缓存时间
+	publicKeyExpired = 10 * time.Minute
+)
+
+// snapshotCreator 按区块创建公开状态的快照
+type snapshotCreator interface {
+	CreateSnapshot(blkId []byte) (ledger.XReader, error)
+}
+
+// Manager 私有交易管理，密钥为节点账户密钥，密文只发往成员节点
+type Manager struct {
+	Ctx         *PrivacyCtx
+	store       storage.Database
+	public      snapshotCreator
+	pubKeys     *cache.Cache // address => *ecdsa.PublicKey
+	subscribers []netBase.Subscriber
+	exit        chan struct{}
+	exited      chan struct{}
+}
+
+var _ base.PrivacyAgent = (*Manager)(nil)
+
+// NewPrivacyManager create instance of Manager, 每条链都注册私有交易合约
+func NewPrivacyManager(ctx *PrivacyCtx) (*Manager, error) {
+	if ctx == nil || ctx.Contract == nil || ctx.BcName == "" {
+		return nil, fmt.Errorf("privacy ctx set error")
+	}
+
+	t := NewPrivacyContract(ctx.ChainCtx.Crypto)
+	register := ctx.Contract.GetKernRegistry()
+	// 注册合约方法
+	kMethods := map[string]contractBase.KernMethod{
+		"commit": t.commit,
+		"query":  t.query,
+	}
+	for method, f := range kMethods {
+		if _, err := register.GetKernMethod(PrivacyKernelContract, method); err != nil {
+			register.RegisterKernMethod(PrivacyKernelContract, method, f)
+		}
+	}
+
+	envCfg := ctx.ChainCtx.EngCtx.EnvCfg
+	storePath := filepath.Join(envCfg.GenDataAbsPath(envCfg.ChainDir), ctx.BcName, storeDirName)
+	store, err := leveldb.CreateKVInstance(&leveldb.KVParameter{
+		DBPath:                storePath,
+		KVEngineType:          leveldb.KVEngineType,
+		StorageType:           leveldb.StorageTypeSingle,
+		MemCacheSize:          ledger.MemCacheSize,
+		FileHandlersCacheSize: ledger.FileHandlersCacheSize,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("open privacy store failed.err:%v", err)
+	}
+
+	mg := &Manager{
+		Ctx:     ctx,
+		store:   store,
+		public:  ctx.ChainCtx.State,
+		pubKeys: cache.New(publicKeyExpired, publicKeyExpired),
+		exit:    make(chan struct{}),
+		exited:  make(chan struct{}),
+	}
+	if err := mg.registerToNetwork(); err != nil {
+		store.Close()
+		return nil, err
+	}
+	go func() {
+		defer close(mg.exited)
+		mg.execLoop()
+	}()
+	return mg, nil
+}
+
+// SendPrivateTx 加密后发往全部成员节点，本节点总是成员之一，返回需要提交到$privacy合约的hash
+// 密文以节点身份签名，只接受节点账户自己发起的请求
+func (t *Manager) SendPrivateTx(ctx xctx.Context, initiator string, members []string,
+	payload *protos.PrivatePayload) ([]byte, error) {
+	if len(members) == 0 || payload == nil {
+		return nil, ErrNoMembers
+	}
+
+	self := t.Ctx.ChainCtx.Address
+	if initiator != self.Address {
+		return nil, ErrNotAuthorized
+	}
+	memberKeys := map[string]*ecdsa.PublicKey{self.Address: self.PublicKey}
+	remote := make([]string, 0, len(members))
+	for _, member := range members {
+		if _, ok := memberKeys[member]; ok {
+			continue
+		}
+		pub, err := t.getPublicKey(ctx, member)
+		if err != nil {
+			ctx.GetLog().Warn("get member public key failed", "member", member, "err", err)
+			return nil, err
+		}
+		memberKeys[member] = pub
+		remote = append(remote, member)
+	}
+
+	tx, err := SealPrivateTx(t.Ctx.ChainCtx.Crypto, self, t.Ctx.BcName, memberKeys, payload)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.save(tx); err != nil {
+		return nil, err
+	}
+	if err := t.distribute(ctx, tx, remote); err != nil {
+		return nil, err
+	}
+	return tx.Hash, nil
+}
+
+// GetPrivateTx 本节点是成员时解密私有交易，返回明文和成员列表，请求方也必须是成员
+func (t *Manager) GetPrivateTx(initiator string, hash []byte) (*protos.PrivatePayload, []string, error) {
+	buf, err := t.store.Get(makePrivateTxKey(hash))
+	if err != nil {
+		return nil, nil, ErrNotMember
+	}
+	tx := new(protos.PrivateTx)
+	if err := proto.Unmarshal(buf, tx); err != nil {
+		return nil, nil, err
+	}
+	if _, ok := tx.GetKeys()[initiator]; !ok {
+		return nil, nil, ErrNotAuthorized
+	}
+	payload, err := OpenPrivateTx(t.Ctx.ChainCtx.Crypto, t.Ctx.ChainCtx.Address, tx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return payload, tx.Members, nil
+}
+
+func (t *Manager) Stop() {
+	if net := t.Ctx.ChainCtx.EngCtx.Net; net != nil {
+		for _, sub := range t.subscribers {
+			net.UnRegister(sub)
+		}
+	}
+	t.subscribers = nil
+	close(t.exit)
+	<-t.exited
+	t.store.Close()
+}
+
+func (t *Manager) save(tx *protos.PrivateTx) error {
+	buf, err := proto.Marshal(tx)
+	if err != nil {
+		return err
+	}
+	return t.store.Put(makePrivateTxKey(tx.Hash), buf)
+}
+
+// distribute 按账户地址发往成员节点，每个成员都确认收到才算成功
+func (t *Manager) distribute(ctx xctx.Context, tx *protos.PrivateTx, members []string) error {
+	if len(members) == 0 {
+		return nil
+	}
+	net := t.Ctx.ChainCtx.EngCtx.Net
+	msg := network.NewMessage(protos.CoreMessage_POST_PRIVATE_TX, tx,
+		network.WithBCName(t.Ctx.BcName),
+		network.WithLogId(ctx.GetLog().GetLogId()),
+	)
+	responses, err := net.SendMessageWithResponse(ctx, msg, netBase.WithAccounts(members))
+	if err != nil {
+		ctx.GetLog().Warn("send private tx failed", "err", err)
+	}
+
+	received := make(map[string]bool, len(responses))
+	for _, resp := range responses {
+		if resp.GetHeader().GetErrorType() != protos.CoreMessage_SUCCESS {
+			continue
+		}
+		var ack protos.NodePublicKey
+		if err := network.Unmarshal(resp, &ack); err != nil {
+			continue
+		}
+		received[ack.Address] = true
+	}
+
+	var missing []string
+	for _, member := range members {
+		if !received[member] {
+			missing = append(missing, member)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("%w, missing:%v", ErrMembersUnreached, missing)
+	}
+	return nil
+}
+
+// getPublicKey 向成员节点查询公钥，公钥必须与成员地址匹配
+func (t *Manager) getPublicKey(ctx xctx.Context, member string) (*ecdsa.PublicKey, error) {
+	if v, ok := t.pubKeys.Get(member); ok {
+		return v.(*ecdsa.PublicKey), nil
+	}
+
+	net := t.Ctx.ChainCtx.EngCtx.Net
+	crypto := t.Ctx.ChainCtx.Crypto
+	msg := network.NewMessage(protos.CoreMessage_GET_PUBLIC_KEY, nil,
+		network.WithBCName(t.Ctx.BcName),
+		network.WithLogId(ctx.GetLog().GetLogId()),
+	)
+	responses, err := net.SendMessageWithResponse(ctx, msg, netBase.WithAccounts([]string{member}))
+	if err != nil {
+		ctx.GetLog().Warn("get public key failed", "member", member, "err", err)
+	}
+	for _, resp := range responses {
+		var key protos.NodePublicKey
+		if err := network.Unmarshal(resp, &key); err != nil || key.Address != member {
+			continue
+		}
+		pub, err := crypto.GetEcdsaPublicKeyFromJsonStr(key.PublicKey)
+		if err != nil {
+			continue
+		}
+		if ok, _ := crypto.VerifyAddressUsingPublicKey(member, pub); !ok {
+			continue
+		}
+		t.pubKeys.SetDefault(member, pub)
+		return pub, nil
+	}
+	return nil, ErrPublicKeyNotFound
+}
+
+func (t *Manager) registerToNetwork() error {
+	net := t.Ctx.ChainCtx.EngCtx.Net
+	if net == nil {
+		return nil
+	}
+	handles := map[protos.CoreMessage_MessageType]network.HandleFunc{
+		protos.CoreMessage_POST_PRIVATE_TX: t.handlePostPrivateTx,
+		protos.CoreMessage_GET_PUBLIC_KEY:  t.handleGetPublicKey,
+	}
+	for msgType, handle := range handles {
+		sub := net.NewSubscriber(msgType, handle, network.WithFilterBCName(t.Ctx.BcName))
+		if err := net.Register(sub); err != nil {
+			return fmt.Errorf("register subscriber failed.type:%s err:%v", msgType, err)
+		}
+		t.subscribers = append(t.subscribers, sub)
+	}
+	return nil
+}
+
+func (t *Manager) response(request *protos.CoreMessage, msg proto.Message,
+	errType protos.CoreMessage_ErrorType) *protos.CoreMessage {
+	opts := []network.MessageOption{
+		network.WithBCName(t.Ctx.BcName),
+		network.WithErrorType(errType),
+		network.WithLogId(request.GetHeader().GetLogid()),
+	}
+	return network.NewMessage(network.GetRespMessageType(request.GetHeader().GetType()), msg, opts...)
+}
+
+// handleGetPublicKey 返回本节点地址和公钥
+func (t *Manager) handleGetPublicKey(ctx xctx.Context,
+	request *protos.CoreMessage) (*protos.CoreMessage, error) {
+	self := t.Ctx.ChainCtx.Address
+	output := &protos.NodePublicKey{
+		Address:   self.Address,
+		PublicKey: self.PublicKeyStr,
+	}
+	return t.response(request, output, protos.CoreMessage_SUCCESS), nil
+}
+
+// handlePostPrivateTx 本节点是成员时保存密文，确认消息中带上本节点地址
+func (t *Manager) handlePostPrivateTx(ctx xctx.Context,
+	request *protos.CoreMessage) (*protos.CoreMessage, error) {
+	var tx protos.PrivateTx
+	if err := network.Unmarshal(request, &tx); err != nil {
+		ctx.GetLog().Warn("unmarshal private tx error", "err", err)
+		t.Ctx.ChainCtx.EngCtx.Net.ReportPeer(request.GetHeader().GetFrom(), netBase.PeerEventInvalidMessage)
+		return t.response(request, nil, protos.CoreMessage_UNMARSHAL_MSG_BODY_ERROR), nil
+	}
+
+	crypto := t.Ctx.ChainCtx.Crypto
+	if err := VerifyPrivateTx(crypto, &tx); err != nil || tx.Bcname != t.Ctx.BcName {
+		ctx.GetLog().Warn("verify private tx error", "sender", tx.Sender, "err", err)
+		t.Ctx.ChainCtx.EngCtx.Net.ReportPeer(request.GetHeader().GetFrom(), netBase.PeerEventInvalidMessage)
+		return t.response(request, nil, protos.CoreMessage_UNKNOW_ERROR), nil
+	}
+	// 非成员节点可能因静态节点配置收到密文，直接丢弃
+	if _, err := OpenPrivateTx(crypto, t.Ctx.ChainCtx.Address, &tx); err != nil {
+		ctx.GetLog().Debug("ignore private tx", "sender", tx.Sender, "err", err)
+		return t.response(request, nil, protos.CoreMessage_UNKNOW_ERROR), nil
+	}
+	if err := t.save(&tx); err != nil {
+		ctx.GetLog().Warn("save private tx error", "err", err)
+		return t.response(request, nil, protos.CoreMessage_UNKNOW_ERROR), nil
+	}
+
+	ack := &protos.NodePublicKey{Address: t.Ctx.ChainCtx.Address.Address}
+	return t.response(request, ack, protos.CoreMessage_SUCCESS), nil
+}
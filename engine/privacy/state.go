@@ -0,0 +1,105 @@
+package privacy
+
+import (
+	"bytes"
+
+	"github.com/wooyang2018/corechain/contract/sandbox"
+	"github.com/wooyang2018/corechain/ledger"
+	"github.com/wooyang2018/corechain/storage"
+)
+
+// privateState 成员节点本地的私有状态，读取时先查私有状态再回落到公开状态，写入只落在本地
+type privateState struct {
+	store  storage.Database
+	public ledger.XReader
+}
+
+var _ ledger.XReader = (*privateState)(nil)
+
+func newPrivateState(store storage.Database, public ledger.XReader) *privateState {
+	return &privateState{
+		store:  store,
+		public: public,
+	}
+}
+
+func (s *privateState) Get(bucket string, key []byte) (*ledger.VersionedData, error) {
+	value, err := s.store.Get(makePrivateStateKey(bucket, key))
+	if err != nil {
+		if storage.ErrNotFound(err) {
+			return s.public.Get(bucket, key)
+		}
+		return nil, err
+	}
+	return makeVersionedData(bucket, key, value), nil
+}
+
+func (s *privateState) GetUncommited(bucket string, key []byte) (*ledger.VersionedData, error) {
+	return s.Get(bucket, key)
+}
+
+// Select 合并私有状态和公开状态，key相同时以私有状态为准
+func (s *privateState) Select(bucket string, startKey []byte, endKey []byte) (ledger.XIterator, error) {
+	back, err := s.public.Select(bucket, startKey, endKey)
+	if err != nil {
+		return nil, err
+	}
+	prefix := makePrivateStateKey(bucket, nil)
+	front := &stateIterator{
+		bucket: bucket,
+		prefix: prefix,
+		iter:   s.store.NewIteratorWithRange(makePrivateStateKey(bucket, startKey), makePrivateStateKey(bucket, endKey)),
+	}
+	return sandbox.NewMultiIterator(front, back), nil
+}
+
+// apply 将私有交易执行产生的写集写入批量操作
+func (s *privateState) apply(batch storage.Batch, wset []*ledger.PureData) error {
+	for _, data := range wset {
+		if err := batch.Put(makePrivateStateKey(data.Bucket, data.Key), data.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// stateIterator 遍历私有状态，key不包含bucket前缀
+type stateIterator struct {
+	bucket string
+	prefix []byte
+	iter   storage.Iterator
+}
+
+func (it *stateIterator) Key() []byte {
+	return bytes.TrimPrefix(it.iter.Key(), it.prefix)
+}
+
+func (it *stateIterator) Value() *ledger.VersionedData {
+	return makeVersionedData(it.bucket, it.Key(), it.iter.Value())
+}
+
+func (it *stateIterator) Next() bool {
+	return it.iter.Next()
+}
+
+func (it *stateIterator) Error() error {
+	return it.iter.Error()
+}
+
+func (it *stateIterator) Close() {
+	it.iter.Release()
+}
+
+// 私有状态没有链上的版本，使用固定的引用，避免沙盒将其视为不存在的数据
+var privateStateRef = []byte(PrivacyKernelContract)
+
+func makeVersionedData(bucket string, key, value []byte) *ledger.VersionedData {
+	return &ledger.VersionedData{
+		RefTxid: privateStateRef,
+		PureData: &ledger.PureData{
+			Bucket: bucket,
+			Key:    append([]byte(nil), key...),
+			Value:  append([]byte(nil), value...),
+		},
+	}
+}
@@ -0,0 +1,84 @@
+package privacy
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/wooyang2018/corechain/contract/sandbox"
+	"github.com/wooyang2018/corechain/ledger"
+	"github.com/wooyang2018/corechain/protos"
+	"github.com/wooyang2018/corechain/storage/leveldb"
+)
+
+func TestPrivateState(t *testing.T) {
+	store, err := leveldb.CreateKVInstance(&leveldb.KVParameter{
+		DBPath:                filepath.Join(t.TempDir(), storeDirName),
+		KVEngineType:          leveldb.KVEngineType,
+		StorageType:           leveldb.StorageTypeSingle,
+		MemCacheSize:          ledger.MemCacheSize,
+		FileHandlersCacheSize: ledger.FileHandlersCacheSize,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+
+	public := sandbox.NewMemXModel()
+	for _, key := range []string{"a", "b", "c"} {
+		public.Put("counter", []byte(key), makeVersionedData("counter", []byte(key), []byte("public")))
+	}
+	state := newPrivateState(store, public)
+
+	// 私有写集只写入本地，覆盖同名的公开数据
+	wset := []*ledger.PureData{
+		{Bucket: "counter", Key: []byte("b"), Value: []byte("private")},
+		{Bucket: "counter", Key: []byte("d"), Value: []byte("private")},
+	}
+	batch := store.NewBatch()
+	if err := state.apply(batch, wset); err != nil {
+		t.Fatal(err)
+	}
+	if err := batch.Write(); err != nil {
+		t.Fatal(err)
+	}
+
+	if data, err := state.Get("counter", []byte("a")); err != nil || string(data.GetPureData().GetValue()) != "public" {
+		t.Fatalf("unexpected value of a: %v %v", data, err)
+	}
+	if data, err := state.Get("counter", []byte("b")); err != nil || string(data.GetPureData().GetValue()) != "private" {
+		t.Fatalf("unexpected value of b: %v %v", data, err)
+	}
+	if data, _ := public.Get("counter", []byte("b")); string(data.GetPureData().GetValue()) != "public" {
+		t.Fatal("public state should not be changed")
+	}
+
+	iter, err := state.Select("counter", []byte("a"), []byte("e"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer iter.Close()
+	expect := map[string]string{"a": "public", "b": "private", "c": "public", "d": "private"}
+	var keys []string
+	for iter.Next() {
+		key := string(iter.Key())
+		keys = append(keys, key)
+		if string(iter.Value().GetPureData().GetValue()) != expect[key] {
+			t.Errorf("unexpected value of %s: %s", key, iter.Value().GetPureData().GetValue())
+		}
+	}
+	if len(keys) != 4 || keys[0] != "a" || keys[3] != "d" {
+		t.Fatalf("unexpected keys: %v", keys)
+	}
+
+	outputs := []*protos.TxOutputExt{
+		{Bucket: "counter", Key: []byte("b"), Value: []byte("private")},
+		{Bucket: "counter", Key: []byte("d"), Value: []byte("private")},
+	}
+	if !matchWriteSet(wset, outputs) {
+		t.Error("write set should match")
+	}
+	outputs[1].Value = []byte("forged")
+	if matchWriteSet(wset, outputs) {
+		t.Error("forged write set should not match")
+	}
+}
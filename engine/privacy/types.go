@@ -0,0 +1,55 @@
+package privacy
+
+import (
+	"encoding/hex"
+	"errors"
+)
+
+const (
+	// 链上记录hash对应的发送方
+	commitPrefix = "commit_"
+	// 本地存储的私有交易密文
+	privateTxPrefix = "ptx_"
+	// 本地私有状态，key为bucket/key
+	privateStatePrefix = "pstate_"
+	// 私有交易执行结果
+	execResultPrefix = "pexec_"
+	// 已执行到的区块高度
+	execCursorKey = "pcursor"
+	// 私有交易本地存储目录，位于链数据目录下
+	storeDirName = "privacy"
+	// AES-256
+	aesKeySize   = 32
+	aesBlockSize = 16
+)
+
+var (
+	ErrInvalidPrivateTx  = errors.New("invalid private tx")
+	ErrNotMember         = errors.New("not a member of private tx")
+	ErrNoMembers         = errors.New("private tx members is empty")
+	ErrPublicKeyNotFound = errors.New("member public key not found")
+	ErrMembersUnreached  = errors.New("private tx not received by all members")
+	ErrHashCommitted     = errors.New("private tx hash already committed")
+	ErrHashNotCommitted  = errors.New("private tx hash not committed")
+	ErrNotAuthorized     = errors.New("initiator is not authorized")
+	ErrInvalidSign       = errors.New("invalid sender sign of private tx hash")
+	ErrWriteSetMismatch  = errors.New("private tx write set mismatch")
+	ErrNotExecuted       = errors.New("private tx not executed")
+)
+
+// MakeCommitKey 链上hash记录的key，按发送方区分
+func MakeCommitKey(sender string, hash []byte) string {
+	return commitPrefix + sender + "_" + hex.EncodeToString(hash)
+}
+
+func makePrivateTxKey(hash []byte) []byte {
+	return []byte(privateTxPrefix + hex.EncodeToString(hash))
+}
+
+func makePrivateStateKey(bucket string, key []byte) []byte {
+	return append([]byte(privateStatePrefix+bucket+"/"), key...)
+}
+
+func makeExecResultKey(hash []byte) []byte {
+	return []byte(execResultPrefix + hex.EncodeToString(hash))
+}
@@ -2,6 +2,7 @@ package reader
 
 import (
 	xctx "github.com/wooyang2018/corechain/common/context"
+	contractBase "github.com/wooyang2018/corechain/contract/base"
 	"github.com/wooyang2018/corechain/engine/base"
 	"github.com/wooyang2018/corechain/logger"
 	"github.com/wooyang2018/corechain/protos"
@@ -22,6 +23,8 @@ type ContractReader interface {
 	QueryContractMethodACL(contract, method string) (*protos.Acl, error)
 	// 查询账户治理代币余额
 	QueryAccountGovernTokenBalance(account string) (*protos.GovernTokenBalance, error)
+	// 查询evm合约abi
+	QueryContractAbi(contract string) ([]byte, error)
 }
 
 type contractReader struct {
@@ -130,3 +133,15 @@ func (t *contractReader) QueryAccountGovernTokenBalance(account string) (*protos
 
 	return amount, nil
 }
+
+func (t *contractReader) QueryContractAbi(contract string) ([]byte, error) {
+	verdata, err := t.chainCtx.State.CreateXMReader().Get("contract", contractBase.ContractAbiKey(contract))
+	if err != nil {
+		return nil, base.CastError(err)
+	}
+	if len(verdata.GetPureData().GetValue()) == 0 {
+		return nil, base.ErrContractNotExist
+	}
+
+	return verdata.GetPureData().GetValue(), nil
+}
@@ -2,6 +2,9 @@ package base
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
 	"fmt"
 	"time"
 
@@ -13,8 +16,33 @@ import (
 const (
 	SubModName    = "corechain"
 	ReqCtxKeyName = "reqCtx"
+	// 网关转发事件订阅时携带原始客户端地址的metadata键
+	ForwardedForKey = "x-forwarded-for"
+	// 网关转发时携带进程内令牌的metadata键，令牌匹配时才信任ForwardedForKey
+	GatewayTokenKey = "x-corechain-gateway-token"
 )
 
+// 网关与rpc服务在同一进程内，令牌启动时随机生成，客户端无法获知，也就无法伪造转发地址
+var gatewayToken = genGatewayToken()
+
+func genGatewayToken() string {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(buf)
+}
+
+// GatewayToken 返回网关转发请求时携带的令牌
+func GatewayToken() string {
+	return gatewayToken
+}
+
+// IsGatewayToken 检查请求携带的令牌是否由本进程的网关生成
+func IsGatewayToken(token string) bool {
+	return gatewayToken != "" && subtle.ConstantTimeCompare([]byte(token), []byte(gatewayToken)) == 1
+}
+
 // 请求级别上下文
 type ReqCtx interface {
 	context.Context
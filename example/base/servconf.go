@@ -27,6 +27,11 @@ type ServConf struct {
 	InitConnWindowSize int32    `yaml:"initConnWindowSize,omitempty"`
 	TlsServerName      string   `yaml:"tlsServerName,omitempty"`
 	EventAddrMaxConn   int      `yaml:"eventAddrMaxConn,omitempty"`
+	// 兼容以太坊JSON-RPC的web3服务
+	EnableWeb3    bool   `yaml:"enableWeb3,omitempty"`
+	Web3Port      int    `yaml:"web3Port,omitempty"`
+	Web3ChainName string `yaml:"web3ChainName,omitempty"`
+	Web3ChainID   uint64 `yaml:"web3ChainID,omitempty"`
 }
 
 func LoadServConf(cfgFile string) (*ServConf, error) {
@@ -58,6 +63,10 @@ func GetDefServConf() *ServConf {
 		InitConnWindowSize: 64 << 10,
 		TlsServerName:      "localhost",
 		EventAddrMaxConn:   5,
+		EnableWeb3:         false,
+		Web3Port:           38103,
+		Web3ChainName:      "xuper",
+		Web3ChainID:        1337,
 	}
 }
 
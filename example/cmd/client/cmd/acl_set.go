@@ -0,0 +1,199 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/wooyang2018/corechain/protos"
+	"github.com/wooyang2018/corechain/state/utxo"
+)
+
+// ACLSetCommand set acl struct
+type ACLSetCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	accountName  string
+	contractName string
+	methodName   string
+	rule         string
+	acceptValue  float64
+	aks          string
+	sets         string
+	fee          string
+	isMulti      bool
+	multiAddrs   string
+	output       string
+	debug        bool
+}
+
+// NewACLSetCommand new acl set cmd
+func NewACLSetCommand(cli *Cli) *cobra.Command {
+	t := new(ACLSetCommand)
+	t.cli = cli
+	t.cmd = &cobra.Command{
+		Use:     "set [OPTIONS]",
+		Short:   "set an access control list(ACL) for an account or contract method.",
+		Example: t.example(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.TODO()
+			return t.setACL(ctx)
+		},
+	}
+	t.addFlags()
+
+	return t.cmd
+}
+
+func (t *ACLSetCommand) addFlags() {
+	t.cmd.Flags().StringVar(&t.accountName, "account", "", "contract account name")
+	t.cmd.Flags().StringVar(&t.contractName, "contract", "", "contract name")
+	t.cmd.Flags().StringVar(&t.methodName, "method", "", "method name")
+	t.cmd.Flags().StringVar(&t.rule, "rule", "threshold", "permission rule: threshold, akset, rate, sum, ca or vote")
+	t.cmd.Flags().Float64Var(&t.acceptValue, "accept", 1, "accept value: weight threshold, sign rate, sign count or min vote percent")
+	t.cmd.Flags().StringVar(&t.aks, "aks", "", "comma separated members, ak or account with optional weight, e.g. ak1:0.5,ak2")
+	t.cmd.Flags().StringVar(&t.sets, "sets", "", "semicolon separated ak sets for akset rule, e.g. ak1,ak2;ak3")
+	t.cmd.Flags().StringVar(&t.fee, "fee", "", "fee of one tx")
+	t.cmd.Flags().BoolVarP(&t.isMulti, "isMulti", "m", false, "multisig scene")
+	t.cmd.Flags().StringVarP(&t.multiAddrs, "multiAddrs", "A", "data/acl/addrs", "multiAddrs if multisig scene")
+	t.cmd.Flags().StringVarP(&t.output, "output", "o", "./tx.out", "tx draw data")
+	t.cmd.Flags().BoolVar(&t.debug, "debug", false, "debug print tx instead of posting")
+}
+
+func (t *ACLSetCommand) example() string {
+	return `
+xchain acl set --account XC1111111111111111@xuper --rule sum --accept 3 --aks ak1,ak2,ak3,ak4,ak5 -m
+xchain acl set --contract counter --method increase --rule rate --accept 0.6 --aks ak1,ak2,ak3
+xchain acl set --account XC2222222222222222@xuper --rule ca --accept 2 --aks XC1111111111111111@xuper
+xchain acl set --contract counter --method upgrade --rule vote --accept 60
+`
+}
+
+func (t *ACLSetCommand) setACL(ctx context.Context) error {
+	if len(t.accountName) == 0 && (len(t.contractName) == 0 || len(t.methodName) == 0) {
+		return errors.New("account name or contract and method name required")
+	}
+	acl, err := t.buildACL()
+	if err != nil {
+		return err
+	}
+	aclJSON, err := json.Marshal(acl)
+	if err != nil {
+		return err
+	}
+
+	ct := &CommTrans{
+		Fee:          t.fee,
+		FrozenHeight: 0,
+		Version:      utxo.TxVersion,
+		ModuleName:   "xkernel",
+		ContractName: "$acl",
+		Args:         map[string][]byte{"acl": aclJSON},
+		MultiAddrs:   t.multiAddrs,
+		IsQuick:      t.isMulti,
+		Output:       t.output,
+		ChainName:    t.cli.RootOptions.Name,
+		Keys:         t.cli.RootOptions.Keys,
+		XchainClient: t.cli.XchainClient(),
+		CryptoType:   t.cli.RootOptions.Crypto,
+		DebugTx:      t.debug,
+		RootOptions:  t.cli.RootOptions,
+	}
+	if len(t.accountName) != 0 {
+		ct.MethodName = "SetAccountAcl"
+		ct.Args["account_name"] = []byte(t.accountName)
+	} else {
+		ct.MethodName = "SetMethodAcl"
+		ct.Args["contract_name"] = []byte(t.contractName)
+		ct.Args["method_name"] = []byte(t.methodName)
+	}
+
+	if t.isMulti {
+		return ct.GenerateMultisigGenRawTx(ctx)
+	}
+	return ct.Transfer(ctx)
+}
+
+func (t *ACLSetCommand) buildACL() (*protos.Acl, error) {
+	rule, err := parsePermissionRule(t.rule)
+	if err != nil {
+		return nil, err
+	}
+	acl := &protos.Acl{
+		Pm: &protos.PermissionModel{
+			Rule:        rule,
+			AcceptValue: t.acceptValue,
+		},
+	}
+
+	// 社区投票策略由交易引用的提案授权，没有成员列表
+	if rule == protos.PermissionRule_COMMUNITY_VOTE {
+		return acl, nil
+	}
+	if rule == protos.PermissionRule_SIGN_AKSET {
+		if t.sets == "" {
+			return nil, errors.New("sets required for akset rule")
+		}
+		acl.AkSets = &protos.AkSets{
+			Sets: make(map[string]*protos.AkSet),
+		}
+		for i, set := range strings.Split(t.sets, ";") {
+			acl.AkSets.Sets[strconv.Itoa(i+1)] = &protos.AkSet{
+				Aks: splitNonEmpty(set, ","),
+			}
+		}
+		return acl, nil
+	}
+
+	members := splitNonEmpty(t.aks, ",")
+	if len(members) == 0 {
+		return nil, errors.New("aks required")
+	}
+	acl.AksWeight = make(map[string]float64, len(members))
+	for _, member := range members {
+		// 签名率和签名个数策略不使用权重，默认为1
+		name, weight := member, 1.0
+		if idx := strings.LastIndex(member, ":"); idx > 0 {
+			name = member[:idx]
+			weight, err = strconv.ParseFloat(member[idx+1:], 64)
+			if err != nil {
+				return nil, fmt.Errorf("bad weight of %s: %v", name, err)
+			}
+		}
+		acl.AksWeight[name] = weight
+	}
+	return acl, nil
+}
+
+func parsePermissionRule(rule string) (protos.PermissionRule, error) {
+	switch strings.ToLower(rule) {
+	case "threshold":
+		return protos.PermissionRule_SIGN_THRESHOLD, nil
+	case "akset":
+		return protos.PermissionRule_SIGN_AKSET, nil
+	case "rate":
+		return protos.PermissionRule_SIGN_RATE, nil
+	case "sum":
+		return protos.PermissionRule_SIGN_SUM, nil
+	case "ca":
+		return protos.PermissionRule_CA_SERVER, nil
+	case "vote":
+		return protos.PermissionRule_COMMUNITY_VOTE, nil
+	}
+	return protos.PermissionRule_NULL, fmt.Errorf("unsupported permission rule %s", rule)
+}
+
+func splitNonEmpty(s, sep string) []string {
+	var res []string
+	for _, item := range strings.Split(s, sep) {
+		if item = strings.TrimSpace(item); item != "" {
+			res = append(res, item)
+		}
+	}
+	return res
+}
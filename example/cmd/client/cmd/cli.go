@@ -326,12 +326,14 @@ func assembleTxSupportAccount(ctx context.Context, client pb.MXchainClient, opt
 	bigZero := big.NewInt(0)
 	totalNeed := big.NewInt(0)
 	tx := &pb.Transaction{
-		Version:   opt.Version,
-		Coinbase:  false,
-		Desc:      opt.Desc,
-		Nonce:     utils.GenNonce(),
-		Timestamp: time.Now().UnixNano(),
-		Initiator: initAddr,
+		Version:    opt.Version,
+		Coinbase:   false,
+		Desc:       opt.Desc,
+		Nonce:      utils.GenNonce(),
+		Timestamp:  time.Now().UnixNano(),
+		Initiator:  initAddr,
+		LockHeight: opt.LockHeight,
+		LockTime:   opt.LockTime,
 	}
 	account := &pb.TxDataAccount{
 		Address:      opt.To,
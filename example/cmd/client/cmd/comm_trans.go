@@ -36,6 +36,9 @@ type CommTrans struct {
 	Descfile     string
 	Fee          string
 	FrozenHeight int64
+	// 交易时间锁，在指定高度和时间(unix秒)之前交易不会被打包
+	LockHeight   int64
+	LockTime     int64
 	Version      int32
 	From         string
 	ModuleName   string
@@ -191,11 +194,13 @@ func (c *CommTrans) ReadPreExeReq(buf []byte) (*pb.InvokeRequest, error) {
 func (c *CommTrans) GenRawTx(ctx context.Context, desc []byte, preExeRes *pb.InvokeResponse,
 	preExeReqs []*pb.InvokeRequest) (*pb.Transaction, error) {
 	tx := &pb.Transaction{
-		Desc:      desc,
-		Coinbase:  false,
-		Nonce:     utils.GenNonce(),
-		Timestamp: time.Now().UnixNano(),
-		Version:   utxo.TxVersion,
+		Desc:       desc,
+		Coinbase:   false,
+		Nonce:      utils.GenNonce(),
+		Timestamp:  time.Now().UnixNano(),
+		Version:    utxo.TxVersion,
+		LockHeight: c.LockHeight,
+		LockTime:   c.LockTime,
 	}
 
 	var gasUsed int64
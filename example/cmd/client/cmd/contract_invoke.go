@@ -27,6 +27,8 @@ type ContractInvokeCommand struct {
 	amount     string
 	debug      bool
 	abiFile    string
+	lockHeight int64
+	lockTime   int64
 }
 
 // NewContractInvokeCommand new wasm/native/evm invoke cmd
@@ -58,6 +60,8 @@ func (c *ContractInvokeCommand) addFlags() {
 	c.cmd.Flags().StringVarP(&c.methodName, "method", "", "invoke", "contract method name")
 	c.cmd.Flags().StringVarP(&c.amount, "amount", "", "", "the amount transfer to contract")
 	c.cmd.Flags().BoolVarP(&c.debug, "debug", "", false, "debug print tx instead of posting")
+	c.cmd.Flags().Int64Var(&c.lockHeight, "lockHeight", 0, "tx is not valid before this block height")
+	c.cmd.Flags().Int64Var(&c.lockTime, "lockTime", 0, "tx is not valid before this unix timestamp in seconds")
 	if c.module == string(bridge.TypeEvm) {
 		c.cmd.Flags().StringVarP(&c.abiFile, "abi", "", "", "the abi file of contract")
 	}
@@ -73,6 +77,8 @@ func (c *ContractInvokeCommand) invoke(ctx context.Context, codeName string) err
 	ct := &CommTrans{
 		Fee:          c.fee,
 		FrozenHeight: 0,
+		LockHeight:   c.lockHeight,
+		LockTime:     c.lockTime,
 		Version:      utxo.TxVersion,
 		From:         c.account,
 		ModuleName:   c.module,
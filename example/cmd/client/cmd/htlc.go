@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/wooyang2018/corechain/contract/htlc"
+)
+
+// HTLCCommand hash time lock contract cmd entrance
+type HTLCCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+}
+
+// NewHTLCCommand new htlc cmd
+func NewHTLCCommand(cli *Cli) *cobra.Command {
+	c := new(HTLCCommand)
+	c.cli = cli
+	c.cmd = &cobra.Command{
+		Use:   "htlc",
+		Short: "htlc: lock|claim|refund|query.",
+	}
+	c.cmd.AddCommand(NewHTLCLockCommand(cli))
+	c.cmd.AddCommand(NewHTLCClaimCommand(cli))
+	c.cmd.AddCommand(NewHTLCRefundCommand(cli))
+	c.cmd.AddCommand(NewHTLCQueryCommand(cli))
+	return c.cmd
+}
+
+// querySwap 预执行查询swap，领取和退款交易据此声明打包高度
+func querySwap(ctx context.Context, cli *Cli, swapID string) (*htlc.Swap, error) {
+	qc := &CommTrans{
+		ModuleName:   "xkernel",
+		ContractName: htlc.HTLCKernelContract,
+		MethodName:   "Query",
+		Args:         map[string][]byte{"swap_id": []byte(swapID)},
+		Keys:         cli.RootOptions.Keys,
+		ChainName:    cli.RootOptions.Name,
+		XchainClient: cli.XchainClient(),
+	}
+	response, _, err := qc.GenPreExeRes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	responses := response.GetResponse().GetResponses()
+	if len(responses) == 0 {
+		return nil, fmt.Errorf("swap %s not found", swapID)
+	}
+	swap := &htlc.Swap{}
+	if err := json.Unmarshal(responses[0].GetBody(), swap); err != nil {
+		return nil, fmt.Errorf("parse swap error: %v", err)
+	}
+	return swap, nil
+}
+
+func init() {
+	AddCommand(NewHTLCCommand)
+}
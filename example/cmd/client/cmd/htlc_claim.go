@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/wooyang2018/corechain/contract/htlc"
+	ltx "github.com/wooyang2018/corechain/ledger/tx"
+	"github.com/wooyang2018/corechain/state/utxo"
+)
+
+// HTLCClaimCommand claim locked tokens with preimage
+type HTLCClaimCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	swapID   string
+	preimage string
+	fee      string
+}
+
+// NewHTLCClaimCommand new htlc claim cmd
+func NewHTLCClaimCommand(cli *Cli) *cobra.Command {
+	t := new(HTLCClaimCommand)
+	t.cli = cli
+	t.cmd = &cobra.Command{
+		Use:   "claim",
+		Short: "Claim locked tokens with the preimage.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.TODO()
+			return t.claim(ctx)
+		},
+	}
+	t.addFlags()
+
+	return t.cmd
+}
+
+func (c *HTLCClaimCommand) addFlags() {
+	c.cmd.Flags().StringVar(&c.swapID, "id", "", "swap id, the same as hashlock.")
+	c.cmd.Flags().StringVar(&c.preimage, "preimage", "", "hex encoded preimage.")
+	c.cmd.Flags().StringVar(&c.fee, "fee", "0", "The fee to claim.")
+}
+
+func (c *HTLCClaimCommand) claim(ctx context.Context) error {
+	if c.swapID == "" || c.preimage == "" {
+		return fmt.Errorf("swap id or preimage is nil")
+	}
+
+	// 查询超时高度，领取交易只能在该高度之前打包
+	swap, err := querySwap(ctx, c.cli, c.swapID)
+	if err != nil {
+		return err
+	}
+
+	ct := &CommTrans{
+		Amount:       "0",
+		Fee:          c.fee,
+		FrozenHeight: 0,
+		Version:      utxo.TxVersion,
+
+		MethodName: "Claim",
+		Args:       make(map[string][]byte),
+
+		IsQuick: false,
+
+		ChainName:    c.cli.RootOptions.Name,
+		Keys:         c.cli.RootOptions.Keys,
+		XchainClient: c.cli.XchainClient(),
+		CryptoType:   c.cli.RootOptions.Crypto,
+		RootOptions:  c.cli.RootOptions,
+	}
+
+	ct.To, err = readAddress(ct.Keys)
+	if err != nil {
+		return err
+	}
+
+	ct.ModuleName = "xkernel"
+	ct.ContractName = htlc.HTLCKernelContract
+	ct.Args["swap_id"] = []byte(c.swapID)
+	ct.Args["preimage"] = []byte(c.preimage)
+	ct.Args[ltx.ExpireHeightArg] = []byte(strconv.FormatInt(swap.Timeout-1, 10))
+
+	return ct.Transfer(ctx)
+}
@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/wooyang2018/corechain/contract/htlc"
+	"github.com/wooyang2018/corechain/state/utxo"
+)
+
+// HTLCLockCommand lock tokens with hashlock and timeout
+type HTLCLockCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	receiver string
+	amount   string
+	hashlock string
+	preimage string
+	timeout  int64
+	fee      string
+}
+
+// NewHTLCLockCommand new htlc lock cmd
+func NewHTLCLockCommand(cli *Cli) *cobra.Command {
+	t := new(HTLCLockCommand)
+	t.cli = cli
+	t.cmd = &cobra.Command{
+		Use:     "lock",
+		Short:   "Lock tokens with hashlock and timeout height.",
+		Example: t.example(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.TODO()
+			return t.lock(ctx)
+		},
+	}
+	t.addFlags()
+
+	return t.cmd
+}
+
+func (c *HTLCLockCommand) addFlags() {
+	c.cmd.Flags().StringVar(&c.receiver, "receiver", "", "receiver who could claim with the preimage.")
+	c.cmd.Flags().StringVar(&c.amount, "amount", "0", "amount to lock.")
+	c.cmd.Flags().StringVar(&c.hashlock, "hashlock", "", "hex encoded sha256 digest of the preimage.")
+	c.cmd.Flags().StringVar(&c.preimage, "preimage", "", "hex encoded preimage, used to compute hashlock if hashlock is empty.")
+	c.cmd.Flags().Int64Var(&c.timeout, "timeout", 0, "block height after which the sender could refund.")
+	c.cmd.Flags().StringVar(&c.fee, "fee", "0", "The fee to lock.")
+}
+
+func (c *HTLCLockCommand) example() string {
+	return `
+xchain-cli htlc lock --receiver bob_address --amount 100 --hashlock sha256_hex --timeout 1000
+`
+}
+
+func (c *HTLCLockCommand) lock(ctx context.Context) error {
+	ct := &CommTrans{
+		Amount:       "0",
+		Fee:          c.fee,
+		FrozenHeight: 0,
+		Version:      utxo.TxVersion,
+
+		MethodName: "Lock",
+		Args:       make(map[string][]byte),
+
+		IsQuick: false,
+
+		ChainName:    c.cli.RootOptions.Name,
+		Keys:         c.cli.RootOptions.Keys,
+		XchainClient: c.cli.XchainClient(),
+		CryptoType:   c.cli.RootOptions.Crypto,
+		RootOptions:  c.cli.RootOptions,
+	}
+
+	var err error
+	ct.To, err = readAddress(ct.Keys)
+	if err != nil {
+		return err
+	}
+
+	if c.hashlock == "" && c.preimage != "" {
+		preimage, err := hex.DecodeString(c.preimage)
+		if err != nil {
+			return fmt.Errorf("preimage should be hex encoded")
+		}
+		c.hashlock = htlc.MakeHashlock(preimage)
+	}
+	if c.receiver == "" || c.hashlock == "" || c.timeout <= 0 {
+		return fmt.Errorf("receiver, hashlock or timeout is invalid")
+	}
+
+	ct.ModuleName = "xkernel"
+	ct.ContractName = htlc.HTLCKernelContract
+	ct.Args["receiver"] = []byte(c.receiver)
+	ct.Args["amount"] = []byte(c.amount)
+	ct.Args["hashlock"] = []byte(c.hashlock)
+	ct.Args["timeout"] = []byte(strconv.FormatInt(c.timeout, 10))
+
+	err = ct.Transfer(ctx)
+	if err != nil {
+		return err
+	}
+	fmt.Println("swap id:", c.hashlock)
+
+	return nil
+}
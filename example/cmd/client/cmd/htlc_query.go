@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/wooyang2018/corechain/contract/htlc"
+)
+
+// HTLCQueryCommand htlc query cmd
+type HTLCQueryCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	swapID string
+}
+
+// NewHTLCQueryCommand new htlc query cmd
+func NewHTLCQueryCommand(cli *Cli) *cobra.Command {
+	c := new(HTLCQueryCommand)
+	c.cli = cli
+	c.cmd = &cobra.Command{
+		Use:   "query",
+		Short: "Query a swap, the preimage is shown once claimed.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.TODO()
+			return c.query(ctx)
+		},
+	}
+	c.addFlags()
+	return c.cmd
+}
+
+func (c *HTLCQueryCommand) addFlags() {
+	c.cmd.Flags().StringVar(&c.swapID, "id", "", "swap id, the same as hashlock.")
+}
+
+func (c *HTLCQueryCommand) query(ctx context.Context) error {
+	ct := &CommTrans{
+		ModuleName:   "xkernel",
+		ContractName: htlc.HTLCKernelContract,
+		MethodName:   "Query",
+		Args:         make(map[string][]byte),
+		Keys:         c.cli.RootOptions.Keys,
+
+		ChainName:    c.cli.RootOptions.Name,
+		XchainClient: c.cli.XchainClient(),
+	}
+
+	if c.swapID == "" {
+		return fmt.Errorf("no swap id found")
+	}
+	ct.Args["swap_id"] = []byte(c.swapID)
+
+	_, _, err := ct.GenPreExeRes(ctx)
+	return err
+}
@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/wooyang2018/corechain/contract/htlc"
+	ltx "github.com/wooyang2018/corechain/ledger/tx"
+	"github.com/wooyang2018/corechain/state/utxo"
+)
+
+// HTLCRefundCommand refund locked tokens after timeout
+type HTLCRefundCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	swapID string
+	fee    string
+}
+
+// NewHTLCRefundCommand new htlc refund cmd
+func NewHTLCRefundCommand(cli *Cli) *cobra.Command {
+	t := new(HTLCRefundCommand)
+	t.cli = cli
+	t.cmd = &cobra.Command{
+		Use:   "refund",
+		Short: "Refund locked tokens after timeout height.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.TODO()
+			return t.refund(ctx)
+		},
+	}
+	t.addFlags()
+
+	return t.cmd
+}
+
+func (c *HTLCRefundCommand) addFlags() {
+	c.cmd.Flags().StringVar(&c.swapID, "id", "", "swap id, the same as hashlock.")
+	c.cmd.Flags().StringVar(&c.fee, "fee", "0", "The fee to refund.")
+}
+
+func (c *HTLCRefundCommand) refund(ctx context.Context) error {
+	if c.swapID == "" {
+		return fmt.Errorf("swap id is nil")
+	}
+
+	// 查询超时高度，退款交易在该高度之前不会被打包
+	swap, err := querySwap(ctx, c.cli, c.swapID)
+	if err != nil {
+		return err
+	}
+
+	ct := &CommTrans{
+		Amount:       "0",
+		Fee:          c.fee,
+		FrozenHeight: 0,
+		Version:      utxo.TxVersion,
+		LockHeight:   swap.Timeout,
+
+		MethodName: "Refund",
+		Args:       make(map[string][]byte),
+
+		IsQuick: false,
+
+		ChainName:    c.cli.RootOptions.Name,
+		Keys:         c.cli.RootOptions.Keys,
+		XchainClient: c.cli.XchainClient(),
+		CryptoType:   c.cli.RootOptions.Crypto,
+		RootOptions:  c.cli.RootOptions,
+	}
+	ct.To, err = readAddress(ct.Keys)
+	if err != nil {
+		return err
+	}
+
+	ct.ModuleName = "xkernel"
+	ct.ContractName = htlc.HTLCKernelContract
+	ct.Args["swap_id"] = []byte(c.swapID)
+	ct.Args[ltx.LockHeightArg] = []byte(strconv.FormatInt(swap.Timeout, 10))
+
+	return ct.Transfer(ctx)
+}
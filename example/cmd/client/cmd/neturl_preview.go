@@ -17,6 +17,7 @@ type NetURLPreviewCommand struct {
 	ipv6 string
 	port string
 	path string
+	quic bool
 }
 
 // NewNetURLPreviewCommand new get neturl cmd
@@ -39,6 +40,7 @@ func (n *NetURLPreviewCommand) addFlags() {
 	n.cmd.Flags().StringVar(&n.ipv6, "ipv6", "", "ipv6 address of the network node")
 	n.cmd.Flags().StringVar(&n.port, "port", "47101", "port of the network node (default is 47101)")
 	n.cmd.Flags().StringVar(&n.path, "path", "./data/netkeys/", "path to save net url (default is ./data/netkeys/)")
+	n.cmd.Flags().BoolVar(&n.quic, "quic", false, "preview net URL of the quic listener, port is the udp port")
 }
 
 func (n *NetURLPreviewCommand) previewNetURL(ctx context.Context) error {
@@ -47,10 +49,14 @@ func (n *NetURLPreviewCommand) previewNetURL(ctx context.Context) error {
 		fmt.Println("Parse net URL from key path failed, err=", err)
 	}
 
+	transport := "tcp/" + n.port
+	if n.quic {
+		transport = "udp/" + n.port + "/quic"
+	}
 	if n.ipv6 != "" {
-		fmt.Printf("/ip6/%s/tcp/%s/network/%s\n", n.ipv6, n.port, pid)
+		fmt.Printf("/ip6/%s/%s/network/%s\n", n.ipv6, transport, pid)
 	} else {
-		fmt.Printf("/ip4/%s/tcp/%s/network/%s\n", n.ip, n.port, pid)
+		fmt.Printf("/ip4/%s/%s/network/%s\n", n.ip, transport, pid)
 	}
 
 	return nil
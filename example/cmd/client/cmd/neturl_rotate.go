@@ -0,0 +1,216 @@
+package cmd
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/spf13/cobra"
+
+	"github.com/wooyang2018/corechain/engine/noderegistry"
+	"github.com/wooyang2018/corechain/network"
+	"github.com/wooyang2018/corechain/network/p2pv2"
+	"github.com/wooyang2018/corechain/state/utxo"
+)
+
+// NetURLRotateCommand neturl rotate cmd
+type NetURLRotateCommand struct {
+	cli      *Cli
+	cmd      *cobra.Command
+	path     string
+	register bool
+	fee      string
+}
+
+// NewNetURLRotateCommand new neturl rotate cmd
+func NewNetURLRotateCommand(cli *Cli) *cobra.Command {
+	n := new(NetURLRotateCommand)
+	n.cli = cli
+	n.cmd = &cobra.Command{
+		Use:     "rotate [options]",
+		Short:   "Rotate net key, old keys are backed up and the new peer id is registered to $node contract.",
+		Example: n.example(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return n.rotate(context.TODO())
+		},
+	}
+	n.addFlags()
+	return n.cmd
+}
+
+func (n *NetURLRotateCommand) addFlags() {
+	n.cmd.Flags().StringVar(&n.path, "path", "./data/netkeys/", "path of net keys to rotate")
+	n.cmd.Flags().BoolVar(&n.register, "register", false, "register the new peer id to $node contract")
+	n.cmd.Flags().StringVar(&n.fee, "fee", "0", "The fee to register.")
+}
+
+func (n *NetURLRotateCommand) example() string {
+	return `
+xchain-cli netURL rotate --path ./data/netkeys/ --register
+`
+}
+
+func (n *NetURLRotateCommand) rotate(ctx context.Context) error {
+	backup, err := n.backup()
+	if err != nil {
+		return err
+	}
+	fmt.Println("old net keys backup:", backup)
+
+	if err := network.GenerateKeyPairWithPath(n.path); err != nil {
+		return err
+	}
+	if err := network.GeneratePemKeyFromNetKey(n.path); err != nil {
+		return err
+	}
+	peerID, err := network.GetPeerIDFromPath(n.path)
+	if err != nil {
+		return err
+	}
+	fmt.Println("new peer id:", peerID)
+
+	// 开启tls时需要用新私钥重新签发证书
+	if _, err := os.Stat(filepath.Join(backup, "cert.pem")); err == nil {
+		if err := n.genCSR(backup); err != nil {
+			return err
+		}
+		fmt.Println("new cert request:", filepath.Join(n.path, "cert.csr"))
+	}
+
+	if !n.register {
+		return nil
+	}
+	args, err := n.registerArgs(peerID)
+	if err != nil {
+		return err
+	}
+	ct := &CommTrans{
+		Amount:       "0",
+		Fee:          n.fee,
+		FrozenHeight: 0,
+		Version:      utxo.TxVersion,
+
+		ModuleName:   "xkernel",
+		ContractName: "$node",
+		MethodName:   "register",
+		Args:         args,
+
+		IsQuick: false,
+
+		ChainName:    n.cli.RootOptions.Name,
+		Keys:         n.cli.RootOptions.Keys,
+		XchainClient: n.cli.XchainClient(),
+		CryptoType:   n.cli.RootOptions.Crypto,
+		RootOptions:  n.cli.RootOptions,
+	}
+	ct.To, err = readAddress(ct.Keys)
+	if err != nil {
+		return err
+	}
+	return ct.Transfer(ctx)
+}
+
+// registerArgs 用新的节点私钥对账户和节点ID签名，证明持有该节点ID
+func (n *NetURLRotateCommand) registerArgs(peerID string) (map[string][]byte, error) {
+	account, err := readAddress(n.cli.RootOptions.Keys)
+	if err != nil {
+		return nil, err
+	}
+	priv, err := network.GetKeyPairFromPath(n.path)
+	if err != nil {
+		return nil, err
+	}
+	pubKey, err := crypto.MarshalPublicKey(priv.GetPublic())
+	if err != nil {
+		return nil, err
+	}
+	sign, err := priv.Sign(noderegistry.RegisterMessage(account, peerID))
+	if err != nil {
+		return nil, err
+	}
+	return map[string][]byte{
+		"peer_id": []byte(peerID),
+		"pubkey":  pubKey,
+		"sign":    sign,
+	}, nil
+}
+
+// backup 将旧密钥和证书复制到带时间戳的子目录
+func (n *NetURLRotateCommand) backup() (string, error) {
+	files, err := ioutil.ReadDir(n.path)
+	if err != nil {
+		return "", err
+	}
+	backup := filepath.Join(n.path, "backup", time.Now().Format("20060102150405"))
+	if err := os.MkdirAll(backup, 0777); err != nil {
+		return "", err
+	}
+	for _, f := range files {
+		if f.IsDir() {
+			continue
+		}
+		data, err := ioutil.ReadFile(filepath.Join(n.path, f.Name()))
+		if err != nil {
+			return "", err
+		}
+		if err := ioutil.WriteFile(filepath.Join(backup, f.Name()), data, f.Mode()); err != nil {
+			return "", err
+		}
+	}
+	return backup, nil
+}
+
+// genCSR 沿用旧证书的subject，并以URI携带账户，供准入检查使用
+func (n *NetURLRotateCommand) genCSR(backup string) error {
+	certData, err := ioutil.ReadFile(filepath.Join(backup, "cert.pem"))
+	if err != nil {
+		return err
+	}
+	block, _ := pem.Decode(certData)
+	if block == nil {
+		return errors.New("decode cert.pem error")
+	}
+	oldCert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return err
+	}
+
+	keyData, err := ioutil.ReadFile(filepath.Join(n.path, "private.key"))
+	if err != nil {
+		return err
+	}
+	block, _ = pem.Decode(keyData)
+	if block == nil {
+		return errors.New("decode private.key error")
+	}
+	priv, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	if err != nil {
+		return err
+	}
+
+	tmpl := &x509.CertificateRequest{
+		Subject:  oldCert.Subject,
+		DNSNames: oldCert.DNSNames,
+		URIs:     oldCert.URIs,
+	}
+	if p2pv2.CertAccount(oldCert) == "" {
+		if address, err := readAddress(n.cli.RootOptions.Keys); err == nil {
+			tmpl.URIs = append(tmpl.URIs, &url.URL{Scheme: p2pv2.AccountURIScheme, Opaque: address})
+		}
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, tmpl, priv)
+	if err != nil {
+		return err
+	}
+	csrData := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr})
+	return ioutil.WriteFile(filepath.Join(n.path, "cert.csr"), csrData, 0600)
+}
@@ -0,0 +1,34 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+const (
+	paraChainKernelContract = "$parachain"
+)
+
+// ParaChainCommand parachain lifecycle cmd entrance
+type ParaChainCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+}
+
+// NewParaChainCommand new parachain cmd
+func NewParaChainCommand(cli *Cli) *cobra.Command {
+	c := new(ParaChainCommand)
+	c.cli = cli
+	c.cmd = &cobra.Command{
+		Use:   "parachain",
+		Short: "parachain: pause|resume|archive|audit.",
+	}
+	c.cmd.AddCommand(NewParaChainStatusCommand(cli, "pause", "pauseChain", "Pause mining of a parachain, queries are still served."))
+	c.cmd.AddCommand(NewParaChainStatusCommand(cli, "resume", "resumeChain", "Resume mining of a paused parachain."))
+	c.cmd.AddCommand(NewParaChainStatusCommand(cli, "archive", "archiveChain", "Unload a parachain and move its data directory to archive."))
+	c.cmd.AddCommand(NewParaChainAuditCommand(cli))
+	return c.cmd
+}
+
+func init() {
+	AddCommand(NewParaChainCommand)
+}
@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// ParaChainAuditCommand query the group change records of a parachain
+type ParaChainAuditCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	bcName string
+}
+
+// NewParaChainAuditCommand new parachain audit cmd
+func NewParaChainAuditCommand(cli *Cli) *cobra.Command {
+	c := new(ParaChainAuditCommand)
+	c.cli = cli
+	c.cmd = &cobra.Command{
+		Use:   "audit",
+		Short: "Query the membership and status change records of a parachain.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.TODO()
+			return c.query(ctx)
+		},
+	}
+	c.addFlags()
+	return c.cmd
+}
+
+func (c *ParaChainAuditCommand) addFlags() {
+	c.cmd.Flags().StringVar(&c.bcName, "chain", "", "parachain name.")
+}
+
+func (c *ParaChainAuditCommand) query(ctx context.Context) error {
+	ct := &CommTrans{
+		ModuleName:   "xkernel",
+		ContractName: paraChainKernelContract,
+		MethodName:   "getGroupAudit",
+		Args:         make(map[string][]byte),
+		Keys:         c.cli.RootOptions.Keys,
+
+		ChainName:    c.cli.RootOptions.Name,
+		XchainClient: c.cli.XchainClient(),
+	}
+
+	if c.bcName == "" {
+		return fmt.Errorf("parachain name is empty")
+	}
+	ct.Args["name"] = []byte(c.bcName)
+
+	_, _, err := ct.GenPreExeRes(ctx)
+	return err
+}
@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/wooyang2018/corechain/state/utxo"
+)
+
+// ParaChainStatusCommand change the status of a parachain, must be sent to the root chain
+type ParaChainStatusCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	method string
+	bcName string
+	fee    string
+}
+
+// NewParaChainStatusCommand new parachain status cmd
+func NewParaChainStatusCommand(cli *Cli, use, method, short string) *cobra.Command {
+	c := new(ParaChainStatusCommand)
+	c.cli = cli
+	c.method = method
+	c.cmd = &cobra.Command{
+		Use:   use,
+		Short: short,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.TODO()
+			return c.invoke(ctx)
+		},
+	}
+	c.addFlags()
+	return c.cmd
+}
+
+func (c *ParaChainStatusCommand) addFlags() {
+	c.cmd.Flags().StringVar(&c.bcName, "chain", "", "parachain name.")
+	c.cmd.Flags().StringVar(&c.fee, "fee", "0", "The fee to change the parachain status.")
+}
+
+func (c *ParaChainStatusCommand) invoke(ctx context.Context) error {
+	ct := &CommTrans{
+		Amount:       "0",
+		Fee:          c.fee,
+		FrozenHeight: 0,
+		Version:      utxo.TxVersion,
+
+		ModuleName:   "xkernel",
+		ContractName: paraChainKernelContract,
+		MethodName:   c.method,
+		Args:         make(map[string][]byte),
+
+		IsQuick: false,
+
+		ChainName:    c.cli.RootOptions.Name,
+		Keys:         c.cli.RootOptions.Keys,
+		XchainClient: c.cli.XchainClient(),
+		CryptoType:   c.cli.RootOptions.Crypto,
+		RootOptions:  c.cli.RootOptions,
+	}
+
+	var err error
+	ct.To, err = readAddress(ct.Keys)
+	if err != nil {
+		return err
+	}
+
+	if c.bcName == "" {
+		return fmt.Errorf("parachain name is empty")
+	}
+	ct.Args["name"] = []byte(c.bcName)
+
+	return ct.Transfer(ctx)
+}
@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+
+	cryptoClient "github.com/wooyang2018/corechain/crypto/client"
+	"github.com/wooyang2018/corechain/example/pb"
+	exampleUtils "github.com/wooyang2018/corechain/example/utils"
+)
+
+// PrivateCommand private tx cmd entrance
+type PrivateCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+}
+
+// NewPrivateCommand new private tx cmd
+func NewPrivateCommand(cli *Cli) *cobra.Command {
+	c := new(PrivateCommand)
+	c.cli = cli
+	c.cmd = &cobra.Command{
+		Use:   "private",
+		Short: "private: send|query.",
+	}
+	c.cmd.AddCommand(NewPrivateSendCommand(cli))
+	c.cmd.AddCommand(NewPrivateQueryCommand(cli))
+	return c.cmd
+}
+
+func init() {
+	AddCommand(NewPrivateCommand)
+}
+
+// signPrivateTxRequest 用成员账户私钥对请求签名，节点据此校验请求方身份
+func signPrivateTxRequest(cli *Cli, req *pb.PrivateTxRequest) error {
+	keys := cli.RootOptions.Keys
+	initiator, err := readAddress(keys)
+	if err != nil {
+		return err
+	}
+	pubKey, err := readPublicKey(keys)
+	if err != nil {
+		return err
+	}
+	scrKey, err := readPrivateKey(keys)
+	if err != nil {
+		return err
+	}
+	crypto, err := cryptoClient.CreateCryptoClient(cli.RootOptions.Crypto)
+	if err != nil {
+		return err
+	}
+	privateKey, err := crypto.GetEcdsaPrivateKeyFromJsonStr(scrKey)
+	if err != nil {
+		return err
+	}
+
+	req.Initiator = initiator
+	req.Publickey = pubKey
+	req.Timestamp = time.Now().UnixNano()
+	digest, err := exampleUtils.PrivateTxRequestDigest(req)
+	if err != nil {
+		return err
+	}
+	req.UserSign, err = crypto.SignECDSA(privateKey, digest)
+	return err
+}
@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/wooyang2018/corechain/common/utils"
+	"github.com/wooyang2018/corechain/example/pb"
+)
+
+// PrivateQueryCommand query private tx from the connected member node
+type PrivateQueryCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	hash string
+}
+
+// NewPrivateQueryCommand new private query cmd
+func NewPrivateQueryCommand(cli *Cli) *cobra.Command {
+	c := new(PrivateQueryCommand)
+	c.cli = cli
+	c.cmd = &cobra.Command{
+		Use:   "query",
+		Short: "Query a private tx, only works when the connected node is a member.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.TODO()
+			return c.query(ctx)
+		},
+	}
+	c.addFlags()
+	return c.cmd
+}
+
+func (c *PrivateQueryCommand) addFlags() {
+	c.cmd.Flags().StringVar(&c.hash, "hash", "", "hex encoded private tx hash.")
+}
+
+func (c *PrivateQueryCommand) query(ctx context.Context) error {
+	hash, err := hex.DecodeString(c.hash)
+	if err != nil || len(hash) == 0 {
+		return fmt.Errorf("hash should be hex encoded")
+	}
+
+	req := &pb.PrivateTxRequest{
+		Header: &pb.Header{
+			Logid: utils.GenLogId(),
+		},
+		Bcname: c.cli.RootOptions.Name,
+		Hash:   hash,
+	}
+	if err := signPrivateTxRequest(c.cli, req); err != nil {
+		return err
+	}
+	reply, err := c.cli.XchainClient().QueryPrivateTx(ctx, req)
+	if err != nil {
+		return err
+	}
+	if reply.GetHeader().GetError() != pb.XChainErrorEnum_SUCCESS {
+		return errors.New(reply.Header.Error.String())
+	}
+
+	type request struct {
+		Module   string            `json:"module"`
+		Contract string            `json:"contract"`
+		Method   string            `json:"method"`
+		Args     map[string]string `json:"args"`
+	}
+	type output struct {
+		Bucket string `json:"bucket"`
+		Key    string `json:"key"`
+		Value  string `json:"value"`
+	}
+	result := struct {
+		Members  []string  `json:"members"`
+		Requests []request `json:"requests"`
+		Outputs  []output  `json:"outputs"`
+		Result   string    `json:"result"`
+	}{Members: reply.GetMembers(), Result: reply.GetResult()}
+	for _, r := range reply.GetRequests() {
+		args := make(map[string]string, len(r.GetArgs()))
+		for k, v := range r.GetArgs() {
+			args[k] = string(v)
+		}
+		result.Requests = append(result.Requests, request{r.GetModuleName(), r.GetContractName(), r.GetMethodName(), args})
+	}
+	for _, o := range reply.GetOutputs() {
+		result.Outputs = append(result.Outputs, output{o.GetBucket(), string(o.GetKey()), string(o.GetValue())})
+	}
+
+	buf, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(buf))
+	return nil
+}
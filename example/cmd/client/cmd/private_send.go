@@ -0,0 +1,169 @@
+package cmd
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/wooyang2018/corechain/common/utils"
+	cryptoClient "github.com/wooyang2018/corechain/crypto/client"
+	"github.com/wooyang2018/corechain/example/pb"
+	"github.com/wooyang2018/corechain/state/utxo"
+)
+
+// PrivateSendCommand send contract call to members privately and commit its hash
+type PrivateSendCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	members  string
+	module   string
+	contract string
+	method   string
+	args     string
+	commit   bool
+	fee      string
+}
+
+// NewPrivateSendCommand new private send cmd
+func NewPrivateSendCommand(cli *Cli) *cobra.Command {
+	t := new(PrivateSendCommand)
+	t.cli = cli
+	t.cmd = &cobra.Command{
+		Use:     "send",
+		Short:   "Send contract args to member nodes encrypted, only the hash is committed on chain.",
+		Example: t.example(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.TODO()
+			return t.send(ctx)
+		},
+	}
+	t.addFlags()
+
+	return t.cmd
+}
+
+func (c *PrivateSendCommand) addFlags() {
+	c.cmd.Flags().StringVar(&c.members, "members", "", "comma separated node addresses of members.")
+	c.cmd.Flags().StringVar(&c.module, "module", "wasm", "contract module name.")
+	c.cmd.Flags().StringVar(&c.contract, "contract", "", "contract name.")
+	c.cmd.Flags().StringVar(&c.method, "method", "invoke", "contract method name.")
+	c.cmd.Flags().StringVarP(&c.args, "args", "a", "{}", "contract method args in json format.")
+	c.cmd.Flags().BoolVar(&c.commit, "commit", true, "commit the hash to $privacy contract.")
+	c.cmd.Flags().StringVar(&c.fee, "fee", "0", "The fee to commit.")
+}
+
+func (c *PrivateSendCommand) example() string {
+	return `
+xchain-cli private send --members addr1,addr2 --contract counter --method increase -a '{"key":"k1"}'
+`
+}
+
+func (c *PrivateSendCommand) send(ctx context.Context) error {
+	if c.members == "" || c.contract == "" {
+		return fmt.Errorf("members or contract is empty")
+	}
+	args := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(c.args), &args); err != nil {
+		return err
+	}
+	invokeArgs, err := convertToXuper3Args(args)
+	if err != nil {
+		return err
+	}
+
+	req := &pb.PrivateTxRequest{
+		Header: &pb.Header{
+			Logid: utils.GenLogId(),
+		},
+		Bcname:  c.cli.RootOptions.Name,
+		Members: strings.Split(c.members, ","),
+		Requests: []*pb.InvokeRequest{
+			{
+				ModuleName:   c.module,
+				ContractName: c.contract,
+				MethodName:   c.method,
+				Args:         invokeArgs,
+			},
+		},
+	}
+	if err := signPrivateTxRequest(c.cli, req); err != nil {
+		return err
+	}
+	reply, err := c.cli.XchainClient().SendPrivateTx(ctx, req)
+	if err != nil {
+		return err
+	}
+	if reply.GetHeader().GetError() != pb.XChainErrorEnum_SUCCESS {
+		return errors.New(reply.Header.Error.String())
+	}
+	hash := hex.EncodeToString(reply.GetHash())
+	fmt.Println("private tx hash:", hash)
+	if !c.commit {
+		return nil
+	}
+	commitArgs, err := c.commitArgs(reply.GetHash())
+	if err != nil {
+		return err
+	}
+
+	ct := &CommTrans{
+		Amount:       "0",
+		Fee:          c.fee,
+		FrozenHeight: 0,
+		Version:      utxo.TxVersion,
+
+		ModuleName:   "xkernel",
+		ContractName: "$privacy",
+		MethodName:   "commit",
+		Args:         commitArgs,
+
+		IsQuick: false,
+
+		ChainName:    c.cli.RootOptions.Name,
+		Keys:         c.cli.RootOptions.Keys,
+		XchainClient: c.cli.XchainClient(),
+		CryptoType:   c.cli.RootOptions.Crypto,
+		RootOptions:  c.cli.RootOptions,
+	}
+	ct.To, err = readAddress(ct.Keys)
+	if err != nil {
+		return err
+	}
+
+	return ct.Transfer(ctx)
+}
+
+// commitArgs 提交hash时附带发送方对hash的签名，发送方即当前账户
+func (c *PrivateSendCommand) commitArgs(hash []byte) (map[string][]byte, error) {
+	pubKey, err := readPublicKey(c.cli.RootOptions.Keys)
+	if err != nil {
+		return nil, err
+	}
+	scrKey, err := readPrivateKey(c.cli.RootOptions.Keys)
+	if err != nil {
+		return nil, err
+	}
+	crypto, err := cryptoClient.CreateCryptoClient(c.cli.RootOptions.Crypto)
+	if err != nil {
+		return nil, err
+	}
+	privateKey, err := crypto.GetEcdsaPrivateKeyFromJsonStr(scrKey)
+	if err != nil {
+		return nil, err
+	}
+	sign, err := crypto.SignECDSA(privateKey, hash)
+	if err != nil {
+		return nil, err
+	}
+	return map[string][]byte{
+		"hash":   []byte(hex.EncodeToString(hash)),
+		"pubkey": []byte(pubKey),
+		"sign":   sign,
+	}, nil
+}
@@ -16,12 +16,14 @@ func NewProposalCommand(cli *Cli) *cobra.Command {
 	c.cli = cli
 	c.cmd = &cobra.Command{
 		Use:   "proposal",
-		Short: "proposal: propose|vote|thaw|query.",
+		Short: "proposal: propose|vote|thaw|query|delegate|undelegate.",
 	}
 	c.cmd.AddCommand(NewProposalProposeCommand(cli))
 	c.cmd.AddCommand(NewProposalQueryCommand(cli))
 	c.cmd.AddCommand(NewProposalVoteCommand(cli))
 	c.cmd.AddCommand(NewProposalThawCommand(cli))
+	c.cmd.AddCommand(NewProposalDelegateCommand(cli, "delegate", "Delegate governance voting power to another account."))
+	c.cmd.AddCommand(NewProposalDelegateCommand(cli, "undelegate", "Revoke the governance delegation."))
 	return c.cmd
 }
 
@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/wooyang2018/corechain/state/utxo"
+)
+
+// ProposalDelegateCommand delegate or undelegate governance voting power
+type ProposalDelegateCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	undelegate bool
+	delegate   string
+	fee        string
+}
+
+// NewProposalDelegateCommand new proposal delegate or undelegate cmd
+func NewProposalDelegateCommand(cli *Cli, use, short string) *cobra.Command {
+	t := new(ProposalDelegateCommand)
+	t.cli = cli
+	t.undelegate = use == "undelegate"
+	t.cmd = &cobra.Command{
+		Use:   use,
+		Short: short,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.TODO()
+			return t.invoke(ctx)
+		},
+	}
+	t.addFlags()
+
+	return t.cmd
+}
+
+func (c *ProposalDelegateCommand) addFlags() {
+	if !c.undelegate {
+		c.cmd.Flags().StringVar(&c.delegate, "delegate", "", "the account to vote on behalf of you.")
+	}
+	c.cmd.Flags().StringVar(&c.fee, "fee", "0", "The fee to change the delegation.")
+}
+
+func (c *ProposalDelegateCommand) invoke(ctx context.Context) error {
+	ct := &CommTrans{
+		Amount:       "0",
+		Fee:          c.fee,
+		FrozenHeight: 0,
+		Version:      utxo.TxVersion,
+
+		MethodName: "Delegate",
+		Args:       make(map[string][]byte),
+
+		IsQuick: false,
+
+		ChainName:    c.cli.RootOptions.Name,
+		Keys:         c.cli.RootOptions.Keys,
+		XchainClient: c.cli.XchainClient(),
+		CryptoType:   c.cli.RootOptions.Crypto,
+	}
+
+	var err error
+	ct.To, err = readAddress(ct.Keys)
+	if err != nil {
+		return err
+	}
+
+	if c.undelegate {
+		ct.MethodName = "Undelegate"
+	} else {
+		if c.delegate == "" {
+			return fmt.Errorf("delegate is nil")
+		}
+		ct.Args["delegate"] = []byte(c.delegate)
+	}
+
+	ct.ModuleName = "xkernel"
+	ct.ContractName = "$proposal"
+
+	return ct.Transfer(ctx)
+}
@@ -16,6 +16,8 @@ type ProposalVoteCommand struct {
 
 	proposalID string
 	amount     string
+	option     string
+	delegator  string
 	fee        string
 }
 
@@ -39,6 +41,8 @@ func NewProposalVoteCommand(cli *Cli) *cobra.Command {
 func (c *ProposalVoteCommand) addFlags() {
 	c.cmd.Flags().StringVarP(&c.proposalID, "pid", "p", "", "proposal id.")
 	c.cmd.Flags().StringVar(&c.amount, "amount", "", "amount.")
+	c.cmd.Flags().StringVar(&c.option, "option", "yes", "vote option: yes|no|abstain.")
+	c.cmd.Flags().StringVar(&c.delegator, "delegator", "", "vote on behalf of the delegator, the initiator should be its delegate.")
 	c.cmd.Flags().StringVar(&c.fee, "fee", "0", "The fee to vote a proposal.")
 }
 
@@ -74,6 +78,10 @@ func (c *ProposalVoteCommand) voteProposal(ctx context.Context) error {
 	ct.ContractName = "$proposal"
 	ct.Args["proposal_id"] = []byte(c.proposalID)
 	ct.Args["amount"] = []byte(c.amount)
+	ct.Args["option"] = []byte(c.option)
+	if c.delegator != "" {
+		ct.Args["delegator"] = []byte(c.delegator)
+	}
 
 	err = ct.Transfer(ctx)
 	if err != nil {
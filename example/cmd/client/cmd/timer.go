@@ -0,0 +1,30 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// TimerCommand scheduled task cmd entrance
+type TimerCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+}
+
+// NewTimerCommand new timer cmd
+func NewTimerCommand(cli *Cli) *cobra.Command {
+	c := new(TimerCommand)
+	c.cli = cli
+	c.cmd = &cobra.Command{
+		Use:   "timer",
+		Short: "timer: schedule|cancel|query|runs.",
+	}
+	c.cmd.AddCommand(NewTimerScheduleCommand(cli))
+	c.cmd.AddCommand(NewTimerCancelCommand(cli))
+	c.cmd.AddCommand(NewTimerQueryCommand(cli, "query", "QueryTask", "Query a scheduled task."))
+	c.cmd.AddCommand(NewTimerQueryCommand(cli, "runs", "QueryRuns", "Query the result of each run of a scheduled task."))
+	return c.cmd
+}
+
+func init() {
+	AddCommand(NewTimerCommand)
+}
@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/wooyang2018/corechain/contract/proposal/utils"
+	"github.com/wooyang2018/corechain/state/utxo"
+)
+
+// TimerCancelCommand cancel a scheduled task and refund the escrow
+type TimerCancelCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	taskID string
+	fee    string
+}
+
+// NewTimerCancelCommand new timer cancel cmd
+func NewTimerCancelCommand(cli *Cli) *cobra.Command {
+	t := new(TimerCancelCommand)
+	t.cli = cli
+	t.cmd = &cobra.Command{
+		Use:   "cancel",
+		Short: "Cancel a scheduled task and refund the remaining escrow.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.TODO()
+			return t.cancel(ctx)
+		},
+	}
+	t.addFlags()
+
+	return t.cmd
+}
+
+func (c *TimerCancelCommand) addFlags() {
+	c.cmd.Flags().StringVar(&c.taskID, "id", "", "task id.")
+	c.cmd.Flags().StringVar(&c.fee, "fee", "0", "The fee to cancel.")
+}
+
+func (c *TimerCancelCommand) cancel(ctx context.Context) error {
+	ct := &CommTrans{
+		Amount:       "0",
+		Fee:          c.fee,
+		FrozenHeight: 0,
+		Version:      utxo.TxVersion,
+
+		MethodName: "Cancel",
+		Args:       make(map[string][]byte),
+
+		IsQuick: false,
+
+		ChainName:    c.cli.RootOptions.Name,
+		Keys:         c.cli.RootOptions.Keys,
+		XchainClient: c.cli.XchainClient(),
+		CryptoType:   c.cli.RootOptions.Crypto,
+		RootOptions:  c.cli.RootOptions,
+	}
+
+	var err error
+	ct.To, err = readAddress(ct.Keys)
+	if err != nil {
+		return err
+	}
+
+	if c.taskID == "" {
+		return fmt.Errorf("no task id found")
+	}
+
+	ct.ModuleName = "xkernel"
+	ct.ContractName = utils.TimerTaskKernelContract
+	ct.Args["task_id"] = []byte(c.taskID)
+
+	return ct.Transfer(ctx)
+}
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/wooyang2018/corechain/contract/proposal/utils"
+)
+
+// TimerQueryCommand timer query cmd
+type TimerQueryCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	method string
+	taskID string
+}
+
+// NewTimerQueryCommand new timer query cmd
+func NewTimerQueryCommand(cli *Cli, use, method, short string) *cobra.Command {
+	c := new(TimerQueryCommand)
+	c.cli = cli
+	c.method = method
+	c.cmd = &cobra.Command{
+		Use:   use,
+		Short: short,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.TODO()
+			return c.query(ctx)
+		},
+	}
+	c.addFlags()
+	return c.cmd
+}
+
+func (c *TimerQueryCommand) addFlags() {
+	c.cmd.Flags().StringVar(&c.taskID, "id", "", "task id.")
+}
+
+func (c *TimerQueryCommand) query(ctx context.Context) error {
+	ct := &CommTrans{
+		ModuleName:   "xkernel",
+		ContractName: utils.TimerTaskKernelContract,
+		MethodName:   c.method,
+		Args:         make(map[string][]byte),
+		Keys:         c.cli.RootOptions.Keys,
+
+		ChainName:    c.cli.RootOptions.Name,
+		XchainClient: c.cli.XchainClient(),
+	}
+
+	if c.taskID == "" {
+		return fmt.Errorf("no task id found")
+	}
+	ct.Args["task_id"] = []byte(c.taskID)
+
+	_, _, err := ct.GenPreExeRes(ctx)
+	return err
+}
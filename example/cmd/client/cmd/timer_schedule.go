@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/wooyang2018/corechain/contract/proposal/utils"
+	"github.com/wooyang2018/corechain/state/utxo"
+)
+
+// TimerScheduleCommand schedule a recurring contract call
+type TimerScheduleCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	module          string
+	contract        string
+	method          string
+	args            string
+	intervalBlocks  int64
+	intervalSeconds int64
+	startHeight     int64
+	startTime       int64
+	maxRuns         int64
+	feePerRun       string
+	amount          string
+	fee             string
+}
+
+// NewTimerScheduleCommand new timer schedule cmd
+func NewTimerScheduleCommand(cli *Cli) *cobra.Command {
+	t := new(TimerScheduleCommand)
+	t.cli = cli
+	t.cmd = &cobra.Command{
+		Use:     "schedule",
+		Short:   "Schedule a recurring contract call, the amount is escrowed to pay fee_per_run for each run.",
+		Example: t.example(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.TODO()
+			return t.schedule(ctx)
+		},
+	}
+	t.addFlags()
+
+	return t.cmd
+}
+
+func (c *TimerScheduleCommand) addFlags() {
+	c.cmd.Flags().StringVar(&c.module, "module", "wasm", "contract module, kernel contracts are not allowed.")
+	c.cmd.Flags().StringVar(&c.contract, "contract", "", "contract name.")
+	c.cmd.Flags().StringVar(&c.method, "method", "", "contract method.")
+	c.cmd.Flags().StringVarP(&c.args, "args", "a", "", "contract method args, a json string.")
+	c.cmd.Flags().Int64Var(&c.intervalBlocks, "interval-blocks", 0, "run every N blocks.")
+	c.cmd.Flags().Int64Var(&c.intervalSeconds, "interval-seconds", 0, "run every N seconds by block time.")
+	c.cmd.Flags().Int64Var(&c.startHeight, "start-height", 0, "first run height, used with interval-blocks.")
+	c.cmd.Flags().Int64Var(&c.startTime, "start-time", 0, "first run unix time, used with interval-seconds.")
+	c.cmd.Flags().Int64Var(&c.maxRuns, "max-runs", 0, "max run times, 0 means unlimited.")
+	c.cmd.Flags().StringVar(&c.feePerRun, "fee-per-run", "100", "fee charged from the escrow for each run, no less than 100.")
+	c.cmd.Flags().StringVar(&c.amount, "amount", "0", "amount to escrow.")
+	c.cmd.Flags().StringVar(&c.fee, "fee", "0", "The fee to schedule.")
+}
+
+func (c *TimerScheduleCommand) example() string {
+	return `
+xchain-cli timer schedule --contract settle --method run -a '{"period":"day"}' --interval-blocks 100 --fee-per-run 100 --amount 1000
+`
+}
+
+func (c *TimerScheduleCommand) schedule(ctx context.Context) error {
+	ct := &CommTrans{
+		Amount:       "0",
+		Fee:          c.fee,
+		FrozenHeight: 0,
+		Version:      utxo.TxVersion,
+
+		MethodName: "Schedule",
+		Args:       make(map[string][]byte),
+
+		IsQuick: false,
+
+		ChainName:    c.cli.RootOptions.Name,
+		Keys:         c.cli.RootOptions.Keys,
+		XchainClient: c.cli.XchainClient(),
+		CryptoType:   c.cli.RootOptions.Crypto,
+		RootOptions:  c.cli.RootOptions,
+	}
+
+	var err error
+	ct.To, err = readAddress(ct.Keys)
+	if err != nil {
+		return err
+	}
+
+	if c.contract == "" || c.method == "" {
+		return fmt.Errorf("contract or method is nil")
+	}
+	if (c.intervalBlocks > 0) == (c.intervalSeconds > 0) {
+		return fmt.Errorf("exactly one of interval-blocks and interval-seconds should be set")
+	}
+
+	ct.ModuleName = "xkernel"
+	ct.ContractName = utils.TimerTaskKernelContract
+	ct.Args["module"] = []byte(c.module)
+	ct.Args["contract"] = []byte(c.contract)
+	ct.Args["method"] = []byte(c.method)
+	if c.args != "" {
+		ct.Args["args"] = []byte(c.args)
+	}
+	ct.Args["interval_blocks"] = []byte(strconv.FormatInt(c.intervalBlocks, 10))
+	ct.Args["interval_seconds"] = []byte(strconv.FormatInt(c.intervalSeconds, 10))
+	ct.Args["start_height"] = []byte(strconv.FormatInt(c.startHeight, 10))
+	ct.Args["start_time"] = []byte(strconv.FormatInt(c.startTime, 10))
+	ct.Args["max_runs"] = []byte(strconv.FormatInt(c.maxRuns, 10))
+	ct.Args["fee_per_run"] = []byte(c.feePerRun)
+	ct.Args["amount"] = []byte(c.amount)
+
+	return ct.Transfer(ctx)
+}
@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/wooyang2018/corechain/contract/token"
+	"github.com/wooyang2018/corechain/state/utxo"
+)
+
+// TokenCommand multi-asset token contract cmd entrance
+type TokenCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+}
+
+// NewTokenCommand new token cmd
+func NewTokenCommand(cli *Cli) *cobra.Command {
+	c := new(TokenCommand)
+	c.cli = cli
+	c.cmd = &cobra.Command{
+		Use:   "token",
+		Short: "token: create|mint|burn|transfer|approve|query.",
+	}
+	c.cmd.AddCommand(NewTokenCreateCommand(cli))
+	c.cmd.AddCommand(NewTokenMintCommand(cli))
+	c.cmd.AddCommand(NewTokenBurnCommand(cli))
+	c.cmd.AddCommand(NewTokenTransferCommand(cli))
+	c.cmd.AddCommand(NewTokenApproveCommand(cli))
+	c.cmd.AddCommand(NewTokenQueryCommand(cli))
+	return c.cmd
+}
+
+func init() {
+	AddCommand(NewTokenCommand)
+}
+
+// newTokenTrans 构造调用$token合约的交易
+func newTokenTrans(cli *Cli, method, fee string) (*CommTrans, error) {
+	ct := &CommTrans{
+		Amount:       "0",
+		Fee:          fee,
+		FrozenHeight: 0,
+		Version:      utxo.TxVersion,
+
+		MethodName: method,
+		Args:       make(map[string][]byte),
+
+		IsQuick: false,
+
+		ChainName:    cli.RootOptions.Name,
+		Keys:         cli.RootOptions.Keys,
+		XchainClient: cli.XchainClient(),
+		CryptoType:   cli.RootOptions.Crypto,
+		RootOptions:  cli.RootOptions,
+	}
+
+	var err error
+	ct.To, err = readAddress(ct.Keys)
+	if err != nil {
+		return nil, err
+	}
+	ct.ModuleName = "xkernel"
+	ct.ContractName = token.TokenKernelContract
+	return ct, nil
+}
@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// TokenApproveCommand approve spender to transfer from the initiator
+type TokenApproveCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	symbol  string
+	spender string
+	amount  string
+	fee     string
+}
+
+// NewTokenApproveCommand new token approve cmd
+func NewTokenApproveCommand(cli *Cli) *cobra.Command {
+	t := new(TokenApproveCommand)
+	t.cli = cli
+	t.cmd = &cobra.Command{
+		Use:   "approve",
+		Short: "Approve spender to transfer asset of the initiator, overwrite the previous allowance.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.TODO()
+			return t.approve(ctx)
+		},
+	}
+	t.addFlags()
+
+	return t.cmd
+}
+
+func (c *TokenApproveCommand) addFlags() {
+	c.cmd.Flags().StringVar(&c.symbol, "symbol", "", "asset symbol.")
+	c.cmd.Flags().StringVar(&c.spender, "spender", "", "spender who could transfer from the initiator.")
+	c.cmd.Flags().StringVar(&c.amount, "amount", "0", "allowance of the spender.")
+	c.cmd.Flags().StringVar(&c.fee, "fee", "100", "The fee to approve.")
+}
+
+func (c *TokenApproveCommand) approve(ctx context.Context) error {
+	if c.symbol == "" || c.spender == "" {
+		return fmt.Errorf("symbol or spender is empty")
+	}
+	ct, err := newTokenTrans(c.cli, "Approve", c.fee)
+	if err != nil {
+		return err
+	}
+
+	ct.Args["symbol"] = []byte(c.symbol)
+	ct.Args["spender"] = []byte(c.spender)
+	ct.Args["amount"] = []byte(c.amount)
+
+	return ct.Transfer(ctx)
+}
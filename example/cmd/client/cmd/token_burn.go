@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// TokenBurnCommand burn asset of the initiator
+type TokenBurnCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	symbol string
+	amount string
+	fee    string
+}
+
+// NewTokenBurnCommand new token burn cmd
+func NewTokenBurnCommand(cli *Cli) *cobra.Command {
+	t := new(TokenBurnCommand)
+	t.cli = cli
+	t.cmd = &cobra.Command{
+		Use:   "burn",
+		Short: "Burn asset held by the initiator.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.TODO()
+			return t.burn(ctx)
+		},
+	}
+	t.addFlags()
+
+	return t.cmd
+}
+
+func (c *TokenBurnCommand) addFlags() {
+	c.cmd.Flags().StringVar(&c.symbol, "symbol", "", "asset symbol.")
+	c.cmd.Flags().StringVar(&c.amount, "amount", "0", "amount to burn.")
+	c.cmd.Flags().StringVar(&c.fee, "fee", "100", "The fee to burn.")
+}
+
+func (c *TokenBurnCommand) burn(ctx context.Context) error {
+	if c.symbol == "" {
+		return fmt.Errorf("symbol is empty")
+	}
+	ct, err := newTokenTrans(c.cli, "Burn", c.fee)
+	if err != nil {
+		return err
+	}
+
+	ct.Args["symbol"] = []byte(c.symbol)
+	ct.Args["amount"] = []byte(c.amount)
+
+	return ct.Transfer(ctx)
+}
@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// TokenCreateCommand create a new asset
+type TokenCreateCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	symbol    string
+	name      string
+	decimals  uint32
+	supply    string
+	maxSupply string
+	minters   string
+	fee       string
+}
+
+// NewTokenCreateCommand new token create cmd
+func NewTokenCreateCommand(cli *Cli) *cobra.Command {
+	t := new(TokenCreateCommand)
+	t.cli = cli
+	t.cmd = &cobra.Command{
+		Use:     "create",
+		Short:   "Create an asset, the initial supply belongs to the creator.",
+		Example: t.example(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.TODO()
+			return t.create(ctx)
+		},
+	}
+	t.addFlags()
+
+	return t.cmd
+}
+
+func (c *TokenCreateCommand) addFlags() {
+	c.cmd.Flags().StringVar(&c.symbol, "symbol", "", "asset symbol, upper case letters and digits.")
+	c.cmd.Flags().StringVar(&c.name, "name", "", "asset name.")
+	c.cmd.Flags().Uint32Var(&c.decimals, "decimals", 0, "decimals of the asset.")
+	c.cmd.Flags().StringVar(&c.supply, "supply", "0", "initial supply.")
+	c.cmd.Flags().StringVar(&c.maxSupply, "max-supply", "", "max supply, unlimited if empty.")
+	c.cmd.Flags().StringVar(&c.minters, "minters", "", "comma separated accounts who could mint besides the creator.")
+	c.cmd.Flags().StringVar(&c.fee, "fee", "1000", "The fee to create.")
+}
+
+func (c *TokenCreateCommand) example() string {
+	return `
+xchain-cli token create --symbol GOLD --name gold --decimals 8 --supply 100000000 --minters alice_address,bob_address
+`
+}
+
+func (c *TokenCreateCommand) create(ctx context.Context) error {
+	if c.symbol == "" {
+		return fmt.Errorf("symbol is empty")
+	}
+	ct, err := newTokenTrans(c.cli, "Create", c.fee)
+	if err != nil {
+		return err
+	}
+
+	ct.Args["symbol"] = []byte(c.symbol)
+	ct.Args["name"] = []byte(c.name)
+	ct.Args["decimals"] = []byte(strconv.FormatUint(uint64(c.decimals), 10))
+	ct.Args["supply"] = []byte(c.supply)
+	if c.maxSupply != "" {
+		ct.Args["max_supply"] = []byte(c.maxSupply)
+	}
+	if c.minters != "" {
+		mintersBuf, err := json.Marshal(strings.Split(c.minters, ","))
+		if err != nil {
+			return err
+		}
+		ct.Args["minters"] = mintersBuf
+	}
+
+	return ct.Transfer(ctx)
+}
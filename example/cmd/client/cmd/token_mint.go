@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// TokenMintCommand mint asset by the owner or minters
+type TokenMintCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	symbol string
+	to     string
+	amount string
+	fee    string
+}
+
+// NewTokenMintCommand new token mint cmd
+func NewTokenMintCommand(cli *Cli) *cobra.Command {
+	t := new(TokenMintCommand)
+	t.cli = cli
+	t.cmd = &cobra.Command{
+		Use:   "mint",
+		Short: "Mint asset, only the owner or minters could mint.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.TODO()
+			return t.mint(ctx)
+		},
+	}
+	t.addFlags()
+
+	return t.cmd
+}
+
+func (c *TokenMintCommand) addFlags() {
+	c.cmd.Flags().StringVar(&c.symbol, "symbol", "", "asset symbol.")
+	c.cmd.Flags().StringVar(&c.to, "to", "", "receiver, the initiator if empty.")
+	c.cmd.Flags().StringVar(&c.amount, "amount", "0", "amount to mint.")
+	c.cmd.Flags().StringVar(&c.fee, "fee", "100", "The fee to mint.")
+}
+
+func (c *TokenMintCommand) mint(ctx context.Context) error {
+	if c.symbol == "" {
+		return fmt.Errorf("symbol is empty")
+	}
+	ct, err := newTokenTrans(c.cli, "Mint", c.fee)
+	if err != nil {
+		return err
+	}
+
+	ct.Args["symbol"] = []byte(c.symbol)
+	ct.Args["to"] = []byte(c.to)
+	ct.Args["amount"] = []byte(c.amount)
+
+	return ct.Transfer(ctx)
+}
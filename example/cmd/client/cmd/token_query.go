@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/wooyang2018/corechain/contract/token"
+)
+
+// TokenQueryCommand token query cmd
+type TokenQueryCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	symbol  string
+	account string
+	owner   string
+	spender string
+}
+
+// NewTokenQueryCommand new token query cmd
+func NewTokenQueryCommand(cli *Cli) *cobra.Command {
+	c := new(TokenQueryCommand)
+	c.cli = cli
+	c.cmd = &cobra.Command{
+		Use:     "query",
+		Short:   "Query asset info, balance of an account or allowance of a spender.",
+		Example: c.example(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.TODO()
+			return c.query(ctx)
+		},
+	}
+	c.addFlags()
+	return c.cmd
+}
+
+func (c *TokenQueryCommand) addFlags() {
+	c.cmd.Flags().StringVar(&c.symbol, "symbol", "", "asset symbol.")
+	c.cmd.Flags().StringVar(&c.account, "account", "", "query balance of the account.")
+	c.cmd.Flags().StringVar(&c.owner, "owner", "", "query allowance approved by the owner, used with --spender.")
+	c.cmd.Flags().StringVar(&c.spender, "spender", "", "query allowance of the spender, used with --owner.")
+}
+
+func (c *TokenQueryCommand) example() string {
+	return `
+xchain-cli token query --symbol GOLD
+xchain-cli token query --symbol GOLD --account alice_address
+xchain-cli token query --symbol GOLD --owner alice_address --spender bob_address
+`
+}
+
+func (c *TokenQueryCommand) query(ctx context.Context) error {
+	ct := &CommTrans{
+		ModuleName:   "xkernel",
+		ContractName: token.TokenKernelContract,
+		MethodName:   "QueryAsset",
+		Args:         make(map[string][]byte),
+		Keys:         c.cli.RootOptions.Keys,
+
+		ChainName:    c.cli.RootOptions.Name,
+		XchainClient: c.cli.XchainClient(),
+	}
+
+	if c.symbol == "" {
+		return fmt.Errorf("symbol is empty")
+	}
+	ct.Args["symbol"] = []byte(c.symbol)
+	switch {
+	case c.owner != "" || c.spender != "":
+		if c.owner == "" || c.spender == "" {
+			return fmt.Errorf("owner and spender should be set together")
+		}
+		ct.MethodName = "Allowance"
+		ct.Args["owner"] = []byte(c.owner)
+		ct.Args["spender"] = []byte(c.spender)
+	case c.account != "":
+		ct.MethodName = "BalanceOf"
+		ct.Args["account"] = []byte(c.account)
+	}
+
+	_, _, err := ct.GenPreExeRes(ctx)
+	return err
+}
@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// TokenTransferCommand transfer asset, or transfer from an approved account
+type TokenTransferCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	symbol string
+	from   string
+	to     string
+	amount string
+	fee    string
+}
+
+// NewTokenTransferCommand new token transfer cmd
+func NewTokenTransferCommand(cli *Cli) *cobra.Command {
+	t := new(TokenTransferCommand)
+	t.cli = cli
+	t.cmd = &cobra.Command{
+		Use:     "transfer",
+		Short:   "Transfer asset, use --from to spend the allowance of another account.",
+		Example: t.example(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.TODO()
+			return t.transfer(ctx)
+		},
+	}
+	t.addFlags()
+
+	return t.cmd
+}
+
+func (c *TokenTransferCommand) addFlags() {
+	c.cmd.Flags().StringVar(&c.symbol, "symbol", "", "asset symbol.")
+	c.cmd.Flags().StringVar(&c.from, "from", "", "owner who approved the initiator, the initiator itself if empty.")
+	c.cmd.Flags().StringVar(&c.to, "to", "", "receiver.")
+	c.cmd.Flags().StringVar(&c.amount, "amount", "0", "amount to transfer.")
+	c.cmd.Flags().StringVar(&c.fee, "fee", "100", "The fee to transfer.")
+}
+
+func (c *TokenTransferCommand) example() string {
+	return `
+xchain-cli token transfer --symbol GOLD --to bob_address --amount 100
+xchain-cli token transfer --symbol GOLD --from alice_address --to bob_address --amount 100
+`
+}
+
+func (c *TokenTransferCommand) transfer(ctx context.Context) error {
+	if c.symbol == "" || c.to == "" {
+		return fmt.Errorf("symbol or to is empty")
+	}
+	method := "Transfer"
+	if c.from != "" {
+		method = "TransferFrom"
+	}
+	ct, err := newTokenTrans(c.cli, method, c.fee)
+	if err != nil {
+		return err
+	}
+
+	ct.Args["symbol"] = []byte(c.symbol)
+	ct.Args["to"] = []byte(c.to)
+	ct.Args["amount"] = []byte(c.amount)
+	if c.from != "" {
+		ct.Args["from"] = []byte(c.from)
+	}
+
+	return ct.Transfer(ctx)
+}
@@ -34,6 +34,8 @@ type TransferOptions struct {
 	Fee            string
 	Desc           []byte
 	FrozenHeight   int64
+	LockHeight     int64
+	LockTime       int64
 	Version        int32
 	// 支持账户转账
 	From        string
@@ -51,6 +53,8 @@ type TransferCommand struct {
 	descfile     string
 	fee          string
 	frozenHeight int64
+	lockHeight   int64
+	lockTime     int64
 	version      int32
 	// 支持账户转账
 	from        string
@@ -80,6 +84,8 @@ func (t *TransferCommand) addFlags() {
 	t.cmd.Flags().StringVar(&t.descfile, "desc", "", "desc file of tx, eg. contract or xpos consensus")
 	t.cmd.Flags().StringVar(&t.fee, "fee", "0", "fee of one tx")
 	t.cmd.Flags().Int64Var(&t.frozenHeight, "frozen", 0, "frozen height of one tx")
+	t.cmd.Flags().Int64Var(&t.lockHeight, "lockHeight", 0, "tx is not valid before this block height")
+	t.cmd.Flags().Int64Var(&t.lockTime, "lockTime", 0, "tx is not valid before this unix timestamp in seconds")
 	t.cmd.Flags().Int32Var(&t.version, "txversion", utxo.TxVersion, "tx version")
 	t.cmd.Flags().StringVar(&t.from, "from", "", "account name")
 	t.cmd.Flags().StringVar(&t.accountPath, "accountPath", "", "key path of account")
@@ -141,6 +147,8 @@ func (t *TransferCommand) transfer(ctx context.Context) error {
 		Fee:            t.fee,
 		Desc:           desc,
 		FrozenHeight:   t.frozenHeight,
+		LockHeight:     t.lockHeight,
+		LockTime:       t.lockTime,
 		Version:        t.version,
 		From:           t.from,
 		AccountPath:    t.accountPath,
@@ -43,7 +43,7 @@ func (c *watchCommand) addFlags() {
 
 func (c *watchCommand) watch(ctx context.Context) error {
 	filter := &protos.BlockFilter{
-		Bcname: c.cli.RootOptions.Name,
+		BcName: c.cli.RootOptions.Name,
 	}
 	err := json.Unmarshal([]byte(c.filter), filter)
 	if err != nil {
@@ -101,6 +101,10 @@ func (t *ChainHandle) GetAccountContracts(account string) ([]*protos.ContractSta
 		t.genXctx()).GetAccountContracts(account)
 }
 
+func (t *ChainHandle) QueryContractAbi(contract string) ([]byte, error) {
+	return reader.NewContractReader(t.chain.Context(), t.genXctx()).QueryContractAbi(contract)
+}
+
 func (t *ChainHandle) GetBalance(account string) (string, error) {
 	return reader.NewUtxoReader(t.chain.Context(), t.genXctx()).GetBalance(account)
 }
@@ -137,6 +141,33 @@ func (t *ChainHandle) GetAccountByAK(address string) ([]string, error) {
 	return reader.NewContractReader(t.chain.Context(), t.genXctx()).GetAccountByAK(address)
 }
 
+func (t *ChainHandle) SendPrivateTx(initiator string, members []string,
+	payload *protos.PrivatePayload) ([]byte, error) {
+	return t.chain.Context().Privacy.SendPrivateTx(t.genXctx(), initiator, members, payload)
+}
+
+func (t *ChainHandle) QueryPrivateTx(initiator string, hash []byte) (*protos.PrivatePayload, []string, error) {
+	return t.chain.Context().Privacy.GetPrivateTx(initiator, hash)
+}
+
+func (t *ChainHandle) QueryPrivateTxResult(hash []byte) (string, error) {
+	return t.chain.Context().Privacy.GetExecResult(hash)
+}
+
+// CheckSign 校验签名来自account对应的公钥
+func (t *ChainHandle) CheckSign(account, pubKey string, sign, digest []byte) bool {
+	crypto := t.chain.Context().Crypto
+	publicKey, err := crypto.GetEcdsaPublicKeyFromJsonStr(pubKey)
+	if err != nil {
+		return false
+	}
+	if ok, err := crypto.VerifyECDSA(publicKey, sign, digest); err != nil || !ok {
+		return false
+	}
+	ok, _ := crypto.VerifyAddressUsingPublicKey(account, publicKey)
+	return ok
+}
+
 func (t *ChainHandle) genXctx() xctx.Context {
 	return &xctx.BaseCtx{
 		XLog:  t.reqCtx.GetLog(),
@@ -82,6 +82,10 @@ type MXchainClient interface {
 	GetAddressContracts(ctx context.Context, in *AddressContractsRequest, opts ...grpc.CallOption) (*AddressContractsResponse, error)
 	//预执行合约
 	PreExec(ctx context.Context, in *InvokeRPCRequest, opts ...grpc.CallOption) (*InvokeRPCResponse, error)
+	// SendPrivateTx 加密合约参数和写集后发往成员节点，返回上链用的hash
+	SendPrivateTx(ctx context.Context, in *PrivateTxRequest, opts ...grpc.CallOption) (*PrivateTxResponse, error)
+	// QueryPrivateTx 本节点是成员时解密私有交易
+	QueryPrivateTx(ctx context.Context, in *PrivateTxRequest, opts ...grpc.CallOption) (*PrivateTxResponse, error)
 }
 
 type mXchainClient struct {
@@ -353,6 +357,24 @@ func (c *mXchainClient) PreExec(ctx context.Context, in *InvokeRPCRequest, opts
 	return out, nil
 }
 
+func (c *mXchainClient) SendPrivateTx(ctx context.Context, in *PrivateTxRequest, opts ...grpc.CallOption) (*PrivateTxResponse, error) {
+	out := new(PrivateTxResponse)
+	err := c.cc.Invoke(ctx, "/pb.MXchain/SendPrivateTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mXchainClient) QueryPrivateTx(ctx context.Context, in *PrivateTxRequest, opts ...grpc.CallOption) (*PrivateTxResponse, error) {
+	out := new(PrivateTxResponse)
+	err := c.cc.Invoke(ctx, "/pb.MXchain/QueryPrivateTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MXchainServer is the server API for MXchain service.
 // All implementations should embed UnimplementedMXchainServer
 // for forward compatibility
@@ -417,6 +439,10 @@ type MXchainServer interface {
 	GetAddressContracts(context.Context, *AddressContractsRequest) (*AddressContractsResponse, error)
 	//预执行合约
 	PreExec(context.Context, *InvokeRPCRequest) (*InvokeRPCResponse, error)
+	// SendPrivateTx 加密合约参数和写集后发往成员节点，返回上链用的hash
+	SendPrivateTx(context.Context, *PrivateTxRequest) (*PrivateTxResponse, error)
+	// QueryPrivateTx 本节点是成员时解密私有交易
+	QueryPrivateTx(context.Context, *PrivateTxRequest) (*PrivateTxResponse, error)
 }
 
 // UnimplementedMXchainServer should be embedded to have forward compatible implementations.
@@ -510,6 +536,12 @@ func (UnimplementedMXchainServer) GetAddressContracts(context.Context, *AddressC
 func (UnimplementedMXchainServer) PreExec(context.Context, *InvokeRPCRequest) (*InvokeRPCResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PreExec not implemented")
 }
+func (UnimplementedMXchainServer) SendPrivateTx(context.Context, *PrivateTxRequest) (*PrivateTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendPrivateTx not implemented")
+}
+func (UnimplementedMXchainServer) QueryPrivateTx(context.Context, *PrivateTxRequest) (*PrivateTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPrivateTx not implemented")
+}
 
 // UnsafeMXchainServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to MXchainServer will
@@ -1044,6 +1076,42 @@ func _MXchain_PreExec_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MXchain_SendPrivateTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrivateTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MXchainServer).SendPrivateTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.MXchain/SendPrivateTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MXchainServer).SendPrivateTx(ctx, req.(*PrivateTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MXchain_QueryPrivateTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrivateTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MXchainServer).QueryPrivateTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.MXchain/QueryPrivateTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MXchainServer).QueryPrivateTx(ctx, req.(*PrivateTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MXchain_ServiceDesc is the grpc.ServiceDesc for MXchain service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1167,6 +1235,14 @@ var MXchain_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PreExec",
 			Handler:    _MXchain_PreExec_Handler,
 		},
+		{
+			MethodName: "SendPrivateTx",
+			Handler:    _MXchain_SendPrivateTx_Handler,
+		},
+		{
+			MethodName: "QueryPrivateTx",
+			Handler:    _MXchain_QueryPrivateTx_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "common.proto",
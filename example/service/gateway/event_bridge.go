@@ -0,0 +1,242 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/websocket"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/wooyang2018/corechain/example/base"
+	"github.com/wooyang2018/corechain/logger"
+	"github.com/wooyang2018/corechain/protos"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	wsSubscribePath  = "/v1/subscribe/ws"
+	sseSubscribePath = "/v1/subscribe/sse"
+	// websocket关闭帧的原因最多123字节
+	maxCloseReasonLen = 123
+)
+
+// eventBridge 将EventService的gRPC流式订阅桥接为WebSocket和SSE接口
+// 请求参数type为订阅类型，filter为对应过滤器的JSON，如BlockFilter
+type eventBridge struct {
+	scfg     *base.ServConf
+	log      logger.Logger
+	client   protos.EventServiceClient
+	upgrader websocket.Upgrader
+}
+
+func newEventBridge(ctx context.Context, scfg *base.ServConf, log logger.Logger,
+	endpoint string, opts []grpc.DialOption) (*eventBridge, error) {
+	conn, err := grpc.DialContext(ctx, endpoint, opts...)
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		<-ctx.Done()
+		conn.Close()
+	}()
+
+	bridge := &eventBridge{
+		scfg:   scfg,
+		log:    log,
+		client: protos.NewEventServiceClient(conn),
+	}
+	if scfg.AdapterAllowCROS {
+		bridge.upgrader.CheckOrigin = func(r *http.Request) bool {
+			return true
+		}
+	}
+	return bridge, nil
+}
+
+// register 注册WebSocket和SSE的订阅路由
+func (e *eventBridge) register(mux *runtime.ServeMux) error {
+	if err := mux.HandlePath("GET", wsSubscribePath, e.serveWebSocket); err != nil {
+		return err
+	}
+	return mux.HandlePath("GET", sseSubscribePath, e.serveSSE)
+}
+
+func (e *eventBridge) serveWebSocket(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	tp, req, err := parseSubscribeRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	conn, err := e.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		e.log.Warn("websocket upgrade failed", "ip", r.RemoteAddr, "err", err)
+		return
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	// 客户端只接收事件，读取消息用于处理控制帧和感知连接关闭
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	err = e.subscribe(ctx, r, tp, req, func(msg []byte) error {
+		return conn.WriteMessage(websocket.TextMessage, msg)
+	})
+	if err != nil {
+		reason := errorMessage(err)
+		if len(reason) > maxCloseReasonLen {
+			reason = reason[:maxCloseReasonLen]
+		}
+		closeMsg := websocket.FormatCloseMessage(websocket.CloseInternalServerErr, reason)
+		conn.WriteMessage(websocket.CloseMessage, closeMsg)
+		return
+	}
+	conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+}
+
+func (e *eventBridge) serveSSE(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	tp, req, err := parseSubscribeRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	err = e.subscribe(r.Context(), r, tp, req, func(msg []byte) error {
+		if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", strings.ToLower(tp.String()), msg); err != nil {
+			return err
+		}
+		flusher.Flush()
+		return nil
+	})
+	if err != nil {
+		fmt.Fprintf(w, "event: error\ndata: %s\n\n", errorMessage(err))
+		flusher.Flush()
+	}
+}
+
+// subscribe 发起gRPC订阅，并将每个事件转换为JSON后交给send发送
+func (e *eventBridge) subscribe(ctx context.Context, r *http.Request, tp protos.SubscribeType,
+	req *protos.SubscribeRequest, send func([]byte) error) error {
+	// 携带原始客户端地址和网关令牌，由EventService按客户端地址限制连接数
+	if clientIP, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		ctx = metadata.AppendToOutgoingContext(ctx, base.ForwardedForKey, clientIP,
+			base.GatewayTokenKey, base.GatewayToken())
+	}
+
+	stream, err := e.client.Subscribe(ctx, req)
+	if err != nil {
+		return err
+	}
+	e.log.Debug("gateway event subscribe", "ip", r.RemoteAddr, "type", tp.String())
+
+	for {
+		event, err := stream.Recv()
+		if err == io.EOF || ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		msg, err := decodeEvent(tp, event.GetPayload())
+		if err != nil {
+			return err
+		}
+		if err := send(msg); err != nil {
+			return nil
+		}
+	}
+}
+
+// parseSubscribeRequest 从请求参数中解析订阅类型和JSON格式的过滤器
+func parseSubscribeRequest(r *http.Request) (protos.SubscribeType, *protos.SubscribeRequest, error) {
+	tp := protos.SubscribeType_BLOCK
+	if typeStr := r.URL.Query().Get("type"); typeStr != "" {
+		v, ok := protos.SubscribeType_value[strings.ToUpper(typeStr)]
+		if !ok {
+			return tp, nil, fmt.Errorf("subscribe type %s unsupported", typeStr)
+		}
+		tp = protos.SubscribeType(v)
+	}
+
+	filter, err := newFilter(tp)
+	if err != nil {
+		return tp, nil, err
+	}
+	if filterStr := r.URL.Query().Get("filter"); filterStr != "" {
+		if err := protojson.Unmarshal([]byte(filterStr), filter); err != nil {
+			return tp, nil, fmt.Errorf("parse filter error: %s", err)
+		}
+	}
+	buf, err := proto.Marshal(filter)
+	if err != nil {
+		return tp, nil, err
+	}
+
+	return tp, &protos.SubscribeRequest{
+		Type:   tp,
+		Filter: buf,
+	}, nil
+}
+
+func newFilter(tp protos.SubscribeType) (proto.Message, error) {
+	switch tp {
+	case protos.SubscribeType_BLOCK:
+		return new(protos.BlockFilter), nil
+	case protos.SubscribeType_PENDING_TX:
+		return new(protos.PendingTxFilter), nil
+	case protos.SubscribeType_CHAIN_STATUS:
+		return new(protos.ChainStatusFilter), nil
+	}
+	return nil, fmt.Errorf("subscribe type %s unsupported", tp)
+}
+
+func decodeEvent(tp protos.SubscribeType, payload []byte) ([]byte, error) {
+	var msg proto.Message
+	switch tp {
+	case protos.SubscribeType_BLOCK:
+		msg = new(protos.FilteredBlock)
+	case protos.SubscribeType_PENDING_TX:
+		msg = new(protos.PendingTxEvent)
+	case protos.SubscribeType_CHAIN_STATUS:
+		msg = new(protos.ChainStatusEvent)
+	default:
+		return nil, fmt.Errorf("subscribe type %s unsupported", tp)
+	}
+	if err := proto.Unmarshal(payload, msg); err != nil {
+		return nil, err
+	}
+	return protojson.Marshal(msg)
+}
+
+func errorMessage(err error) string {
+	if st, ok := status.FromError(err); ok {
+		return st.Message()
+	}
+	return err.Error()
+}
@@ -0,0 +1,54 @@
+package gateway
+
+import (
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/wooyang2018/corechain/protos"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestParseSubscribeRequest(t *testing.T) {
+	query := url.Values{}
+	query.Set("type", "pending_tx")
+	query.Set("filter", `{"bcName":"corechain","contract":"counter"}`)
+	r := httptest.NewRequest("GET", sseSubscribePath+"?"+query.Encode(), nil)
+
+	tp, req, err := parseSubscribeRequest(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tp != protos.SubscribeType_PENDING_TX || req.GetType() != tp {
+		t.Fatalf("expect PENDING_TX got %s", tp)
+	}
+	filter := new(protos.PendingTxFilter)
+	if err := proto.Unmarshal(req.GetFilter(), filter); err != nil {
+		t.Fatal(err)
+	}
+	if filter.GetBcName() != "corechain" || filter.GetContract() != "counter" {
+		t.Fatalf("unexpected filter %v", filter)
+	}
+
+	r = httptest.NewRequest("GET", wsSubscribePath+"?type=unknown", nil)
+	if _, _, err := parseSubscribeRequest(r); err == nil {
+		t.Fatal("expect unsupported type error")
+	}
+}
+
+func TestDecodeEvent(t *testing.T) {
+	buf, err := proto.Marshal(&protos.FilteredBlock{
+		Bcname:      "corechain",
+		BlockHeight: 10,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg, err := decodeEvent(protos.SubscribeType_BLOCK, buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(msg) == 0 {
+		t.Fatal("expect json payload")
+	}
+}
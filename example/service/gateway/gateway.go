@@ -81,7 +81,7 @@ func (t *Gateway) runGateway() error {
 		grpc.WithReadBufferSize(t.scfg.ReadBufSize),
 	}
 
-	rpcEndpoint := fmt.Sprintf(":%d", t.scfg.GWPort)
+	rpcEndpoint := fmt.Sprintf(":%d", t.scfg.RpcPort)
 	err := pb.RegisterMXchainHandlerFromEndpoint(ctx, mux, rpcEndpoint, opts)
 	if err != nil {
 		return err
@@ -94,6 +94,16 @@ func (t *Gateway) runGateway() error {
 		}
 	}
 
+	if t.scfg.EnableEvent {
+		bridge, err := newEventBridge(ctx, t.scfg, t.log, rpcEndpoint, opts)
+		if err != nil {
+			return err
+		}
+		if err = bridge.register(mux); err != nil {
+			return err
+		}
+	}
+
 	addr := fmt.Sprintf(":%d", t.scfg.GWPort)
 	t.server = &http.Server{
 		Addr:    addr,
@@ -7,6 +7,7 @@ import (
 	sconf "github.com/wooyang2018/corechain/example/base"
 	"github.com/wooyang2018/corechain/example/service/gateway"
 	"github.com/wooyang2018/corechain/example/service/rpc"
+	"github.com/wooyang2018/corechain/example/service/web3"
 	"github.com/wooyang2018/corechain/logger"
 )
 
@@ -47,6 +48,14 @@ func NewServMG(scfg *sconf.ServConf, engine base.BasicEngine) (*Manager, error)
 
 	obj.servers = append(obj.servers, serv, GW)
 
+	if scfg.EnableWeb3 {
+		web3Serv, err := web3.NewWeb3Server(scfg, engine)
+		if err != nil {
+			return nil, err
+		}
+		obj.servers = append(obj.servers, web3Serv)
+	}
+
 	return obj, nil
 }
 
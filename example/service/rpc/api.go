@@ -3,6 +3,7 @@ package rpc
 import (
 	"context"
 	"math/big"
+	"time"
 
 	"github.com/wooyang2018/corechain/common/utils"
 	engineBase "github.com/wooyang2018/corechain/engine/base"
@@ -14,6 +15,9 @@ import (
 	"github.com/wooyang2018/corechain/protos"
 )
 
+// 私有交易请求签名的有效期
+const privateTxRequestExpired = time.Minute
+
 // 注意：
 // 1.rpc接口响应resp不能为nil，必须实例化
 // 2.rpc接口响应err必须为ecom.Error类型的标准错误，没有错误响应err=nil
@@ -987,3 +991,108 @@ func (t *RpcServer) DposStatus(gctx context.Context, req *pb.DposStatusRequest)
 
 	return resp, engineBase.ErrForbidden
 }
+
+// SendPrivateTx encrypt contract requests and write sets to members, return the hash to commit
+func (t *RpcServer) SendPrivateTx(gctx context.Context, req *pb.PrivateTxRequest) (*pb.PrivateTxResponse, error) {
+	// 默认响应
+	resp := &pb.PrivateTxResponse{}
+	// 获取请求上下文，对内传递rctx
+	rctx := sctx.ValueReqCtx(gctx)
+
+	// 校验参数
+	if req == nil || req.GetBcname() == "" || len(req.GetMembers()) == 0 {
+		rctx.GetLog().Warn("param error,some param unset")
+		return resp, engineBase.ErrParameter
+	}
+	payload, err := scom.PrivatePayloadToXledger(req.GetRequests(), req.GetOutputs())
+	if err != nil {
+		rctx.GetLog().Warn("param error, convert failed", "err", err)
+		return resp, engineBase.ErrParameter
+	}
+
+	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
+	if err != nil {
+		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
+		return resp, err
+	}
+	if err := checkPrivateTxRequest(handle, req); err != nil {
+		rctx.GetLog().Warn("check private tx request failed", "initiator", req.GetInitiator(), "err", err)
+		return resp, err
+	}
+	hash, err := handle.SendPrivateTx(req.GetInitiator(), req.GetMembers(), payload)
+	if err != nil {
+		rctx.GetLog().Warn("send private tx failed", "err", err)
+		return resp, engineBase.ErrSendMessageFailed.More("%v", err)
+	}
+
+	resp.Bcname = req.GetBcname()
+	resp.Hash = hash
+	resp.Members = req.GetMembers()
+	rctx.GetLog().SetInfoField("bc_name", req.GetBcname())
+	rctx.GetLog().SetInfoField("hash", utils.F(hash))
+	return resp, nil
+}
+
+// QueryPrivateTx decrypt private tx when this node is one of the members
+func (t *RpcServer) QueryPrivateTx(gctx context.Context, req *pb.PrivateTxRequest) (*pb.PrivateTxResponse, error) {
+	// 默认响应
+	resp := &pb.PrivateTxResponse{}
+	// 获取请求上下文，对内传递rctx
+	rctx := sctx.ValueReqCtx(gctx)
+
+	// 校验参数
+	if req == nil || req.GetBcname() == "" || len(req.GetHash()) == 0 {
+		rctx.GetLog().Warn("param error,some param unset")
+		return resp, engineBase.ErrParameter
+	}
+
+	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
+	if err != nil {
+		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
+		return resp, err
+	}
+	if err := checkPrivateTxRequest(handle, req); err != nil {
+		rctx.GetLog().Warn("check private tx request failed", "initiator", req.GetInitiator(), "err", err)
+		return resp, err
+	}
+	payload, members, err := handle.QueryPrivateTx(req.GetInitiator(), req.GetHash())
+	if err != nil {
+		rctx.GetLog().Warn("query private tx failed", "err", err)
+		return resp, engineBase.ErrForbidden.More("%v", err)
+	}
+	reqs, outputs, err := scom.PrivatePayloadToXchain(payload)
+	if err != nil {
+		rctx.GetLog().Warn("convert private tx failed", "err", err)
+		return resp, engineBase.ErrInternal
+	}
+
+	resp.Bcname = req.GetBcname()
+	resp.Hash = req.GetHash()
+	resp.Members = members
+	// 尚未执行时结果为空
+	resp.Result, _ = handle.QueryPrivateTxResult(req.GetHash())
+	resp.Requests = reqs
+	resp.Outputs = outputs
+	rctx.GetLog().SetInfoField("bc_name", req.GetBcname())
+	rctx.GetLog().SetInfoField("hash", utils.F(req.GetHash()))
+	return resp, nil
+}
+
+// checkPrivateTxRequest 校验请求方对请求的签名，过期的请求不再接受，防止重放
+func checkPrivateTxRequest(handle *models.ChainHandle, req *pb.PrivateTxRequest) error {
+	if req.GetInitiator() == "" || len(req.GetUserSign()) == 0 {
+		return engineBase.ErrUnauthorized
+	}
+	elapsed := time.Since(time.Unix(0, req.GetTimestamp()))
+	if elapsed > privateTxRequestExpired || elapsed < -privateTxRequestExpired {
+		return engineBase.ErrUnauthorized.More("request expired")
+	}
+	digest, err := scom.PrivateTxRequestDigest(req)
+	if err != nil {
+		return engineBase.ErrParameter
+	}
+	if !handle.CheckSign(req.GetInitiator(), req.GetPublickey(), req.GetUserSign(), digest) {
+		return engineBase.ErrUnauthorized.More("check sign failed")
+	}
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 	sconf "github.com/wooyang2018/corechain/example/base"
 	"github.com/wooyang2018/corechain/protos"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
 )
 
@@ -75,6 +76,12 @@ func (e *eventService) connPermit(ctx context.Context) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	// 本机网关转发的订阅按原始客户端地址计数，客户端可以任意设置metadata，只信任携带网关令牌的请求
+	if ip := net.ParseIP(remoteIP); ip != nil && ip.IsLoopback() {
+		if forwarded, ok := forwardedFor(ctx); ok {
+			remoteIP = forwarded
+		}
+	}
 
 	if e.cfg.EventAddrMaxConn == 0 {
 		return remoteIP, nil
@@ -94,6 +101,24 @@ func (e *eventService) connPermit(ctx context.Context) (string, error) {
 	return remoteIP, nil
 }
 
+// forwardedFor 返回网关转发的原始客户端地址
+func forwardedFor(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+	tokens := md.Get(sconf.GatewayTokenKey)
+	if len(tokens) != 1 || !sconf.IsGatewayToken(tokens[0]) {
+		return "", false
+	}
+	// 令牌只由网关设置，取网关追加的最后一个地址
+	forwarded := md.Get(sconf.ForwardedForKey)
+	if len(forwarded) == 0 || forwarded[len(forwarded)-1] == "" {
+		return "", false
+	}
+	return forwarded[len(forwarded)-1], true
+}
+
 func (e *eventService) releaseConn(addr string) {
 	if e.cfg.EventAddrMaxConn == 0 {
 		return
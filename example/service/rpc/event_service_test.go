@@ -0,0 +1,45 @@
+package rpc
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	sconf "github.com/wooyang2018/corechain/example/base"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestConnPermitForwardedFor(t *testing.T) {
+	e := &eventService{cfg: sconf.GetDefServConf(), connCounter: make(map[string]int)}
+	local := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 50000},
+	})
+
+	// 本机客户端自行设置的转发地址不被信任
+	ctx := metadata.NewIncomingContext(local, metadata.Pairs(sconf.ForwardedForKey, "10.0.0.1"))
+	if ip, err := e.connPermit(ctx); err != nil || ip != "127.0.0.1" {
+		t.Fatalf("forged forwarded address should be ignored, ip=%s err=%v", ip, err)
+	}
+	ctx = metadata.NewIncomingContext(local, metadata.Pairs(sconf.ForwardedForKey, "10.0.0.1",
+		sconf.GatewayTokenKey, "forged"))
+	if ip, err := e.connPermit(ctx); err != nil || ip != "127.0.0.1" {
+		t.Fatalf("forged gateway token should be ignored, ip=%s err=%v", ip, err)
+	}
+
+	// 网关转发时取网关追加的地址
+	ctx = metadata.NewIncomingContext(local, metadata.Pairs(sconf.ForwardedForKey, "10.0.0.1",
+		sconf.ForwardedForKey, "10.0.0.2", sconf.GatewayTokenKey, sconf.GatewayToken()))
+	if ip, err := e.connPermit(ctx); err != nil || ip != "10.0.0.2" {
+		t.Fatalf("gateway forwarded address should be used, ip=%s err=%v", ip, err)
+	}
+
+	for i := 1; i < e.cfg.EventAddrMaxConn; i++ {
+		if _, err := e.connPermit(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := e.connPermit(ctx); err == nil {
+		t.Fatal("expect maximum connections exceeded")
+	}
+}
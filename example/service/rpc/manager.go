@@ -17,6 +17,7 @@ import (
 	sconf "github.com/wooyang2018/corechain/example/base"
 	"github.com/wooyang2018/corechain/example/pb"
 	"github.com/wooyang2018/corechain/logger"
+	"github.com/wooyang2018/corechain/protos"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/reflection"
@@ -114,7 +115,7 @@ func (t *RpcManager) runRpcServ() error {
 
 	// event involved rpc
 	eventService := newEventService(t.scfg, t.engine)
-	pb.RegisterEventServiceServer(t.servHD, eventService)
+	protos.RegisterEventServiceServer(t.servHD, eventService)
 
 	if t.scfg.EnableEndorser {
 		endorserService, err := newEndorserService(t.scfg, t.engine, t.rpcServ)
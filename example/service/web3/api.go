@@ -0,0 +1,761 @@
+package web3
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/wooyang2018/corechain/common/utils"
+	"github.com/wooyang2018/corechain/contract/evm"
+	"github.com/wooyang2018/corechain/contract/sandbox"
+	engineBase "github.com/wooyang2018/corechain/engine/base"
+	sctx "github.com/wooyang2018/corechain/example/base"
+	"github.com/wooyang2018/corechain/example/models"
+	"github.com/wooyang2018/corechain/network"
+	"github.com/wooyang2018/corechain/protos"
+	"github.com/wooyang2018/corechain/state/txhash"
+	"github.com/wooyang2018/corechain/state/utxo"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	evmModuleName     = "evm"
+	evmInitMethod     = "initialize"
+	evmInputArg       = "input"
+	feePlaceholder    = "$"
+	web3ClientVersion = "corechain/web3"
+	maxLogBlockRange  = 1000
+)
+
+var emptyLogsBloom = encodeBytes(make([]byte, 256))
+
+func (t *Web3Server) clientVersion(rctx sctx.ReqCtx, params json.RawMessage) (interface{}, error) {
+	return web3ClientVersion, nil
+}
+
+func (t *Web3Server) netVersion(rctx sctx.ReqCtx, params json.RawMessage) (interface{}, error) {
+	return strconv.FormatUint(t.scfg.Web3ChainID, 10), nil
+}
+
+func (t *Web3Server) chainId(rctx sctx.ReqCtx, params json.RawMessage) (interface{}, error) {
+	return encodeUint64(t.scfg.Web3ChainID), nil
+}
+
+func (t *Web3Server) blockNumber(rctx sctx.ReqCtx, params json.RawMessage) (interface{}, error) {
+	handle, err := t.chainHandle(rctx)
+	if err != nil {
+		return nil, err
+	}
+	height, err := latestHeight(handle)
+	if err != nil {
+		return nil, err
+	}
+	return encodeUint64(uint64(height)), nil
+}
+
+func (t *Web3Server) getBalance(rctx sctx.ReqCtx, params json.RawMessage) (interface{}, error) {
+	var addr, tag string
+	if err := parsePositionalParams(params, &addr, &tag); err != nil {
+		return nil, err
+	}
+	if err := checkLatestTag(tag); err != nil {
+		return nil, err
+	}
+	account, err := evmToXchain(addr)
+	if err != nil {
+		return nil, newRPCError(errCodeInvalidParams, "invalid address: %v", err)
+	}
+
+	handle, err := t.chainHandle(rctx)
+	if err != nil {
+		return nil, err
+	}
+	balance, err := handle.GetBalance(account)
+	if err != nil {
+		return nil, err
+	}
+	amount, ok := new(big.Int).SetString(balance, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid balance %s", balance)
+	}
+	return encodeBig(amount), nil
+}
+
+// call 将eth_call转换为evm合约的预执行
+func (t *Web3Server) call(rctx sctx.ReqCtx, params json.RawMessage) (interface{}, error) {
+	var args callArgs
+	var tag string
+	if err := parsePositionalParams(params, &args, &tag); err != nil {
+		return nil, err
+	}
+	if err := checkLatestTag(tag); err != nil {
+		return nil, err
+	}
+	if args.To == "" {
+		return nil, newRPCError(errCodeInvalidParams, "contract creation by eth_call unsupported")
+	}
+	toAddr, err := parseEVMAddress(args.To)
+	if err != nil {
+		return nil, newRPCError(errCodeInvalidParams, "invalid to address: %v", err)
+	}
+	contractName, err := evm.DetermineContractNameFromEVM(toAddr)
+	if err != nil {
+		return nil, newRPCError(errCodeInvalidParams, "to address is not a contract: %v", err)
+	}
+	data := args.Input
+	if data == "" {
+		data = args.Data
+	}
+	input, err := decodeBytes(data)
+	if err != nil {
+		return nil, newRPCError(errCodeInvalidParams, "invalid data: %v", err)
+	}
+
+	handle, err := t.chainHandle(rctx)
+	if err != nil {
+		return nil, err
+	}
+	// 权限和事件均以方法名为单位，需要根据函数选择器从abi中查找方法名
+	abiBuf, err := handle.QueryContractAbi(contractName)
+	if err != nil {
+		return nil, err
+	}
+	method, err := evm.MethodFromAbi(abiBuf, input)
+	if err != nil {
+		return nil, newRPCError(errCodeInvalidParams, "%v", err)
+	}
+
+	initiator, err := t.initiator(args.From)
+	if err != nil {
+		return nil, err
+	}
+	req := &protos.InvokeRequest{
+		ModuleName:   evmModuleName,
+		ContractName: contractName,
+		MethodName:   method,
+		Args:         map[string][]byte{evmInputArg: input},
+	}
+	if args.Value != "" {
+		value, err := decodeBig(args.Value)
+		if err != nil {
+			return nil, newRPCError(errCodeInvalidParams, "invalid value: %v", err)
+		}
+		if value.Sign() > 0 {
+			req.Amount = value.String()
+		}
+	}
+
+	resp, err := handle.PreExec([]*protos.InvokeRequest{req}, initiator, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.GetResponses()) == 0 {
+		return encodeBytes(nil), nil
+	}
+	out := resp.GetResponses()[len(resp.GetResponses())-1]
+	if out.GetStatus() >= 400 {
+		return nil, newRPCError(errCodeServer, "execution reverted: %s", out.GetMessage())
+	}
+	return encodeBytes(out.GetBody()), nil
+}
+
+// sendRawTransaction 提交序列化后的交易，返回交易哈希
+// 以太坊钱包签名的RLP交易转换为evm合约调用后提交，protobuf格式的交易需由链的SDK构造并签名
+func (t *Web3Server) sendRawTransaction(rctx sctx.ReqCtx, params json.RawMessage) (interface{}, error) {
+	var raw string
+	if err := parsePositionalParams(params, &raw); err != nil {
+		return nil, err
+	}
+	buf, err := decodeBytes(raw)
+	if err != nil || len(buf) == 0 {
+		return nil, newRPCError(errCodeInvalidParams, "invalid raw transaction")
+	}
+	if isRLPTransaction(buf) {
+		return t.sendEthTransaction(rctx, buf)
+	}
+	tx := &protos.Transaction{}
+	if err := proto.Unmarshal(buf, tx); err != nil || len(tx.GetTxid()) == 0 {
+		return nil, newRPCError(errCodeInvalidParams, "raw transaction must be a signed protobuf transaction")
+	}
+
+	handle, err := t.chainHandle(rctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := handle.SubmitTx(tx); err != nil {
+		return nil, err
+	}
+	msg := network.NewMessage(protos.CoreMessage_POSTTX, tx,
+		network.WithBCName(t.scfg.Web3ChainName),
+		network.WithLogId(rctx.GetLog().GetLogId()),
+	)
+	go t.engine.Context().Net.SendMessage(rctx, msg)
+
+	return encodeBytes(tx.GetTxid()), nil
+}
+
+// sendEthTransaction 将钱包签名的legacy或EIP-155交易转换为evm合约调用
+// 发送者地址对应的链上地址作为发起人，由本节点选择发起人的utxo支付转账金额和手续费，
+// 发起人签名字段携带原始RLP交易，各节点据此恢复发送者并校验交易内容，重放由evm合约记录的nonce保证。
+// 不支持合约部署和EIP-2718类型交易
+func (t *Web3Server) sendEthTransaction(rctx sctx.ReqCtx, raw []byte) (interface{}, error) {
+	ethTx, err := evm.DecodeEthTx(raw)
+	if err == evm.ErrTypedEthTx {
+		return nil, newRPCError(errCodeUnsupported, "typed ethereum transaction unsupported, use a legacy transaction")
+	}
+	if err != nil {
+		return nil, newRPCError(errCodeInvalidParams, "invalid rlp transaction: %v", err)
+	}
+	if id := ethTx.ChainID(); id != nil && (!id.IsUint64() || id.Uint64() != t.scfg.Web3ChainID) {
+		return nil, newRPCError(errCodeInvalidParams, "invalid chain id %s, want %d", id, t.scfg.Web3ChainID)
+	}
+	sender, err := ethTx.Sender()
+	if err != nil {
+		return nil, newRPCError(errCodeInvalidParams, "invalid sender: %v", err)
+	}
+	if len(ethTx.To) == 0 {
+		return nil, newRPCError(errCodeUnsupported, "contract creation by raw transaction unsupported")
+	}
+	toAddr, err := crypto.AddressFromBytes(ethTx.To)
+	if err != nil {
+		return nil, newRPCError(errCodeInvalidParams, "invalid to address: %v", err)
+	}
+	contractName, err := evm.DetermineContractNameFromEVM(toAddr)
+	if err != nil {
+		return nil, newRPCError(errCodeInvalidParams, "to address is not a contract: %v", err)
+	}
+	initiator, err := evm.EVMAddressToXchain(sender)
+	if err != nil {
+		return nil, err
+	}
+
+	handle, err := t.chainHandle(rctx)
+	if err != nil {
+		return nil, err
+	}
+	abiBuf, err := handle.QueryContractAbi(contractName)
+	if err != nil {
+		return nil, err
+	}
+	method, err := evm.MethodFromAbi(abiBuf, ethTx.Data)
+	if err != nil {
+		return nil, newRPCError(errCodeInvalidParams, "%v", err)
+	}
+	req := &protos.InvokeRequest{
+		ModuleName:   evmModuleName,
+		ContractName: contractName,
+		MethodName:   method,
+		Args: map[string][]byte{
+			evmInputArg:  ethTx.Data,
+			evm.EthTxArg: raw,
+		},
+	}
+	if ethTx.Value.Sign() > 0 {
+		req.Amount = ethTx.Value.String()
+	}
+	resp, err := handle.PreExec([]*protos.InvokeRequest{req}, initiator, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, out := range resp.GetResponses() {
+		if out.GetStatus() >= 400 {
+			return nil, newRPCError(errCodeServer, "execution reverted: %s", out.GetMessage())
+		}
+	}
+	fee := big.NewInt(resp.GetGasUsed())
+	maxFee := new(big.Int).Mul(ethTx.GasPrice, new(big.Int).SetUint64(ethTx.GasLimit))
+	if fee.Cmp(maxFee) > 0 {
+		return nil, newRPCError(errCodeServer, "intrinsic gas too low: fee %s exceeds gasPrice*gasLimit %s", fee, maxFee)
+	}
+
+	tx, err := genEthTx(handle, initiator, ethTx, resp, fee)
+	if err != nil {
+		return nil, err
+	}
+	if err := handle.SubmitTx(tx); err != nil {
+		return nil, err
+	}
+	msg := network.NewMessage(protos.CoreMessage_POSTTX, tx,
+		network.WithBCName(t.scfg.Web3ChainName),
+		network.WithLogId(rctx.GetLog().GetLogId()),
+	)
+	go t.engine.Context().Net.SendMessage(rctx, msg)
+
+	hash := ethTx.Hash()
+	t.ethTxs.Add(string(hash), tx.GetTxid())
+	return encodeBytes(hash), nil
+}
+
+// getTransactionCount 以太坊钱包签名交易的nonce
+func (t *Web3Server) getTransactionCount(rctx sctx.ReqCtx, params json.RawMessage) (interface{}, error) {
+	var addr, tag string
+	if err := parsePositionalParams(params, &addr, &tag); err != nil {
+		return nil, err
+	}
+	if err := checkLatestTag(tag); err != nil {
+		return nil, err
+	}
+	evmAddr, err := parseEVMAddress(addr)
+	if err != nil {
+		return nil, newRPCError(errCodeInvalidParams, "invalid address: %v", err)
+	}
+
+	chain, err := t.engine.Get(t.scfg.Web3ChainName)
+	if err != nil {
+		return nil, err
+	}
+	bucket, key := evm.EthNonceKey(evmAddr)
+	data, err := chain.Context().State.CreateXMReader().Get(bucket, key)
+	if err != nil {
+		return nil, err
+	}
+	return encodeUint64(evm.DecodeEthNonce(data.GetPureData().GetValue())), nil
+}
+
+// getTransactionReceipt 交易未上链或不在主干上时返回null
+// 以太坊交易哈希只能查询本节点提交的交易，收据中的交易哈希与查询时一致
+func (t *Web3Server) getTransactionReceipt(rctx sctx.ReqCtx, params json.RawMessage) (interface{}, error) {
+	var hash string
+	if err := parsePositionalParams(params, &hash); err != nil {
+		return nil, err
+	}
+	txid, err := decodeBytes(hash)
+	if err != nil || len(txid) == 0 {
+		return nil, newRPCError(errCodeInvalidParams, "invalid transaction hash")
+	}
+	txHash := encodeBytes(txid)
+	if id, ok := t.ethTxs.Get(string(txid)); ok {
+		txid = id.([]byte)
+	}
+
+	handle, err := t.chainHandle(rctx)
+	if err != nil {
+		return nil, err
+	}
+	txInfo, err := handle.QueryTx(txid)
+	if err == engineBase.ErrTxNotExist {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if txInfo.GetStatus() != protos.TransactionStatus_TX_CONFIRM {
+		return nil, nil
+	}
+	tx := txInfo.GetTx()
+	blockInfo, err := handle.QueryBlock(tx.GetBlockid(), true)
+	if err != nil {
+		return nil, err
+	}
+	block := blockInfo.GetBlock()
+
+	receipt := &rpcReceipt{
+		TransactionHash: txHash,
+		BlockHash:       encodeBytes(block.GetBlockid()),
+		BlockNumber:     encodeUint64(uint64(block.GetHeight())),
+		Logs:            []*rpcLog{},
+		LogsBloom:       emptyLogsBloom,
+		Status:          "0x1",
+	}
+	for i, btx := range block.GetTransactions() {
+		if bytes.Equal(btx.GetTxid(), tx.GetTxid()) {
+			receipt.TransactionIndex = encodeUint64(uint64(i))
+			break
+		}
+	}
+	if receipt.From, err = xchainToEVM(tx.GetInitiator()); err != nil {
+		receipt.From = encodeBytes(crypto.ZeroAddress.Bytes())
+	}
+	for _, req := range tx.GetContractRequests() {
+		if req.GetModuleName() != evmModuleName {
+			continue
+		}
+		addr, err := xchainToEVM(req.GetContractName())
+		if err != nil {
+			continue
+		}
+		if req.GetMethodName() == evmInitMethod {
+			receipt.ContractAddress = &addr
+		} else {
+			receipt.To = &addr
+		}
+	}
+	gasUsed := txFee(tx)
+	receipt.GasUsed = encodeBig(gasUsed)
+	receipt.CumulativeGasUsed = encodeBig(gasUsed)
+
+	for _, log := range newLogCollector(handle).blockLogs(block) {
+		if log.TransactionHash == encodeBytes(tx.GetTxid()) {
+			log.TransactionHash = txHash
+			receipt.Logs = append(receipt.Logs, log)
+		}
+	}
+	return receipt, nil
+}
+
+// getLogs 按区块扫描交易中的合约事件，根据合约abi还原为evm日志
+func (t *Web3Server) getLogs(rctx sctx.ReqCtx, params json.RawMessage) (interface{}, error) {
+	var query filterQuery
+	if err := parsePositionalParams(params, &query); err != nil {
+		return nil, err
+	}
+	filter, err := newLogFilter(&query)
+	if err != nil {
+		return nil, newRPCError(errCodeInvalidParams, "%v", err)
+	}
+
+	handle, err := t.chainHandle(rctx)
+	if err != nil {
+		return nil, err
+	}
+	var blocks []*protos.InternalBlock
+	if query.BlockHash != "" {
+		blockid, err := decodeBytes(query.BlockHash)
+		if err != nil {
+			return nil, newRPCError(errCodeInvalidParams, "invalid block hash")
+		}
+		blockInfo, err := handle.QueryBlock(blockid, true)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, blockInfo.GetBlock())
+	} else {
+		latest, err := latestHeight(handle)
+		if err != nil {
+			return nil, err
+		}
+		from, err := parseBlockNumber(query.FromBlock, latest)
+		if err != nil {
+			return nil, newRPCError(errCodeInvalidParams, "invalid fromBlock: %v", err)
+		}
+		to, err := parseBlockNumber(query.ToBlock, latest)
+		if err != nil {
+			return nil, newRPCError(errCodeInvalidParams, "invalid toBlock: %v", err)
+		}
+		if to > latest {
+			to = latest
+		}
+		if from > to {
+			return []*rpcLog{}, nil
+		}
+		if to-from >= maxLogBlockRange {
+			return nil, newRPCError(errCodeInvalidParams, "block range exceeds %d", maxLogBlockRange)
+		}
+		for height := from; height <= to; height++ {
+			blockInfo, err := handle.QueryBlockByHeight(height, true)
+			if err != nil {
+				return nil, err
+			}
+			blocks = append(blocks, blockInfo.GetBlock())
+		}
+	}
+
+	logs := []*rpcLog{}
+	collector := newLogCollector(handle)
+	for _, block := range blocks {
+		for _, log := range collector.blockLogs(block) {
+			if filter.match(log) {
+				logs = append(logs, log)
+			}
+		}
+	}
+	return logs, nil
+}
+
+func (t *Web3Server) chainHandle(rctx sctx.ReqCtx) (*models.ChainHandle, error) {
+	return models.NewChainHandle(t.scfg.Web3ChainName, rctx)
+}
+
+// initiator 预执行的发起者，未指定时使用节点地址
+func (t *Web3Server) initiator(from string) (string, error) {
+	if from == "" {
+		chain, err := t.engine.Get(t.scfg.Web3ChainName)
+		if err != nil {
+			return "", err
+		}
+		if chain.Context().Address == nil {
+			return "", newRPCError(errCodeInvalidParams, "from address required")
+		}
+		return chain.Context().Address.Address, nil
+	}
+	initiator, err := evmToXchain(from)
+	if err != nil {
+		return "", newRPCError(errCodeInvalidParams, "invalid from address: %v", err)
+	}
+	return initiator, nil
+}
+
+// logCollector 收集区块中evm合约的日志，同一次请求内缓存合约abi
+type logCollector struct {
+	handle *models.ChainHandle
+	abis   map[string][]byte
+}
+
+func newLogCollector(handle *models.ChainHandle) *logCollector {
+	return &logCollector{
+		handle: handle,
+		abis:   make(map[string][]byte),
+	}
+}
+
+func (c *logCollector) contractAbi(contract string) []byte {
+	if abiBuf, ok := c.abis[contract]; ok {
+		return abiBuf
+	}
+	// 非evm合约没有abi，缓存空值避免重复查询
+	abiBuf, _ := c.handle.QueryContractAbi(contract)
+	c.abis[contract] = abiBuf
+	return abiBuf
+}
+
+func (c *logCollector) blockLogs(block *protos.InternalBlock) []*rpcLog {
+	var logs []*rpcLog
+	for txIndex, tx := range block.GetTransactions() {
+		events, err := sandbox.ParseContractEvents(tx)
+		if err != nil {
+			continue
+		}
+		for _, event := range events {
+			abiBuf := c.contractAbi(event.GetContract())
+			if len(abiBuf) == 0 {
+				continue
+			}
+			topics, data, err := evm.PackEventFromAbi(abiBuf, event)
+			if err != nil {
+				continue
+			}
+			addr, err := evm.ContractNameToEVMAddress(event.GetContract())
+			if err != nil {
+				continue
+			}
+
+			log := &rpcLog{
+				Address:          encodeBytes(addr.Bytes()),
+				Topics:           make([]string, 0, len(topics)),
+				Data:             encodeBytes(data),
+				BlockNumber:      encodeUint64(uint64(block.GetHeight())),
+				BlockHash:        encodeBytes(block.GetBlockid()),
+				TransactionHash:  encodeBytes(tx.GetTxid()),
+				TransactionIndex: encodeUint64(uint64(txIndex)),
+				LogIndex:         encodeUint64(uint64(len(logs))),
+			}
+			for _, topic := range topics {
+				log.Topics = append(log.Topics, encodeBytes(topic.Bytes()))
+			}
+			logs = append(logs, log)
+		}
+	}
+	return logs
+}
+
+// logFilter eth_getLogs的地址和topic过滤条件
+// topics每个位置为空表示任意值，多个值之间为或关系
+type logFilter struct {
+	addresses map[string]bool
+	topics    [][]string
+}
+
+func newLogFilter(query *filterQuery) (*logFilter, error) {
+	filter := &logFilter{
+		addresses: make(map[string]bool),
+	}
+	if len(query.Address) > 0 && string(query.Address) != "null" {
+		var addrs []string
+		var addr string
+		if err := json.Unmarshal(query.Address, &addr); err == nil {
+			addrs = append(addrs, addr)
+		} else if err := json.Unmarshal(query.Address, &addrs); err != nil {
+			return nil, fmt.Errorf("invalid address filter")
+		}
+		for _, addr := range addrs {
+			filter.addresses[strings.ToLower(addr)] = true
+		}
+	}
+
+	for _, topic := range query.Topics {
+		switch v := topic.(type) {
+		case nil:
+			filter.topics = append(filter.topics, nil)
+		case string:
+			filter.topics = append(filter.topics, []string{strings.ToLower(v)})
+		case []interface{}:
+			var alts []string
+			for _, alt := range v {
+				s, ok := alt.(string)
+				if !ok {
+					return nil, fmt.Errorf("invalid topic filter")
+				}
+				alts = append(alts, strings.ToLower(s))
+			}
+			filter.topics = append(filter.topics, alts)
+		default:
+			return nil, fmt.Errorf("invalid topic filter")
+		}
+	}
+	return filter, nil
+}
+
+func (f *logFilter) match(log *rpcLog) bool {
+	if len(f.addresses) > 0 && !f.addresses[log.Address] {
+		return false
+	}
+	if len(f.topics) > len(log.Topics) {
+		return false
+	}
+	for i, alts := range f.topics {
+		if len(alts) == 0 {
+			continue
+		}
+		matched := false
+		for _, alt := range alts {
+			if alt == log.Topics[i] {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+func latestHeight(handle *models.ChainHandle) (int64, error) {
+	status, err := handle.QueryChainStatus()
+	if err != nil {
+		return 0, err
+	}
+	return status.GetLedgerMeta().GetTrunkHeight(), nil
+}
+
+// 只支持查询最新状态
+func checkLatestTag(tag string) error {
+	if tag != "" && tag != blockTagLatest && tag != blockTagPending {
+		return newRPCError(errCodeInvalidParams, "only latest block state supported")
+	}
+	return nil
+}
+
+func parseEVMAddress(s string) (crypto.Address, error) {
+	buf, err := decodeBytes(s)
+	if err != nil {
+		return crypto.ZeroAddress, err
+	}
+	return crypto.AddressFromBytes(buf)
+}
+
+// evmToXchain 将evm地址转换为链上的地址、合约账户或合约名
+func evmToXchain(s string) (string, error) {
+	addr, err := parseEVMAddress(s)
+	if err != nil {
+		return "", err
+	}
+	xaddr, _, err := evm.DetermineEVMAddress(addr)
+	return xaddr, err
+}
+
+// xchainToEVM 将链上的地址、合约账户或合约名转换为evm地址
+func xchainToEVM(s string) (string, error) {
+	addr, _, err := evm.DetermineXchainAddress(s)
+	if err != nil {
+		return "", err
+	}
+	return "0x" + strings.ToLower(addr), nil
+}
+
+// genEthTx 按预执行结果构造交易，发起人支付合约转账金额和手续费，签名字段为原始RLP交易
+func genEthTx(handle *models.ChainHandle, initiator string, ethTx *evm.EthTx,
+	resp *protos.InvokeResponse, fee *big.Int) (*protos.Transaction, error) {
+	tx := &protos.Transaction{
+		Nonce:            utils.GenNonce(),
+		Timestamp:        time.Now().UnixNano(),
+		Version:          utxo.TxVersion,
+		Initiator:        initiator,
+		TxInputs:         resp.GetUtxoInputs(),
+		TxOutputs:        resp.GetUtxoOutputs(),
+		TxInputsExt:      resp.GetInputs(),
+		TxOutputsExt:     resp.GetOutputs(),
+		ContractRequests: resp.GetRequests(),
+		InitiatorSigns: []*protos.SignatureInfo{{
+			PublicKey: evm.EthSignPublicKey,
+			Sign:      ethTx.Raw(),
+		}},
+	}
+
+	need := new(big.Int)
+	if ethTx.Value.Sign() > 0 {
+		contractName := resp.GetRequests()[len(resp.GetRequests())-1].GetContractName()
+		tx.TxOutputs = append(tx.TxOutputs, &protos.TxOutput{
+			ToAddr: []byte(contractName),
+			Amount: ethTx.Value.Bytes(),
+		})
+		need.Add(need, ethTx.Value)
+	}
+	if fee.Sign() > 0 {
+		tx.TxOutputs = append(tx.TxOutputs, &protos.TxOutput{
+			ToAddr: []byte(feePlaceholder),
+			Amount: fee.Bytes(),
+		})
+		need.Add(need, fee)
+	}
+	if need.Sign() > 0 {
+		utxos, err := handle.SelectUtxo(initiator, need, false, false, "", nil)
+		if err != nil {
+			return nil, newRPCError(errCodeServer, "insufficient funds: %v", err)
+		}
+		for _, u := range utxos.GetUtxoList() {
+			tx.TxInputs = append(tx.TxInputs, &protos.TxInput{
+				RefTxid:   u.GetRefTxid(),
+				RefOffset: u.GetRefOffset(),
+				FromAddr:  u.GetToAddr(),
+				Amount:    u.GetAmount(),
+			})
+		}
+		total, ok := new(big.Int).SetString(utxos.GetTotalSelected(), 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid selected amount %s", utxos.GetTotalSelected())
+		}
+		if change := total.Sub(total, need); change.Sign() > 0 {
+			tx.TxOutputs = append(tx.TxOutputs, &protos.TxOutput{
+				ToAddr: []byte(initiator),
+				Amount: change.Bytes(),
+			})
+		}
+	}
+
+	txid, err := txhash.MakeTxID(tx)
+	if err != nil {
+		return nil, err
+	}
+	tx.Txid = txid
+	return tx, nil
+}
+
+// txFee 交易支付的手续费，作为消耗的gas返回
+func txFee(tx *protos.Transaction) *big.Int {
+	fee := new(big.Int)
+	for _, output := range tx.GetTxOutputs() {
+		if string(output.GetToAddr()) == feePlaceholder {
+			fee.Add(fee, new(big.Int).SetBytes(output.GetAmount()))
+		}
+	}
+	return fee
+}
+
+// isRLPTransaction 判断是否为以太坊交易，包括legacy交易和EIP-2718类型交易
+func isRLPTransaction(buf []byte) bool {
+	if len(buf) == 0 {
+		return false
+	}
+	// legacy交易为RLP列表
+	if buf[0] >= 0xc0 {
+		return true
+	}
+	// 类型交易为类型字节加RLP列表，protobuf中字段号0非法，不会与之混淆
+	return (buf[0] == 0x01 || buf[0] == 0x02) && len(buf) > 1 && buf[1] >= 0xc0
+}
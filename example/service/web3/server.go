@@ -0,0 +1,212 @@
+package web3
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"sync"
+
+	"github.com/wooyang2018/corechain/common/cache"
+	"github.com/wooyang2018/corechain/common/utils"
+	"github.com/wooyang2018/corechain/engine/base"
+	sctx "github.com/wooyang2018/corechain/example/base"
+	"github.com/wooyang2018/corechain/logger"
+)
+
+const (
+	// 单个请求体最大字节数
+	maxRequestSize = 5 << 20
+	// 以太坊交易哈希到链上交易id映射的缓存数量
+	ethTxCacheSize = 100000
+)
+
+type handlerFunc func(rctx sctx.ReqCtx, params json.RawMessage) (interface{}, error)
+
+// Web3Server 兼容以太坊JSON-RPC的服务，将eth_*接口转换为evm合约的预执行、交易提交和事件查询
+type Web3Server struct {
+	scfg     *sctx.ServConf
+	engine   base.Engine
+	log      logger.Logger
+	handlers map[string]handlerFunc
+	server   *http.Server
+	// 本节点提交的以太坊交易哈希到链上交易id的映射，只保存在内存中
+	ethTxs   *cache.LRUCache
+	isInit   bool
+	exitOnce *sync.Once
+}
+
+func NewWeb3Server(scfg *sctx.ServConf, en base.BasicEngine) (*Web3Server, error) {
+	if scfg == nil || en == nil {
+		return nil, fmt.Errorf("param error")
+	}
+	engine, ok := en.(base.Engine)
+	if !ok {
+		return nil, fmt.Errorf("not engines engine")
+	}
+
+	log, _ := logger.NewLogger("", sctx.SubModName)
+	obj := &Web3Server{
+		scfg:     scfg,
+		engine:   engine,
+		log:      log,
+		ethTxs:   cache.NewLRUCache(ethTxCacheSize),
+		isInit:   true,
+		exitOnce: &sync.Once{},
+	}
+	obj.handlers = map[string]handlerFunc{
+		"web3_clientVersion":        obj.clientVersion,
+		"net_version":               obj.netVersion,
+		"eth_chainId":               obj.chainId,
+		"eth_blockNumber":           obj.blockNumber,
+		"eth_getBalance":            obj.getBalance,
+		"eth_getTransactionCount":   obj.getTransactionCount,
+		"eth_call":                  obj.call,
+		"eth_sendRawTransaction":    obj.sendRawTransaction,
+		"eth_getTransactionReceipt": obj.getTransactionReceipt,
+		"eth_getLogs":               obj.getLogs,
+	}
+
+	return obj, nil
+}
+
+// 启动web3服务，阻塞直到退出
+func (t *Web3Server) Run() error {
+	if !t.isInit {
+		return errors.New("web3 server not init")
+	}
+
+	t.server = &http.Server{
+		Addr:    fmt.Sprintf(":%d", t.scfg.Web3Port),
+		Handler: t,
+	}
+	err := t.server.ListenAndServe()
+	if err != http.ErrServerClosed {
+		t.log.Error("web3 server abnormal exit", "err", err)
+		return err
+	}
+
+	t.log.Debug("web3 server exit")
+	return nil
+}
+
+// 退出web3服务，释放相关资源，需要幂等
+func (t *Web3Server) Exit() {
+	if !t.isInit {
+		return
+	}
+
+	t.exitOnce.Do(func() {
+		if t.server != nil {
+			t.server.Shutdown(context.Background())
+		}
+	})
+}
+
+func (t *Web3Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if t.scfg.AdapterAllowCROS {
+		if origin := r.Header.Get("Origin"); origin != "" {
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+			w.Header().Set("Access-Control-Allow-Headers", "Content-Type,Accept")
+			w.Header().Set("Access-Control-Allow-Methods", "POST,OPTIONS")
+		}
+	}
+	if r.Method == http.MethodOptions {
+		return
+	}
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestSize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		return
+	}
+	clientIp, _, _ := net.SplitHostPort(r.RemoteAddr)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(t.handleBody(body, clientIp))
+}
+
+// handleBody 处理单个请求或批量请求
+func (t *Web3Server) handleBody(body []byte, clientIp string) interface{} {
+	body = bytes.TrimSpace(body)
+	if len(body) > 0 && body[0] == '[' {
+		var reqs []*rpcRequest
+		if err := json.Unmarshal(body, &reqs); err != nil {
+			return errorResponse(nil, newRPCError(errCodeParse, "parse error: %v", err))
+		}
+		if len(reqs) == 0 {
+			return errorResponse(nil, newRPCError(errCodeInvalidRequest, "empty batch"))
+		}
+		resps := make([]interface{}, 0, len(reqs))
+		for _, req := range reqs {
+			resps = append(resps, t.handleRequest(req, clientIp))
+		}
+		return resps
+	}
+
+	req := &rpcRequest{}
+	if err := json.Unmarshal(body, req); err != nil {
+		return errorResponse(nil, newRPCError(errCodeParse, "parse error: %v", err))
+	}
+	return t.handleRequest(req, clientIp)
+}
+
+func (t *Web3Server) handleRequest(req *rpcRequest, clientIp string) (resp interface{}) {
+	if req == nil || req.JSONRPC != jsonRPCVersion || req.Method == "" {
+		return errorResponse(nil, newRPCError(errCodeInvalidRequest, "invalid request"))
+	}
+	handler, ok := t.handlers[req.Method]
+	if !ok {
+		return errorResponse(req.ID, newRPCError(errCodeMethodNotFound,
+			"the method %s does not exist/is not available", req.Method))
+	}
+
+	rctx, err := sctx.NewReqCtx(t.engine, utils.GenLogId(), clientIp)
+	if err != nil {
+		return errorResponse(req.ID, newRPCError(errCodeInternal, "create request context failed"))
+	}
+	defer func() {
+		if e := recover(); e != nil {
+			rctx.GetLog().Error("web3 server happen panic", "method", req.Method, "error", e)
+			resp = errorResponse(req.ID, newRPCError(errCodeInternal, "internal error"))
+		}
+		rctx.GetLog().Info("web3 access", "client_ip", clientIp, "method", req.Method,
+			"cost_time", rctx.GetTimer().Print())
+	}()
+
+	result, err := handler(rctx, req.Params)
+	if err != nil {
+		return errorResponse(req.ID, toRPCError(err))
+	}
+	return &rpcResponse{
+		JSONRPC: jsonRPCVersion,
+		ID:      req.ID,
+		Result:  result,
+	}
+}
+
+func errorResponse(id json.RawMessage, err *rpcError) *rpcErrorResponse {
+	if id == nil {
+		id = json.RawMessage("null")
+	}
+	return &rpcErrorResponse{
+		JSONRPC: jsonRPCVersion,
+		ID:      id,
+		Error:   err,
+	}
+}
+
+func toRPCError(err error) *rpcError {
+	if rerr, ok := err.(*rpcError); ok {
+		return rerr
+	}
+	return newRPCError(errCodeServer, "%s", err.Error())
+}
@@ -0,0 +1,151 @@
+package web3
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	sctx "github.com/wooyang2018/corechain/example/base"
+	"github.com/wooyang2018/corechain/protos"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestHandleBody(t *testing.T) {
+	serv := &Web3Server{
+		scfg:     sctx.GetDefServConf(),
+		handlers: map[string]handlerFunc{},
+	}
+
+	cases := []struct {
+		body string
+		code int
+	}{
+		{`{"jsonrpc":"2.0","id":1,"method":"eth_unknown"}`, errCodeMethodNotFound},
+		{`{"jsonrpc":"1.0","id":1,"method":"eth_chainId"}`, errCodeInvalidRequest},
+		{`{"jsonrpc":"2.0","id":1`, errCodeParse},
+		{`[]`, errCodeInvalidRequest},
+	}
+	for _, c := range cases {
+		resp, ok := serv.handleBody([]byte(c.body), "127.0.0.1").(*rpcErrorResponse)
+		if !ok {
+			t.Fatalf("body %s expect error response", c.body)
+		}
+		if resp.Error.Code != c.code {
+			t.Errorf("body %s expect code %d, got %d", c.body, c.code, resp.Error.Code)
+		}
+	}
+
+	batch := `[{"jsonrpc":"2.0","id":1,"method":"a"},{"jsonrpc":"2.0","id":2,"method":"b"}]`
+	resps, ok := serv.handleBody([]byte(batch), "127.0.0.1").([]interface{})
+	if !ok || len(resps) != 2 {
+		t.Fatalf("expect 2 batch responses, got %v", resps)
+	}
+	if string(resps[1].(*rpcErrorResponse).ID) != "2" {
+		t.Errorf("batch response id mismatch")
+	}
+}
+
+func TestParsePositionalParams(t *testing.T) {
+	var addr, tag string
+	err := parsePositionalParams(json.RawMessage(`["0x01","latest"]`), &addr, &tag)
+	if err != nil || addr != "0x01" || tag != "latest" {
+		t.Fatalf("parse params failed, addr:%s tag:%s err:%v", addr, tag, err)
+	}
+	if err := parsePositionalParams(json.RawMessage(`["a","b","c"]`), &addr, &tag); err == nil {
+		t.Error("expect error for too many params")
+	}
+	if err := parsePositionalParams(json.RawMessage(`{}`), &addr); err == nil {
+		t.Error("expect error for object params")
+	}
+}
+
+func TestHexCodec(t *testing.T) {
+	if s := encodeUint64(0); s != "0x0" {
+		t.Errorf("encode 0 got %s", s)
+	}
+	if s := encodeBig(big.NewInt(255)); s != "0xff" {
+		t.Errorf("encode 255 got %s", s)
+	}
+	buf, err := decodeBytes("0x123")
+	if err != nil || len(buf) != 2 || buf[0] != 0x01 || buf[1] != 0x23 {
+		t.Errorf("decode odd length hex failed, got %x err:%v", buf, err)
+	}
+
+	for s, expect := range map[string]int64{"latest": 10, "earliest": 0, "0x5": 5, "": 10} {
+		height, err := parseBlockNumber(s, 10)
+		if err != nil || height != expect {
+			t.Errorf("parse block number %s expect %d, got %d err:%v", s, expect, height, err)
+		}
+	}
+	if _, err := parseBlockNumber("5", 10); err == nil {
+		t.Error("expect error for block number without 0x prefix")
+	}
+}
+
+func TestLogFilter(t *testing.T) {
+	log := &rpcLog{
+		Address: "0x313131312d2d2d2d2d2d2d2d2d2d636f756e746572",
+		Topics:  []string{"0xaa", "0xbb"},
+	}
+
+	cases := []struct {
+		query string
+		match bool
+	}{
+		{`{}`, true},
+		{`{"address":"0x313131312D2D2D2D2D2D2D2D2D2D636F756E746572"}`, true},
+		{`{"address":["0x01","0x313131312d2d2d2d2d2d2d2d2d2d636f756e746572"]}`, true},
+		{`{"address":"0x01"}`, false},
+		{`{"topics":["0xaa"]}`, true},
+		{`{"topics":[null,["0xcc","0xbb"]]}`, true},
+		{`{"topics":[null,"0xcc"]}`, false},
+		{`{"topics":["0xaa","0xbb","0xcc"]}`, false},
+	}
+	for _, c := range cases {
+		query := &filterQuery{}
+		if err := json.Unmarshal([]byte(c.query), query); err != nil {
+			t.Fatal(err)
+		}
+		filter, err := newLogFilter(query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if filter.match(log) != c.match {
+			t.Errorf("query %s expect match %v", c.query, c.match)
+		}
+	}
+}
+
+func TestSendRLPTransaction(t *testing.T) {
+	serv := &Web3Server{scfg: sctx.GetDefServConf()}
+	send := func(raw string) error {
+		params, _ := json.Marshal([]string{raw})
+		_, err := serv.sendRawTransaction(nil, params)
+		return err
+	}
+	expectCode := func(raw string, code int, msg string) {
+		err := send(raw)
+		if rpcErr, ok := err.(*rpcError); !ok || rpcErr.Code != code || !strings.Contains(rpcErr.Message, msg) {
+			t.Errorf("raw tx %s expect error %d %q, got %v", raw, code, msg, err)
+		}
+	}
+
+	// EIP-155示例交易，由私钥0x4646...46对链id为1的转账签名
+	signed := "0xf86c098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a7640000" +
+		"8025a028ef61340bd939bc2195fe537567866003e1a15d3c71ff63e1590620aa636276a067cbe9d8997f761aecb7" +
+		"03304b3800ccf555c9f3dc64214b297fb1966a3b6d83"
+	expectCode(signed, errCodeInvalidParams, "invalid chain id 1")
+	// 链id一致时恢复出发送者，接收地址不是合约
+	serv.scfg.Web3ChainID = 1
+	expectCode(signed, errCodeInvalidParams, "to address is not a contract")
+
+	// 截断的交易和EIP-1559类型交易
+	expectCode(signed[:len(signed)-10], errCodeInvalidParams, "invalid rlp transaction")
+	expectCode("0x02f8720181", errCodeUnsupported, "typed ethereum transaction")
+
+	buf, _ := proto.Marshal(&protos.Transaction{Txid: []byte("txid"), Nonce: "1"})
+	if isRLPTransaction(buf) {
+		t.Error("protobuf transaction should not be treated as rlp")
+	}
+}
@@ -0,0 +1,181 @@
+package web3
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+const jsonRPCVersion = "2.0"
+
+// 标准JSON-RPC 2.0错误码
+const (
+	errCodeParse          = -32700
+	errCodeInvalidRequest = -32600
+	errCodeMethodNotFound = -32601
+	errCodeInvalidParams  = -32602
+	errCodeInternal       = -32603
+	// 以太坊约定的服务端执行错误码
+	errCodeServer = -32000
+	// 以太坊约定的请求不支持错误码
+	errCodeUnsupported = -32004
+)
+
+// 区块标签
+const (
+	blockTagLatest   = "latest"
+	blockTagEarliest = "earliest"
+	blockTagPending  = "pending"
+)
+
+type rpcRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+type rpcResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  interface{}     `json:"result"`
+}
+
+type rpcErrorResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Error   *rpcError       `json:"error"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *rpcError) Error() string {
+	return fmt.Sprintf("code:%d msg:%s", e.Code, e.Message)
+}
+
+func newRPCError(code int, format string, args ...interface{}) *rpcError {
+	return &rpcError{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+// callArgs eth_call的交易参数
+type callArgs struct {
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Gas   string `json:"gas"`
+	Value string `json:"value"`
+	Data  string `json:"data"`
+	Input string `json:"input"`
+}
+
+// filterQuery eth_getLogs的过滤条件
+type filterQuery struct {
+	BlockHash string          `json:"blockHash"`
+	FromBlock string          `json:"fromBlock"`
+	ToBlock   string          `json:"toBlock"`
+	Address   json.RawMessage `json:"address"`
+	Topics    []interface{}   `json:"topics"`
+}
+
+type rpcLog struct {
+	Address          string   `json:"address"`
+	Topics           []string `json:"topics"`
+	Data             string   `json:"data"`
+	BlockNumber      string   `json:"blockNumber"`
+	BlockHash        string   `json:"blockHash"`
+	TransactionHash  string   `json:"transactionHash"`
+	TransactionIndex string   `json:"transactionIndex"`
+	LogIndex         string   `json:"logIndex"`
+	Removed          bool     `json:"removed"`
+}
+
+type rpcReceipt struct {
+	TransactionHash   string    `json:"transactionHash"`
+	TransactionIndex  string    `json:"transactionIndex"`
+	BlockHash         string    `json:"blockHash"`
+	BlockNumber       string    `json:"blockNumber"`
+	From              string    `json:"from"`
+	To                *string   `json:"to"`
+	ContractAddress   *string   `json:"contractAddress"`
+	CumulativeGasUsed string    `json:"cumulativeGasUsed"`
+	GasUsed           string    `json:"gasUsed"`
+	Logs              []*rpcLog `json:"logs"`
+	LogsBloom         string    `json:"logsBloom"`
+	Status            string    `json:"status"`
+}
+
+// parsePositionalParams 按位置解析JSON-RPC数组参数，参数数量可以少于目标数量
+func parsePositionalParams(params json.RawMessage, targets ...interface{}) error {
+	var raws []json.RawMessage
+	if len(params) > 0 {
+		if err := json.Unmarshal(params, &raws); err != nil {
+			return newRPCError(errCodeInvalidParams, "params must be an array")
+		}
+	}
+	if len(raws) > len(targets) {
+		return newRPCError(errCodeInvalidParams, "too many params, want at most %d", len(targets))
+	}
+	for i, raw := range raws {
+		if err := json.Unmarshal(raw, targets[i]); err != nil {
+			return newRPCError(errCodeInvalidParams, "invalid param %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+func encodeBytes(b []byte) string {
+	return "0x" + hex.EncodeToString(b)
+}
+
+func encodeUint64(n uint64) string {
+	return "0x" + strconv.FormatUint(n, 16)
+}
+
+func encodeBig(n *big.Int) string {
+	if n.Sign() < 0 {
+		return "-0x" + new(big.Int).Neg(n).Text(16)
+	}
+	return "0x" + n.Text(16)
+}
+
+func decodeBytes(s string) ([]byte, error) {
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+	if len(s)%2 == 1 {
+		s = "0" + s
+	}
+	return hex.DecodeString(s)
+}
+
+func decodeBig(s string) (*big.Int, error) {
+	if !strings.HasPrefix(s, "0x") && !strings.HasPrefix(s, "0X") {
+		return nil, fmt.Errorf("hex number %s without 0x prefix", s)
+	}
+	n, ok := new(big.Int).SetString(s[2:], 16)
+	if !ok {
+		return nil, fmt.Errorf("invalid hex number %s", s)
+	}
+	return n, nil
+}
+
+// parseBlockNumber 解析区块高度或标签，latest和pending均视为当前最新高度
+func parseBlockNumber(s string, latest int64) (int64, error) {
+	switch s {
+	case "", blockTagLatest, blockTagPending:
+		return latest, nil
+	case blockTagEarliest:
+		return 0, nil
+	}
+	n, err := decodeBig(s)
+	if err != nil {
+		return 0, err
+	}
+	if !n.IsInt64() || n.Int64() < 0 {
+		return 0, fmt.Errorf("block number %s out of range", s)
+	}
+	return n.Int64(), nil
+}
@@ -3,6 +3,7 @@ package utils
 import (
 	"fmt"
 
+	"github.com/wooyang2018/corechain/crypto/core/hash"
 	"github.com/wooyang2018/corechain/example/pb"
 	"github.com/wooyang2018/corechain/protos"
 	"google.golang.org/protobuf/proto"
@@ -349,3 +350,81 @@ func UtxoMetaToXchain(meta *protos.UtxoMeta) *pb.UtxoMeta {
 
 	return &tmp
 }
+
+func PrivatePayloadToXledger(reqs []*pb.InvokeRequest, outputs []*pb.TxOutputExt) (*protos.PrivatePayload, error) {
+	newReqs, err := ConvertInvokeReq(reqs)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := &protos.PrivatePayload{ContractRequests: newReqs}
+	for _, output := range outputs {
+		buf, err := proto.Marshal(output)
+		if err != nil {
+			return nil, err
+		}
+
+		var tmp protos.TxOutputExt
+		err = proto.Unmarshal(buf, &tmp)
+		if err != nil {
+			return nil, err
+		}
+
+		payload.TxOutputsExt = append(payload.TxOutputsExt, &tmp)
+	}
+
+	return payload, nil
+}
+
+func PrivatePayloadToXchain(payload *protos.PrivatePayload) ([]*pb.InvokeRequest, []*pb.TxOutputExt, error) {
+	if payload == nil {
+		return nil, nil, nil
+	}
+
+	reqs := make([]*pb.InvokeRequest, 0, len(payload.ContractRequests))
+	for _, req := range payload.ContractRequests {
+		buf, err := proto.Marshal(req)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var tmp pb.InvokeRequest
+		err = proto.Unmarshal(buf, &tmp)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		reqs = append(reqs, &tmp)
+	}
+
+	outputs := make([]*pb.TxOutputExt, 0, len(payload.TxOutputsExt))
+	for _, output := range payload.TxOutputsExt {
+		buf, err := proto.Marshal(output)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var tmp pb.TxOutputExt
+		err = proto.Unmarshal(buf, &tmp)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		outputs = append(outputs, &tmp)
+	}
+
+	return reqs, outputs, nil
+}
+
+// PrivateTxRequestDigest 私有交易请求的签名摘要，不包含header和签名本身
+func PrivateTxRequestDigest(req *pb.PrivateTxRequest) ([]byte, error) {
+	tmp := proto.Clone(req).(*pb.PrivateTxRequest)
+	tmp.Header = nil
+	tmp.UserSign = nil
+	buf, err := proto.MarshalOptions{Deterministic: true}.Marshal(tmp)
+	if err != nil {
+		return nil, err
+	}
+
+	return hash.DoubleSha256(buf), nil
+}
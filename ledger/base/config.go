@@ -16,6 +16,17 @@ type XLedgerConf struct {
 	BlockCacheSize int        `yaml:"blockCacheSize,omitempty"`
 	TxCacheSize    int        `yaml:"txCacheSize,omitempty"`
 	MempoolTxLimit int        `yaml:"mempoolTxLimit,omitempty"`
+	// 时间锁未到期交易的暂存池配置，暂存交易不持久化，节点重启后丢失
+	LockedPool LockedPoolConfig `yaml:"lockedPool,omitempty"`
+}
+
+type LockedPoolConfig struct {
+	// 交易锁定高度与下一区块高度的最大距离，0表示不限制
+	MaxLockHeight int64 `yaml:"maxLockHeight,omitempty"`
+	// 交易锁定时间与当前时间的最大距离(秒)，0表示不限制
+	MaxLockSeconds int64 `yaml:"maxLockSeconds,omitempty"`
+	// 单个发起人最多暂存的交易数，0表示不限制
+	MaxTxPerInitiator int `yaml:"maxTxPerInitiator,omitempty"`
 }
 
 type UtxoConfig struct {
@@ -42,6 +53,11 @@ func GetDefLedgerConf() *XLedgerConf {
 			CacheSize:      100000,
 			TmpLockSeconds: 60,
 		},
+		LockedPool: LockedPoolConfig{
+			MaxLockHeight:     100000,
+			MaxLockSeconds:    7 * 24 * 3600,
+			MaxTxPerInitiator: 100,
+		},
 	}
 }
 
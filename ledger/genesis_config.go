@@ -47,6 +47,8 @@ type GenesisConfig struct {
 	IrreversibleSlideWindow string `json:"irreversibleslidewindow"`
 	// GroupChainContract
 	GroupChainContract InvokeRequest `json:"group_chain_contract"`
+	// EVMChainID 以太坊钱包签名交易绑定的链id，为0时不接受钱包签名的交易
+	EVMChainID uint64 `json:"evm_chain_id"`
 }
 
 // InvokeRequest define genesis reserved_contracts configure
@@ -1239,6 +1239,20 @@ func (l *Ledger) Truncate(utxovmLastID []byte) error {
 
 // VerifyBlock verify block
 func (l *Ledger) VerifyBlock(block *protos.InternalBlock, logid string) (bool, error) {
+	if ok, err := l.VerifyBlockHeader(block, logid); !ok {
+		return ok, err
+	}
+
+	errv := VerifyMerkle(block)
+	if errv != nil {
+		l.xlog.Warn("VerifyMerkle error", "logid", logid, "error", errv)
+		return false, nil
+	}
+	return true, nil
+}
+
+// VerifyBlockHeader verify blockid and proposer signature, transactions are not required
+func (l *Ledger) VerifyBlockHeader(block *protos.InternalBlock, logid string) (bool, error) {
 	blkid, err := MakeBlockID(block)
 	if err != nil {
 		l.xlog.Warn("VerifyBlock MakeBlockID error", "logid", logid, "error", err)
@@ -1250,12 +1264,6 @@ func (l *Ledger) VerifyBlock(block *protos.InternalBlock, logid string) (bool, e
 		return false, nil
 	}
 
-	errv := VerifyMerkle(block)
-	if errv != nil {
-		l.xlog.Warn("VerifyMerkle error", "logid", logid, "error", errv)
-		return false, nil
-	}
-
 	k, err := l.cryptoClient.GetEcdsaPublicKeyFromJsonStr(string(block.Pubkey))
 	if err != nil {
 		l.xlog.Warn("VerifyBlock get ecdsa from block error", "logid", logid, "error", err)
@@ -83,6 +83,40 @@ func MakeMerkleTree(txList []*protos.Transaction) [][]byte {
 	return tree
 }
 
+// MakeMerkleProof 生成叶子节点index到merkle根的路径，缺失的右兄弟节点以空值表示
+func MakeMerkleProof(tree [][]byte, index int) ([][]byte, error) {
+	leafSize := (len(tree) + 1) / 2
+	if index < 0 || index >= leafSize || tree[index] == nil {
+		return nil, fmt.Errorf("leaf %d not found in merkle tree", index)
+	}
+	var path [][]byte
+	for offset, n := 0, leafSize; n > 1; offset, n = offset+n, n/2 {
+		path = append(path, tree[offset+(index^1)])
+		index /= 2
+	}
+	return path, nil
+}
+
+// VerifyMerkleProof 校验叶子节点经path计算得到的根是否与root一致
+func VerifyMerkleProof(leaf, root []byte, index int, path [][]byte) bool {
+	cur := leaf
+	for _, sibling := range path {
+		if index&1 == 0 {
+			if len(sibling) == 0 {
+				sibling = cur
+			}
+			cur = merkleDoubleSha256(cur, sibling, nil)
+		} else {
+			if len(sibling) == 0 {
+				return false
+			}
+			cur = merkleDoubleSha256(sibling, cur, nil)
+		}
+		index /= 2
+	}
+	return index == 0 && bytes.Equal(cur, root)
+}
+
 // // FastMakeMerkleTree generate merkele-tree
 // func FastMakeMerkleTree(txList []*protos.Transaction) [][]byte {
 // 	txCount := len(txList)
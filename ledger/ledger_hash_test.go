@@ -21,6 +21,34 @@ func TestMerkleHash(t *testing.T) {
 	}
 }
 
+func TestMerkleProof(t *testing.T) {
+	for _, count := range []int{1, 2, 5, 8} {
+		var txs []*protos.Transaction
+		for i := 0; i < count; i++ {
+			buf := make([]byte, 32)
+			rand.Read(buf)
+			txs = append(txs, &protos.Transaction{Txid: buf})
+		}
+		tree := MakeMerkleTree(txs)
+		root := tree[len(tree)-1]
+		for i, tx := range txs {
+			path, err := MakeMerkleProof(tree, i)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !VerifyMerkleProof(tx.Txid, root, i, path) {
+				t.Fatalf("verify proof failed, count:%d index:%d", count, i)
+			}
+			if count > 1 && VerifyMerkleProof(tx.Txid, root, (i+1)%count, path) {
+				t.Fatalf("proof should be bound to index, count:%d index:%d", count, i)
+			}
+		}
+		if _, err := MakeMerkleProof(tree, count); count&(count-1) != 0 && err == nil {
+			t.Fatalf("proof for empty leaf should fail, count:%d", count)
+		}
+	}
+}
+
 func BenchmarkNormalMerkle(b *testing.B) {
 	var txs []*protos.Transaction
 	for i := 0; i < 10000; i++ {
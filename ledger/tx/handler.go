@@ -42,7 +42,8 @@ type TxHandler struct {
 	ledger            *ledger.Ledger
 	maxConfirmedDelay uint32
 
-	Mempool *Mempool
+	Mempool    *Mempool
+	LockedPool *LockedPool
 }
 
 // RootJSON xuper.json对应的struct，目前先只写了utxovm关注的字段
@@ -68,6 +69,7 @@ func NewTxHandler(sctx *base.StateCtx, stateDB storage.Database) (*TxHandler, er
 	}
 	m := NewMempool(tx, tx.log, sctx.LedgerCfg.MempoolTxLimit)
 	tx.Mempool = m
+	tx.LockedPool = NewLockedPool(sctx.LedgerCfg.MempoolTxLimit, sctx.LedgerCfg.LockedPool)
 	return tx, nil
 }
 
@@ -0,0 +1,259 @@
+package tx
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+
+	ledgerBase "github.com/wooyang2018/corechain/ledger/base"
+	"github.com/wooyang2018/corechain/protos"
+)
+
+var (
+	// ErrLockedPoolFull locked pool is full when put tx.
+	ErrLockedPoolFull = errors.New("the time locked tx pool is full")
+	// ErrLockDistanceTooLarge tx is locked too far from current height or time.
+	ErrLockDistanceTooLarge = errors.New("the tx lock height or lock time is too far")
+	// ErrInitiatorLockedTxLimit initiator holds too many txs in locked pool.
+	ErrInitiatorLockedTxLimit = errors.New("the initiator has too many time locked txs")
+)
+
+const (
+	// LockHeightArg 合约请求可携带的保留参数，声明交易最早打包高度，不能超过交易自身的lock_height
+	LockHeightArg = "$lock_height"
+	// ExpireHeightArg 合约请求可携带的保留参数，声明交易最晚打包高度，超过该高度的区块不能包含此交易
+	ExpireHeightArg = "$expire_height"
+)
+
+// CheckLockHeightArgs 校验合约请求中的$lock_height和$expire_height参数，合约可据此确定执行时区块高度的上下限
+func CheckLockHeightArgs(tx *protos.Transaction) bool {
+	for _, req := range tx.GetContractRequests() {
+		if buf, ok := req.GetArgs()[LockHeightArg]; ok {
+			height, err := strconv.ParseInt(string(buf), 10, 64)
+			if err != nil || height < 0 || height > tx.GetLockHeight() {
+				return false
+			}
+		}
+		if buf, ok := req.GetArgs()[ExpireHeightArg]; ok {
+			height, err := strconv.ParseInt(string(buf), 10, 64)
+			if err != nil || height < tx.GetLockHeight() {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// IsTxExpired 判断交易是否已不能在指定区块高度打包，$expire_height非法的交易视为已过期
+func IsTxExpired(tx *protos.Transaction, height int64) bool {
+	for _, req := range tx.GetContractRequests() {
+		buf, ok := req.GetArgs()[ExpireHeightArg]
+		if !ok {
+			continue
+		}
+		expireHeight, err := strconv.ParseInt(string(buf), 10, 64)
+		if err != nil || expireHeight < height {
+			return true
+		}
+	}
+	return false
+}
+
+// IsTxMature 判断交易在指定区块高度和时间(unix秒)下是否已满足时间锁
+func IsTxMature(tx *protos.Transaction, height, timestamp int64) bool {
+	return tx.GetLockHeight() <= height && tx.GetLockTime() <= timestamp
+}
+
+// FilterMatureTxs 过滤在指定高度和时间下时间锁未到期或已过期的交易以及依赖它们的交易，txs需按依赖顺序排列
+func FilterMatureTxs(txs []*protos.Transaction, height, timestamp int64) []*protos.Transaction {
+	immature := make(map[string]bool)
+	dependOnImmature := func(tx *protos.Transaction) bool {
+		for _, input := range tx.GetTxInputs() {
+			if immature[string(input.GetRefTxid())] {
+				return true
+			}
+		}
+		for _, input := range tx.GetTxInputsExt() {
+			if immature[string(input.GetRefTxid())] {
+				return true
+			}
+		}
+		return false
+	}
+
+	mature := make([]*protos.Transaction, 0, len(txs))
+	for _, tx := range txs {
+		if !IsTxMature(tx, height, timestamp) || IsTxExpired(tx, height) || dependOnImmature(tx) {
+			immature[string(tx.GetTxid())] = true
+			continue
+		}
+		mature = append(mature, tx)
+	}
+	return mature
+}
+
+// LockedPool 暂存时间锁尚未到期的交易，类似孤儿交易，这些交易不执行也不进入mempool，
+// 到期后按照到达顺序释放重新执行，依赖这些交易输出的子交易也一并暂存
+// 暂存交易只保存在内存中，节点重启后丢失，需要用户在到期后重新提交
+type LockedPool struct {
+	txLimit        int
+	initiatorLimit int
+	maxLockHeight  int64
+	maxLockTime    int64
+	txs            []*protos.Transaction
+	txids          map[string]bool
+	initiators     map[string]int
+	mutex          sync.Mutex
+}
+
+// NewLockedPool new locked pool.
+func NewLockedPool(txLimit int, cfg ledgerBase.LockedPoolConfig) *LockedPool {
+	if txLimit <= 0 {
+		txLimit = defaultMaxtxLimit
+	}
+	return &LockedPool{
+		txLimit:        txLimit,
+		initiatorLimit: cfg.MaxTxPerInitiator,
+		maxLockHeight:  cfg.MaxLockHeight,
+		maxLockTime:    cfg.MaxLockSeconds,
+		txids:          make(map[string]bool),
+		initiators:     make(map[string]int),
+	}
+}
+
+// Put put tx into locked pool.
+// height和timestamp为下一个区块的高度和当前时间，时间锁距离超过配置上限的交易直接拒绝；
+// 暂存池已满时，从暂存交易最多的其他发起人中驱逐最晚到达的交易及依赖它的子交易
+func (p *LockedPool) Put(tx *protos.Transaction, height, timestamp int64) error {
+	if p.maxLockHeight > 0 && tx.GetLockHeight()-height > p.maxLockHeight {
+		return ErrLockDistanceTooLarge
+	}
+	if p.maxLockTime > 0 && tx.GetLockTime()-timestamp > p.maxLockTime {
+		return ErrLockDistanceTooLarge
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.txids[string(tx.Txid)] {
+		return ErrTxExist
+	}
+	if p.initiatorLimit > 0 && p.initiators[tx.GetInitiator()] >= p.initiatorLimit {
+		return ErrInitiatorLockedTxLimit
+	}
+	if len(p.txs) >= p.txLimit && !p.evict(tx.GetInitiator()) {
+		return ErrLockedPoolFull
+	}
+	p.txs = append(p.txs, tx)
+	p.txids[string(tx.Txid)] = true
+	p.initiators[tx.GetInitiator()]++
+	return nil
+}
+
+// evict 驱逐暂存交易最多的发起人最晚到达的交易，该发起人不能是新交易的发起人且暂存数需多于新交易的发起人
+func (p *LockedPool) evict(initiator string) bool {
+	victim, most := "", p.initiators[initiator]
+	for name, cnt := range p.initiators {
+		if name != initiator && cnt > most {
+			victim, most = name, cnt
+		}
+	}
+	if most == p.initiators[initiator] {
+		return false
+	}
+
+	for i := len(p.txs) - 1; i >= 0; i-- {
+		if p.txs[i].GetInitiator() != victim {
+			continue
+		}
+		// 依赖被驱逐交易的子交易无法再执行，一并驱逐
+		evicted := map[string]bool{string(p.txs[i].Txid): true}
+		remain := p.txs[:0]
+		for _, tx := range p.txs {
+			if evicted[string(tx.Txid)] || p.dependOn(tx, evicted) {
+				evicted[string(tx.Txid)] = true
+				p.removeIndex(tx)
+				continue
+			}
+			remain = append(remain, tx)
+		}
+		for j := len(remain); j < len(p.txs); j++ {
+			p.txs[j] = nil
+		}
+		p.txs = remain
+		return true
+	}
+	return false
+}
+
+func (p *LockedPool) dependOn(tx *protos.Transaction, txids map[string]bool) bool {
+	for _, input := range tx.GetTxInputs() {
+		if txids[string(input.GetRefTxid())] {
+			return true
+		}
+	}
+	for _, input := range tx.GetTxInputsExt() {
+		if txids[string(input.GetRefTxid())] {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *LockedPool) removeIndex(tx *protos.Transaction) {
+	delete(p.txids, string(tx.Txid))
+	p.initiators[tx.GetInitiator()]--
+	if p.initiators[tx.GetInitiator()] <= 0 {
+		delete(p.initiators, tx.GetInitiator())
+	}
+}
+
+// Has return true if tx in locked pool.
+func (p *LockedPool) Has(txid string) bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return p.txids[txid]
+}
+
+// DependOnLocked return true if tx refers outputs of any tx in locked pool.
+func (p *LockedPool) DependOnLocked(tx *protos.Transaction) bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return p.dependOnLocked(tx)
+}
+
+func (p *LockedPool) dependOnLocked(tx *protos.Transaction) bool {
+	if len(p.txids) == 0 {
+		return false
+	}
+	return p.dependOn(tx, p.txids)
+}
+
+// Release 取出在指定高度和时间下已到期且不依赖其他暂存交易的交易，按到达顺序返回
+func (p *LockedPool) Release(height, timestamp int64) []*protos.Transaction {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	var released []*protos.Transaction
+	remain := p.txs[:0]
+	for _, tx := range p.txs {
+		// 前面释放的父交易已从txids中删除，子交易可在同一轮释放
+		if !IsTxMature(tx, height, timestamp) || p.dependOnLocked(tx) {
+			remain = append(remain, tx)
+			continue
+		}
+		released = append(released, tx)
+		p.removeIndex(tx)
+	}
+	for i := len(remain); i < len(p.txs); i++ {
+		p.txs[i] = nil
+	}
+	p.txs = remain
+	return released
+}
+
+// Len return the count of txs in locked pool.
+func (p *LockedPool) Len() int {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return len(p.txs)
+}
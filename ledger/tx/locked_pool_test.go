@@ -0,0 +1,172 @@
+package tx
+
+import (
+	"testing"
+
+	ledgerBase "github.com/wooyang2018/corechain/ledger/base"
+	"github.com/wooyang2018/corechain/protos"
+)
+
+func TestLockedPoolRelease(t *testing.T) {
+	p := NewLockedPool(3, ledgerBase.LockedPoolConfig{})
+	parent := &protos.Transaction{Txid: []byte("parent"), LockHeight: 10}
+	child := &protos.Transaction{
+		Txid:     []byte("child"),
+		TxInputs: []*protos.TxInput{{RefTxid: []byte("parent")}},
+	}
+	timed := &protos.Transaction{Txid: []byte("timed"), LockTime: 1000}
+
+	for _, tx := range []*protos.Transaction{parent, child, timed} {
+		if err := p.Put(tx, 0, 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := p.Put(parent, 0, 0); err != ErrTxExist {
+		t.Fatalf("expect ErrTxExist, got %v", err)
+	}
+	if err := p.Put(&protos.Transaction{Txid: []byte("other")}, 0, 0); err != ErrLockedPoolFull {
+		t.Fatalf("expect ErrLockedPoolFull, got %v", err)
+	}
+	if !p.DependOnLocked(child) {
+		t.Fatal("child should depend on locked parent")
+	}
+
+	// 父交易未到期，子交易不能释放
+	if released := p.Release(9, 2000); len(released) != 1 || string(released[0].Txid) != "timed" {
+		t.Fatalf("unexpected released txs %v", released)
+	}
+	released := p.Release(10, 0)
+	if len(released) != 2 || string(released[0].Txid) != "parent" || string(released[1].Txid) != "child" {
+		t.Fatalf("unexpected released txs %v", released)
+	}
+	if p.Len() != 0 || p.Has("parent") {
+		t.Fatal("locked pool should be empty")
+	}
+}
+
+func TestLockedPoolLimits(t *testing.T) {
+	p := NewLockedPool(3, ledgerBase.LockedPoolConfig{
+		MaxLockHeight:     10,
+		MaxLockSeconds:    100,
+		MaxTxPerInitiator: 2,
+	})
+	if err := p.Put(&protos.Transaction{Txid: []byte("far"), LockHeight: 21}, 10, 0); err != ErrLockDistanceTooLarge {
+		t.Fatalf("expect ErrLockDistanceTooLarge, got %v", err)
+	}
+	if err := p.Put(&protos.Transaction{Txid: []byte("late"), LockTime: 1101}, 10, 1000); err != ErrLockDistanceTooLarge {
+		t.Fatalf("expect ErrLockDistanceTooLarge, got %v", err)
+	}
+
+	parent := &protos.Transaction{Txid: []byte("a1"), Initiator: "alice", LockHeight: 20}
+	child := &protos.Transaction{
+		Txid:      []byte("a2"),
+		Initiator: "alice",
+		TxInputs:  []*protos.TxInput{{RefTxid: []byte("a1")}},
+	}
+	for _, tx := range []*protos.Transaction{parent, child} {
+		if err := p.Put(tx, 10, 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := p.Put(&protos.Transaction{Txid: []byte("a3"), Initiator: "alice"}, 10, 0); err != ErrInitiatorLockedTxLimit {
+		t.Fatalf("expect ErrInitiatorLockedTxLimit, got %v", err)
+	}
+	if err := p.Put(&protos.Transaction{Txid: []byte("b1"), Initiator: "bob"}, 10, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	// 暂存池已满，驱逐alice最晚到达的交易
+	if err := p.Put(&protos.Transaction{Txid: []byte("c1"), Initiator: "carol"}, 10, 0); err != nil {
+		t.Fatal(err)
+	}
+	if p.Has("a2") || !p.Has("a1") || p.Len() != 3 {
+		t.Fatal("unexpected evicted txs")
+	}
+	// 各发起人暂存数相同时不驱逐
+	if err := p.Put(&protos.Transaction{Txid: []byte("b2"), Initiator: "bob"}, 10, 0); err != ErrLockedPoolFull {
+		t.Fatalf("expect ErrLockedPoolFull, got %v", err)
+	}
+
+	// 驱逐交易时依赖它的其他发起人的子交易一并驱逐
+	p = NewLockedPool(3, ledgerBase.LockedPoolConfig{})
+	bobChild := &protos.Transaction{
+		Txid:        []byte("b1"),
+		Initiator:   "bob",
+		TxInputsExt: []*protos.TxInputExt{{RefTxid: []byte("a2")}},
+	}
+	for _, tx := range []*protos.Transaction{parent, child, bobChild} {
+		if err := p.Put(tx, 10, 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := p.Put(&protos.Transaction{Txid: []byte("c1"), Initiator: "carol"}, 10, 0); err != nil {
+		t.Fatal(err)
+	}
+	if p.Has("a2") || p.Has("b1") || !p.Has("a1") || p.Len() != 2 {
+		t.Fatal("children of evicted tx should be evicted")
+	}
+}
+
+func TestIsTxMature(t *testing.T) {
+	tx := &protos.Transaction{LockHeight: 5, LockTime: 100}
+	if IsTxMature(tx, 4, 100) || IsTxMature(tx, 5, 99) {
+		t.Fatal("tx should not be mature")
+	}
+	if !IsTxMature(tx, 5, 100) || !IsTxMature(&protos.Transaction{}, 0, 0) {
+		t.Fatal("tx should be mature")
+	}
+}
+
+func TestFilterMatureTxs(t *testing.T) {
+	parent := &protos.Transaction{Txid: []byte("parent"), LockHeight: 10}
+	child := &protos.Transaction{
+		Txid:        []byte("child"),
+		TxInputsExt: []*protos.TxInputExt{{RefTxid: []byte("parent")}},
+	}
+	other := &protos.Transaction{Txid: []byte("other"), LockTime: 100}
+
+	txs := FilterMatureTxs([]*protos.Transaction{parent, child, other}, 9, 100)
+	if len(txs) != 1 || string(txs[0].Txid) != "other" {
+		t.Fatalf("unexpected mature txs %v", txs)
+	}
+	if txs := FilterMatureTxs([]*protos.Transaction{parent, child, other}, 10, 99); len(txs) != 2 {
+		t.Fatalf("unexpected mature txs %v", txs)
+	}
+
+	expired := &protos.Transaction{
+		Txid: []byte("expired"),
+		ContractRequests: []*protos.InvokeRequest{
+			{Args: map[string][]byte{ExpireHeightArg: []byte("10")}},
+		},
+	}
+	if txs := FilterMatureTxs([]*protos.Transaction{expired}, 10, 0); len(txs) != 1 {
+		t.Fatalf("tx should be packed at expire height, got %v", txs)
+	}
+	if txs := FilterMatureTxs([]*protos.Transaction{expired}, 11, 0); len(txs) != 0 {
+		t.Fatalf("expired tx should be filtered, got %v", txs)
+	}
+}
+
+func TestCheckLockHeightArgs(t *testing.T) {
+	tx := &protos.Transaction{
+		LockHeight: 10,
+		ContractRequests: []*protos.InvokeRequest{
+			{Args: map[string][]byte{LockHeightArg: []byte("10")}},
+		},
+	}
+	if !CheckLockHeightArgs(tx) {
+		t.Fatal("lock height arg should be valid")
+	}
+	tx.ContractRequests[0].Args[LockHeightArg] = []byte("11")
+	if CheckLockHeightArgs(tx) {
+		t.Fatal("lock height arg greater than tx lock height should be invalid")
+	}
+	tx.ContractRequests[0].Args[LockHeightArg] = []byte("abc")
+	if CheckLockHeightArgs(tx) {
+		t.Fatal("malformed lock height arg should be invalid")
+	}
+	tx.ContractRequests[0].Args = map[string][]byte{ExpireHeightArg: []byte("9")}
+	if CheckLockHeightArgs(tx) {
+		t.Fatal("expire height less than tx lock height should be invalid")
+	}
+}
@@ -42,9 +42,14 @@ type Mempool struct {
 	emptyTxIDNode *Node
 	stoneNode     *Node
 
+	watcher TxWatcher // 交易进出 mempool 的观察者
+
 	mlock *sync.Mutex
 }
 
+// TxWatcher 观察交易进入和移出 mempool，在 mempool 锁内调用，实现不能阻塞。
+type TxWatcher func(tx *protos.Transaction, evicted bool)
+
 // NewMempool new mempool.
 func NewMempool(tx *TxHandler, log logger.Logger, txLimit int) *Mempool {
 	if txLimit <= 0 {
@@ -64,6 +69,19 @@ func NewMempool(tx *TxHandler, log logger.Logger, txLimit int) *Mempool {
 	return m
 }
 
+// SetTxWatcher set the watcher of tx admission and eviction.
+func (m *Mempool) SetTxWatcher(w TxWatcher) {
+	m.mlock.Lock()
+	defer m.mlock.Unlock()
+	m.watcher = w
+}
+
+func (m *Mempool) notify(tx *protos.Transaction, evicted bool) {
+	if m.watcher != nil && tx != nil {
+		m.watcher(tx, evicted)
+	}
+}
+
 // HasTx has tx in mempool.
 func (m *Mempool) HasTx(txid string) bool {
 	m.mlock.Lock()
@@ -188,7 +206,11 @@ func (m *Mempool) PutTx(tx *protos.Transaction) error {
 		}
 	}
 
-	return m.putTx(tx, false)
+	if err := m.putTx(tx, false); err != nil {
+		return err
+	}
+	m.notify(tx, false)
+	return nil
 }
 
 // FindConflictByTx 找出所有与 tx 冲突的交易。返回数组中，前面是子交易，后面是父交易。
@@ -405,13 +427,16 @@ func (m *Mempool) DeleteTxAndChildren(txid string) []*protos.Transaction { // De
 
 func (m *Mempool) deleteTx(txid string) []*protos.Transaction {
 	var (
-		node *Node
-		ok   bool
+		node    *Node
+		ok      bool
+		pending bool
 	)
 	if node, ok = m.unconfirmed[txid]; ok {
 		delete(m.unconfirmed, txid)
+		pending = true
 	} else if node, ok = m.orphans[txid]; ok {
 		delete(m.orphans, txid)
+		pending = true
 	} else if node, ok = m.confirmed[txid]; ok {
 		delete(m.confirmed, txid)
 	} else {
@@ -421,7 +446,13 @@ func (m *Mempool) deleteTx(txid string) []*protos.Transaction {
 	if node != nil {
 		m.deleteBucketKey(node)
 		node.breakOutputs()
-		return m.deleteChildrenFromNode(node)
+		deleted := m.deleteChildrenFromNode(node)
+		for _, tx := range deleted {
+			if tx != node.tx || pending {
+				m.notify(tx, true)
+			}
+		}
+		return deleted
 	}
 	return nil
 }
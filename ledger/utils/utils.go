@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	xconf "github.com/wooyang2018/corechain/common/config"
 	"github.com/wooyang2018/corechain/common/utils"
@@ -23,6 +24,8 @@ var (
 	ErrBlockChainExist = errors.New("blockchain exist")
 	// ErrCreateBlockChain is returned when create block chain error
 	ErrCreateBlockChain = errors.New("create blockchain error")
+	// ErrBlockChainNotExist is returned when archive a not existed block chain
+	ErrBlockChainNotExist = errors.New("blockchain not exist")
 )
 
 // CreateLedger 通过创世块配置文件地址创建全新账本
@@ -112,6 +115,29 @@ func createLedger(bcName string, data []byte, envCfg *xconf.EnvConf) error {
 	return nil
 }
 
+// ArchiveDir 归档链数据的目录，与链数据目录同级，避免引擎启动时被加载
+const ArchiveDir = "archive"
+
+// ArchiveLedger 将链数据目录移入归档目录并返回归档路径，调用前需先关闭该链
+func ArchiveLedger(bcName string, envCfg *xconf.EnvConf) (string, error) {
+	if bcName == "" || envCfg == nil {
+		return "", fmt.Errorf("param set error")
+	}
+	fullpath := filepath.Join(envCfg.GenDataAbsPath(envCfg.ChainDir), bcName)
+	if !utils.PathExists(fullpath) {
+		return "", ErrBlockChainNotExist
+	}
+	archiveDir := envCfg.GenDataAbsPath(ArchiveDir)
+	if err := os.MkdirAll(archiveDir, 0755); err != nil {
+		return "", err
+	}
+	target := filepath.Join(archiveDir, fmt.Sprintf("%s_%d", bcName, time.Now().Unix()))
+	if err := os.Rename(fullpath, target); err != nil {
+		return "", err
+	}
+	return target, nil
+}
+
 //GetCryptoType 解析json中的crypto字段并返回
 func GetCryptoType(data []byte) (string, error) {
 	rootJSON := map[string]interface{}{}
@@ -2,6 +2,7 @@ package utils
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 
 	mock "github.com/wooyang2018/corechain/mock/config"
@@ -25,3 +26,29 @@ func TestCreateLedger(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestArchiveLedger(t *testing.T) {
+	econf, err := mock.GetMockEnvConf()
+	if err != nil {
+		t.Fatal(err)
+	}
+	econf.RootPath = t.TempDir()
+
+	if _, err := ArchiveLedger("para", econf); err != ErrBlockChainNotExist {
+		t.Fatalf("expect ErrBlockChainNotExist, got %v", err)
+	}
+	chainPath := filepath.Join(econf.GenDataAbsPath(econf.ChainDir), "para")
+	if err := os.MkdirAll(chainPath, 0755); err != nil {
+		t.Fatal(err)
+	}
+	target, err := ArchiveLedger("para", econf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(chainPath); !os.IsNotExist(err) {
+		t.Fatal("chain data should be detached")
+	}
+	if _, err := os.Stat(target); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,229 @@
+package cluster
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	xconf "github.com/wooyang2018/corechain/common/config"
+	"github.com/wooyang2018/corechain/common/utils"
+	"github.com/wooyang2018/corechain/engine"
+	engineBase "github.com/wooyang2018/corechain/engine/base"
+	ledgerUtils "github.com/wooyang2018/corechain/ledger/utils"
+	"github.com/wooyang2018/corechain/logger"
+	mock "github.com/wooyang2018/corechain/mock/config"
+	"github.com/wooyang2018/corechain/network/memnet"
+
+	// import内核核心组件驱动
+	_ "github.com/wooyang2018/corechain/consensus/single"
+	_ "github.com/wooyang2018/corechain/consensus/xpoa"
+	_ "github.com/wooyang2018/corechain/contract/evm"
+	_ "github.com/wooyang2018/corechain/contract/kernel"
+	_ "github.com/wooyang2018/corechain/crypto/client"
+	_ "github.com/wooyang2018/corechain/storage/leveldb"
+)
+
+const (
+	// 节点密钥来自mock/testnet，最多支持3个节点
+	MaxNodes = 3
+	netConf  = "memnet.yaml"
+)
+
+var testnetDir = filepath.Join(utils.GetCurFileDir(), "../testnet")
+
+// Config 集群配置，Genesis为空时使用single共识
+type Config struct {
+	Nodes   int
+	BcName  string
+	Genesis []byte
+}
+
+// Node 集群中的一个完整引擎
+type Node struct {
+	Name   string
+	EnvCfg *xconf.EnvConf
+	Engine engineBase.Engine
+}
+
+// Cluster 在同一进程内运行多个引擎，节点之间通过memnet通信
+type Cluster struct {
+	Fabric *memnet.Fabric
+	Nodes  []*Node
+
+	root     string
+	bcName   string
+	stopOnce sync.Once
+}
+
+// NewCluster 为每个节点生成独立的数据目录并创建账本，引擎初始化完成后返回，需要调用Start启动
+func NewCluster(cfg *Config) (*Cluster, error) {
+	if cfg.Nodes <= 0 || cfg.Nodes > MaxNodes {
+		return nil, fmt.Errorf("nodes should be in [1, %d]", MaxNodes)
+	}
+	if cfg.BcName == "" {
+		cfg.BcName = "corechain"
+	}
+	if cfg.Genesis == nil {
+		cfg.Genesis = mock.GetGenesisConfBytes("single")
+	}
+
+	root, err := ioutil.TempDir("", "cluster")
+	if err != nil {
+		return nil, err
+	}
+	c := &Cluster{
+		Fabric: memnet.GetFabric(filepath.Base(root)),
+		root:   root,
+		bcName: cfg.BcName,
+	}
+	// 日志全局只有一份，所有节点共用
+	logger.InitMLog(filepath.Join(testnetDir, "node1/conf/log.yaml"), filepath.Join(root, "logger"))
+
+	for i := 1; i <= cfg.Nodes; i++ {
+		envCfg, err := c.prepare(fmt.Sprintf("node%d", i), cfg)
+		if err != nil {
+			c.Stop()
+			return nil, err
+		}
+		c.Nodes = append(c.Nodes, &Node{Name: fmt.Sprintf("node%d", i), EnvCfg: envCfg})
+	}
+
+	// 引擎初始化较慢，并发进行
+	errs := make([]error, len(c.Nodes))
+	var wg sync.WaitGroup
+	for i, node := range c.Nodes {
+		wg.Add(1)
+		go func(i int, node *Node) {
+			defer wg.Done()
+			node.Engine, errs[i] = newEngine(node.EnvCfg)
+		}(i, node)
+	}
+	wg.Wait()
+	for i, err := range errs {
+		if err != nil {
+			c.Stop()
+			return nil, fmt.Errorf("init %s failed.err:%v", c.Nodes[i].Name, err)
+		}
+	}
+	return c, nil
+}
+
+// Start 启动全部引擎
+func (c *Cluster) Start() {
+	for _, node := range c.Nodes {
+		go node.Engine.Run()
+	}
+}
+
+// Stop 关闭全部引擎并清理数据目录
+func (c *Cluster) Stop() {
+	c.stopOnce.Do(func() {
+		for _, node := range c.Nodes {
+			if node.Engine != nil {
+				node.Engine.Exit()
+			}
+		}
+		memnet.RemoveFabric(c.Fabric.Name())
+		os.RemoveAll(c.root)
+	})
+}
+
+// Chain 返回第i个节点的链实例，i从0开始
+func (c *Cluster) Chain(i int) engineBase.Chain {
+	chain, err := c.Nodes[i].Engine.Get(c.bcName)
+	if err != nil {
+		panic(err)
+	}
+	return chain
+}
+
+// BlockID 返回第i个节点主干上指定高度的区块id，不存在时返回nil
+func (c *Cluster) BlockID(i int, height int64) []byte {
+	block, err := c.Chain(i).Context().Ledger.QueryBlockHeaderByHeight(height)
+	if err != nil {
+		return nil
+	}
+	return block.GetBlockid()
+}
+
+// Height 返回第i个节点的主干高度
+func (c *Cluster) Height(i int) int64 {
+	return c.Chain(i).Context().Ledger.GetMeta().GetTrunkHeight()
+}
+
+// WaitHeight 等待第i个节点的高度不低于height
+func (c *Cluster) WaitHeight(i int, height int64, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		h := c.Height(i)
+		if h >= height {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("%s wait height %d timeout, current %d", c.Nodes[i].Name, height, h)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+// prepare 复制mock/testnet的配置和密钥，网络替换为memnet
+func (c *Cluster) prepare(name string, cfg *Config) (*xconf.EnvConf, error) {
+	src := filepath.Join(testnetDir, name)
+	dst := filepath.Join(c.root, name)
+	for _, dir := range []string{"conf", "data/keys", "data/netkeys"} {
+		if err := copyDir(filepath.Join(src, dir), filepath.Join(dst, dir)); err != nil {
+			return nil, err
+		}
+	}
+
+	envCfg, err := xconf.LoadEnvConf(filepath.Join(dst, "conf/env.yaml"))
+	if err != nil {
+		return nil, err
+	}
+	envCfg.RootPath = dst
+	envCfg.NetConf = netConf
+
+	content := fmt.Sprintf("module: %s\naddress: %s\n", memnet.ServerName, memnet.MakeAddress(c.Fabric.Name(), name))
+	if err := ioutil.WriteFile(envCfg.GenConfFilePath(netConf), []byte(content), 0644); err != nil {
+		return nil, err
+	}
+
+	if err := ledgerUtils.CreateLedgerWithData(cfg.BcName, cfg.Genesis, envCfg); err != nil {
+		return nil, fmt.Errorf("create ledger failed.err:%v", err)
+	}
+	return envCfg, nil
+}
+
+func newEngine(envCfg *xconf.EnvConf) (engineBase.Engine, error) {
+	basicEng := engine.NewEngine()
+	if err := basicEng.Init(envCfg); err != nil {
+		return nil, err
+	}
+	return engine.EngineConvert(basicEng)
+}
+
+func copyDir(src, dst string) error {
+	if err := os.MkdirAll(dst, 0755); err != nil {
+		return err
+	}
+	files, err := ioutil.ReadDir(src)
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		if f.IsDir() {
+			continue
+		}
+		buf, err := ioutil.ReadFile(filepath.Join(src, f.Name()))
+		if err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(filepath.Join(dst, f.Name()), buf, f.Mode()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+package cluster
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/wooyang2018/corechain/engine/noderegistry"
+	mock "github.com/wooyang2018/corechain/mock/config"
+)
+
+const testPeerID = "Qmf2HeHe4sspGkfRCTq6257Vm3UHzvh2TeQJHHvHzzuFw6"
+
+func TestPartitionAndSync(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skip cluster test in short mode")
+	}
+
+	genesis := bytes.Replace(mock.GetGenesisConfBytes("single"),
+		[]byte(`"period": "3000"`), []byte(`"period": "500"`), 1)
+	c, err := NewCluster(&Config{Nodes: 3, Genesis: genesis})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Stop()
+	c.Start()
+
+	for i := range c.Nodes {
+		if err := c.WaitHeight(i, 2, 30*time.Second); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// 隔离node3后只有node1和node2继续同步
+	c.Fabric.Isolate("node3")
+	c.Fabric.Flush()
+	isolated := c.Height(2)
+	if err := c.WaitHeight(1, isolated+3, 30*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if h := c.Height(2); h > isolated+1 {
+		t.Fatalf("node3 should not grow during partition, before %d after %d", isolated, h)
+	}
+
+	// 恢复后node3追上
+	c.Fabric.Heal()
+	target := c.Height(0)
+	if err := c.WaitHeight(2, target, 30*time.Second); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestForkAndConverge 三个poa矿工轮流出块，分区后两侧各自出块形成分叉，恢复后少数派回滚到多数派的链
+func TestForkAndConverge(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skip cluster test in short mode")
+	}
+
+	// 每个矿工轮值3个块，一轮4.5秒
+	genesis := mock.GetGenesisConfBytes("poa")
+	genesis = bytes.Replace(genesis, []byte(`"period": 3000`), []byte(`"period": 500`), 1)
+	genesis = bytes.Replace(genesis, []byte(`"block_num": 10`), []byte(`"block_num": 3`), 1)
+	c, err := NewCluster(&Config{Nodes: 3, Genesis: genesis})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Stop()
+	c.Start()
+
+	for i := range c.Nodes {
+		if err := c.WaitHeight(i, 3, 60*time.Second); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// 孤立的节点由跟随切换为矿工前需要向验证人同步，同步失败不出块，
+	// 因此在node3轮值的第一个块之后分区，node3在本轮剩余时间内单独出块
+	forkHeight, err := waitSlotStart(c, 2, 30*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Fabric.Partition([]string{"node1", "node2"}, []string{"node3"})
+	c.Fabric.Flush()
+	forkHeight++
+	if err := c.WaitHeight(2, forkHeight, 30*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.WaitHeight(0, c.Height(2)+3, 30*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	minority := c.BlockID(2, forkHeight)
+	if bytes.Equal(minority, c.BlockID(0, forkHeight)) {
+		t.Fatalf("expect fork at height %d", forkHeight)
+	}
+
+	// 恢复后node3与验证人同步时发现分叉，裁剪到分叉点后追上多数派
+	c.Fabric.Heal()
+	c.Fabric.Flush()
+	target := c.Height(0) + 2
+	if err := waitConverge(c, target, 60*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(c.BlockID(2, forkHeight), minority) {
+		t.Fatal("minority fork block should be truncated")
+	}
+}
+
+// waitSlotStart 等待第i个节点出了轮值的第一个块，返回该块高度
+func waitSlotStart(c *Cluster, i int, timeout time.Duration) (int64, error) {
+	ledger := c.Chain(i).Context().Ledger
+	miner := []byte(c.Chain(i).Context().Address.Address)
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		tip, err := ledger.QueryBlockHeader(ledger.GetMeta().GetTipBlockid())
+		if err == nil && bytes.Equal(tip.GetProposer(), miner) {
+			pre, err := ledger.QueryBlockHeader(tip.GetPreHash())
+			if err == nil && !bytes.Equal(pre.GetProposer(), miner) {
+				return tip.GetHeight(), nil
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	return 0, fmt.Errorf("%s wait slot start timeout", c.Nodes[i].Name)
+}
+
+// waitConverge 等待全部节点在height高度上的区块一致
+func waitConverge(c *Cluster, height int64, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		expect := c.BlockID(0, height)
+		converged := expect != nil
+		for i := 1; i < len(c.Nodes) && converged; i++ {
+			converged = bytes.Equal(c.BlockID(i, height), expect)
+		}
+		if converged {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("wait converge at height %d timeout", height)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+func TestNodeRegistry(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skip cluster test in short mode")
+	}
+
+	c, err := NewCluster(&Config{Nodes: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Stop()
+	c.Start()
+	if err := c.WaitHeight(0, 1, 30*time.Second); err != nil {
+		t.Fatal(err)
+	}
+
+	// 引擎加载主链时经NetworkImpl把节点登记表设置给网络层
+	registry := c.Fabric.Node("node1").NodeRegistry()
+	if registry == nil {
+		t.Fatal("node registry not set to network")
+	}
+	if _, err := registry.GetAccountByPeerID(testPeerID); err != noderegistry.ErrNotRegistered {
+		t.Fatalf("expect %v, got %v", noderegistry.ErrNotRegistered, err)
+	}
+}
@@ -32,6 +32,7 @@ func NewNetCtx(envCfg *xconf.EnvConf) (*NetCtx, error) {
 
 	// 配置路径转为绝对路径
 	cfg.KeyPath = envCfg.GenDataAbsPath(cfg.KeyPath)
+	cfg.BanListPath = envCfg.GenDataAbsPath(cfg.BanListPath)
 
 	log, err := logger.NewLogger("", "network")
 	if err != nil {
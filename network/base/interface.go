@@ -19,6 +19,8 @@ type Network interface {
 
 	Context() *NetCtx
 	PeerInfo() protos.PeerInfo
+	// ReportPeer 上报消息处理结果，调整对端信誉分，分数过低的节点会被封禁，无法认证消息来源的实现可忽略
+	ReportPeer(peerID string, event PeerEvent)
 }
 
 type SubscriberOption func(Subscriber)
@@ -39,4 +41,24 @@ type Dispatcher interface {
 	Register(sub Subscriber) error
 	UnRegister(sub Subscriber) error
 	Dispatch(*protos.CoreMessage, Stream) error
+	// Stop 停止工作协程，之后的消息不再分发
+	Stop()
+}
+
+// NodeRegistry 链上节点登记表，节点轮换密钥后重新登记新的节点ID
+type NodeRegistry interface {
+	GetAccountByPeerID(peerID string) (string, error)
+}
+
+// AdmissionAware 支持按节点登记表做准入检查的网络组件
+type AdmissionAware interface {
+	SetNodeRegistry(NodeRegistry)
+}
+
+// GossipValidator 在gossip消息分发和转发前校验，返回错误时丢弃消息
+type GossipValidator func(msg *protos.CoreMessage) error
+
+// GossipAware 支持注册gossip消息校验器的网络组件
+type GossipAware interface {
+	AddGossipValidator(protos.CoreMessage_MessageType, GossipValidator) error
 }
@@ -22,6 +22,27 @@ const (
 	DefaultMaxBroadcastPeers = 20
 	DefaultServiceName       = "localhost"
 	DefaultIsBroadCast       = true
+	DefaultPeerLowScore      = -40
+	DefaultPeerBanScore      = -100
+	DefaultPeerBanTime       = 3600
+	DefaultPeerScoreHalfLife = 600
+	DefaultBanListPath       = "banlist.json"
+	DefaultEnableGossip      = false
+	DefaultGossipDegree      = 6
+	DefaultMaxChunkedSize    = 1024 // MB
+	DefaultDispatchWorkers   = 1024
+	DefaultDispatchQueueSize = 4096
+	DefaultEnableMdns        = false
+)
+
+// peer admission policies
+const (
+	// AdmissionAllowlist 证书对应的账户在白名单中
+	AdmissionAllowlist = "allowlist"
+	// AdmissionCA 证书由指定的签发证书签发
+	AdmissionCA = "ca"
+	// AdmissionRegistry 节点ID已在主链节点登记表中登记
+	AdmissionRegistry = "registry"
 )
 
 // Config is the envconfig of p2p server. Attention, envconfig of dht are not expose
@@ -32,6 +53,8 @@ type NetConf struct {
 	Port int32 `yaml:"port,omitempty"`
 	// Address multiaddr string, /ip4/127.0.0.1/tcp/8080
 	Address string `yaml:"address,omitempty"`
+	// QuicAddress multiaddr string of the quic listener, /ip4/127.0.0.1/udp/8080/quic, only p2pv2 supported
+	QuicAddress string `yaml:"quicAddress,omitempty"`
 	// keyPath is the node private key path
 	KeyPath string `yaml:"keyPath,omitempty"`
 	// isNat envconfig whether the node use NAT manager
@@ -59,6 +82,58 @@ type NetConf struct {
 	IsTls bool `yaml:"isTls,omitempty"`
 	// ServiceName
 	ServiceName string `yaml:"serviceName,omitempty"`
+	// PeerLowScore peers whose score is no more than it are skipped for broadcasts and sync requests
+	PeerLowScore int64 `yaml:"peerLowScore,omitempty"`
+	// PeerBanScore peers whose score is no more than it are banned
+	PeerBanScore int64 `yaml:"peerBanScore,omitempty"`
+	// PeerBanTime ban duration in seconds
+	PeerBanTime int64 `yaml:"peerBanTime,omitempty"`
+	// PeerScoreHalfLife score decays by half every PeerScoreHalfLife seconds
+	PeerScoreHalfLife int64 `yaml:"peerScoreHalfLife,omitempty"`
+	// BanListPath the file to persist banned peers across restarts
+	BanListPath string `yaml:"banListPath,omitempty"`
+	// EnableGossip broadcast blocks and txs by gossip mesh instead of flooding, only p2pv2 supported
+	EnableGossip bool `yaml:"enableGossip,omitempty"`
+	// GossipDegree the number of mesh peers per topic
+	GossipDegree int `yaml:"gossipDegree,omitempty"`
+	// CompactBlock key:bcname, relay blocks of the chain as header plus short tx ids
+	CompactBlock map[string]bool `yaml:"compactBlock,omitempty"`
+	// Codecs compression codecs negotiated with peers on p2pv2 streams, in order of preference
+	Codecs []string `yaml:"codecs,omitempty"`
+	// MaxChunkedMessageSize max size(MB) of message transferred in chunks on negotiated p2pv2 streams
+	MaxChunkedMessageSize int64 `yaml:"maxChunkedMessageSize,omitempty"`
+	// DispatchWorkers the number of workers handling incoming messages
+	DispatchWorkers int `yaml:"dispatchWorkers,omitempty"`
+	// DispatchQueueSize the size of each priority queue, messages are dropped when the queue is full
+	DispatchQueueSize int `yaml:"dispatchQueueSize,omitempty"`
+	// RateLimits key: message type, e.g. POSTTX
+	RateLimits map[string]RateLimitConf `yaml:"rateLimits,omitempty"`
+	// EnableMdns discover peers on the local network by mDNS, only p2pv2 supported
+	EnableMdns bool `yaml:"enableMdns,omitempty"`
+	// DnsSeeds domains whose TXT records list peer addresses as dnsaddr=<multiaddr>, only p2pv2 supported
+	DnsSeeds []string `yaml:"dnsSeeds,omitempty"`
+	// Admission peer admission policies checked in the tls handshake, only p2pv2 with isTls supported
+	Admission AdmissionConf `yaml:"admission,omitempty"`
+}
+
+// AdmissionConf all configured policies must pass before a peer is admitted
+type AdmissionConf struct {
+	// Policies any of allowlist, ca and registry
+	Policies []string `yaml:"policies,omitempty"`
+	// Accounts chain accounts admitted by the allowlist policy
+	Accounts []string `yaml:"accounts,omitempty"`
+	// IssuerCert pem file under keyPath, peer certificates must be issued by one of its certs for the ca policy
+	IssuerCert string `yaml:"issuerCert,omitempty"`
+}
+
+// RateLimitConf token bucket limits of incoming message type, zero rate means unlimited
+type RateLimitConf struct {
+	// Rate messages per second of the type from all peers
+	Rate  float64 `yaml:"rate,omitempty"`
+	Burst int     `yaml:"burst,omitempty"`
+	// PeerRate messages per second of the type from each peer
+	PeerRate  float64 `yaml:"peerRate,omitempty"`
+	PeerBurst int     `yaml:"peerBurst,omitempty"`
 }
 
 func LoadP2PConf(cfgFile string) (*NetConf, error) {
@@ -89,6 +164,24 @@ func GetDefP2PConf() *NetConf {
 		StaticNodes:       make(map[string][]string),
 		ServiceName:       DefaultServiceName,
 		IsBroadCast:       DefaultIsBroadCast,
+		PeerLowScore:      DefaultPeerLowScore,
+		PeerBanScore:      DefaultPeerBanScore,
+		PeerBanTime:       DefaultPeerBanTime,
+		PeerScoreHalfLife: DefaultPeerScoreHalfLife,
+		BanListPath:       DefaultBanListPath,
+		EnableGossip:      DefaultEnableGossip,
+		GossipDegree:      DefaultGossipDegree,
+		// 压缩算法按偏好排序，与对端协商
+		Codecs:                []string{"zstd", "snappy"},
+		MaxChunkedMessageSize: DefaultMaxChunkedSize,
+		DispatchWorkers:       DefaultDispatchWorkers,
+		DispatchQueueSize:     DefaultDispatchQueueSize,
+		// 默认只限制交易消息，避免交易洪泛影响共识和区块消息
+		RateLimits: map[string]RateLimitConf{
+			"POSTTX":      {Rate: 5000, Burst: 10000, PeerRate: 500, PeerBurst: 1000},
+			"BATCHPOSTTX": {Rate: 500, Burst: 1000, PeerRate: 50, PeerBurst: 100},
+		},
+		EnableMdns: DefaultEnableMdns,
 	}
 }
 
@@ -0,0 +1,43 @@
+package base
+
+// PeerEvent 消息处理结果，用于调整对端节点的信誉分
+type PeerEvent int
+
+const (
+	// PeerEventUsefulResponse 对端返回了有效的数据
+	PeerEventUsefulResponse PeerEvent = iota
+	// PeerEventTimeout 请求对端超时
+	PeerEventTimeout
+	// PeerEventInvalidMessage 消息校验或反序列化失败
+	PeerEventInvalidMessage
+	// PeerEventInvalidTx 广播的交易校验失败
+	PeerEventInvalidTx
+	// PeerEventInvalidBlock 广播的区块校验失败
+	PeerEventInvalidBlock
+)
+
+// PeerEventScore 各类事件对信誉分的影响
+var PeerEventScore = map[PeerEvent]float64{
+	PeerEventUsefulResponse: 1,
+	PeerEventTimeout:        -2,
+	PeerEventInvalidMessage: -10,
+	PeerEventInvalidTx:      -5,
+	PeerEventInvalidBlock:   -25,
+}
+
+func (e PeerEvent) String() string {
+	switch e {
+	case PeerEventUsefulResponse:
+		return "UsefulResponse"
+	case PeerEventTimeout:
+		return "Timeout"
+	case PeerEventInvalidMessage:
+		return "InvalidMessage"
+	case PeerEventInvalidTx:
+		return "InvalidTx"
+	case PeerEventInvalidBlock:
+		return "InvalidBlock"
+	default:
+		return "Unknown"
+	}
+}
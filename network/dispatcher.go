@@ -9,6 +9,7 @@ import (
 
 	"github.com/patrickmn/go-cache"
 	xctx "github.com/wooyang2018/corechain/common/context"
+	"github.com/wooyang2018/corechain/common/metrics"
 	"github.com/wooyang2018/corechain/common/timer"
 	"github.com/wooyang2018/corechain/common/utils"
 	"github.com/wooyang2018/corechain/crypto/core/hash"
@@ -23,8 +24,50 @@ var (
 	ErrMessageEmpty = errors.New("message empty")
 	ErrStreamNil    = errors.New("stream is nil")
 	ErrNotRegister  = errors.New("message not register")
+	ErrStopped      = errors.New("dispatcher stopped")
 )
 
+// 消息优先级，工作协程总是先处理高优先级队列中的消息
+const (
+	// 共识消息
+	PriorityConsensus = iota
+	// 区块、同步和其他消息
+	PriorityBlock
+	// 交易消息
+	PriorityTx
+	priorityCount
+)
+
+var messagePriority = map[protos.CoreMessage_MessageType]int{
+	protos.CoreMessage_CHAINED_BFT_NEW_VIEW_MSG:     PriorityConsensus,
+	protos.CoreMessage_CHAINED_BFT_NEW_PROPOSAL_MSG: PriorityConsensus,
+	protos.CoreMessage_CHAINED_BFT_VOTE_MSG:         PriorityConsensus,
+	protos.CoreMessage_POSTTX:                       PriorityTx,
+	protos.CoreMessage_BATCHPOSTTX:                  PriorityTx,
+}
+
+// MessagePriority return the priority of message type
+func MessagePriority(typ protos.CoreMessage_MessageType) int {
+	if priority, ok := messagePriority[typ]; ok {
+		return priority
+	}
+	return PriorityBlock
+}
+
+// 消息被丢弃的原因
+const (
+	dropRateLimit = "rate_limit"
+	dropQueueFull = "queue_full"
+)
+
+type dispatchTask struct {
+	ctx    xctx.Context
+	sub    netBase.Subscriber
+	msg    *protos.CoreMessage
+	stream netBase.Stream
+	wg     *sync.WaitGroup
+}
+
 // DispatcherImpl implement interface Dispatcher
 type DispatcherImpl struct {
 	ctx     *netBase.NetCtx
@@ -32,19 +75,41 @@ type DispatcherImpl struct {
 	mu      sync.RWMutex
 	mc      map[protos.CoreMessage_MessageType]map[netBase.Subscriber]struct{}
 	handled *cache.Cache
-	// control goroutinue number
-	parallel chan struct{}
+	limiter *RateLimiter
+	// 按优先级排队，由固定数量的工作协程处理
+	queues [priorityCount]chan *dispatchTask
+	// 关闭后工作协程退出，stopped由mu保护，置位后不再入队
+	exit     chan struct{}
+	stopped  bool
+	stopOnce sync.Once
 }
 
 var _ netBase.Dispatcher = &DispatcherImpl{}
 
 func NewDispatcher(ctx *netBase.NetCtx) netBase.Dispatcher {
+	cfg := ctx.P2PConf
 	d := &DispatcherImpl{
-		ctx:      ctx,
-		log:      ctx.XLog,
-		mc:       make(map[protos.CoreMessage_MessageType]map[netBase.Subscriber]struct{}),
-		handled:  cache.New(time.Duration(3)*time.Second, 1*time.Second),
-		parallel: make(chan struct{}, 1024),
+		ctx:     ctx,
+		log:     ctx.XLog,
+		mc:      make(map[protos.CoreMessage_MessageType]map[netBase.Subscriber]struct{}),
+		handled: cache.New(time.Duration(3)*time.Second, 1*time.Second),
+		limiter: NewRateLimiter(cfg.RateLimits),
+		exit:    make(chan struct{}),
+	}
+
+	queueSize := cfg.DispatchQueueSize
+	if queueSize <= 0 {
+		queueSize = netBase.DefaultDispatchQueueSize
+	}
+	for i := range d.queues {
+		d.queues[i] = make(chan *dispatchTask, queueSize)
+	}
+	workers := cfg.DispatchWorkers
+	if workers <= 0 {
+		workers = netBase.DefaultDispatchWorkers
+	}
+	for i := 0; i < workers; i++ {
+		go d.work()
 	}
 
 	return d
@@ -111,26 +176,37 @@ func (d *DispatcherImpl) Dispatch(msg *protos.CoreMessage, stream netBase.Stream
 		return ErrStreamNil
 	}
 
+	if !d.limiter.Allow(msg) {
+		d.drop(ctx, msg, dropRateLimit)
+		return nil
+	}
+
 	d.mu.RLock()
 	ctx.GetTimer().Mark("lock")
+	if d.stopped {
+		d.mu.RUnlock()
+		return ErrStopped
+	}
 	if _, ok := d.mc[msg.GetHeader().GetType()]; !ok {
 		d.mu.RUnlock()
 		return ErrNotRegister
 	}
 
 	var wg sync.WaitGroup
+	queue := d.queues[MessagePriority(msg.GetHeader().GetType())]
 	for sub, _ := range d.mc[msg.GetHeader().GetType()] {
 		if !sub.Match(msg) {
 			continue
 		}
 
-		d.parallel <- struct{}{}
 		wg.Add(1)
-		go func(sub netBase.Subscriber) {
-			defer wg.Done()
-			sub.HandleMessage(ctx, msg, stream)
-			<-d.parallel
-		}(sub)
+		task := &dispatchTask{ctx: ctx, sub: sub, msg: msg, stream: stream, wg: &wg}
+		select {
+		case queue <- task:
+		default:
+			wg.Done()
+			d.drop(ctx, msg, dropQueueFull)
+		}
 	}
 	d.mu.RUnlock()
 	ctx.GetTimer().Mark("unlock")
@@ -141,6 +217,67 @@ func (d *DispatcherImpl) Dispatch(msg *protos.CoreMessage, stream netBase.Stream
 	return nil
 }
 
+// work 严格按优先级取任务，高优先级队列为空时才处理低优先级队列
+func (d *DispatcherImpl) work() {
+	for {
+		select {
+		case <-d.exit:
+			return
+		default:
+		}
+
+		var task *dispatchTask
+		select {
+		case task = <-d.queues[PriorityConsensus]:
+		default:
+			select {
+			case task = <-d.queues[PriorityConsensus]:
+			case task = <-d.queues[PriorityBlock]:
+			default:
+				select {
+				case task = <-d.queues[PriorityConsensus]:
+				case task = <-d.queues[PriorityBlock]:
+				case task = <-d.queues[PriorityTx]:
+				case <-d.exit:
+					return
+				}
+			}
+		}
+
+		task.sub.HandleMessage(task.ctx, task.msg, task.stream)
+		task.wg.Done()
+	}
+}
+
+// Stop 通知工作协程退出，已入队未处理的任务直接丢弃，避免Dispatch一直等待
+func (d *DispatcherImpl) Stop() {
+	d.stopOnce.Do(func() {
+		d.mu.Lock()
+		d.stopped = true
+		close(d.exit)
+		d.mu.Unlock()
+
+		for _, queue := range d.queues {
+			for drained := false; !drained; {
+				select {
+				case task := <-queue:
+					task.wg.Done()
+				default:
+					drained = true
+				}
+			}
+		}
+	})
+}
+
+func (d *DispatcherImpl) drop(ctx xctx.Context, msg *protos.CoreMessage, reason string) {
+	ctx.GetLog().SetInfoField("dropped", reason)
+	if d.ctx.EnvCfg != nil && d.ctx.EnvCfg.MetricSwitch {
+		metrics.NetworkMsgDroppedCounter.WithLabelValues(msg.GetHeader().GetBcname(),
+			msg.GetHeader().GetType().String(), reason).Inc()
+	}
+}
+
 func MessageKey(msg *protos.CoreMessage) string {
 	if msg == nil || msg.GetHeader() == nil {
 		return ""
@@ -1,8 +1,11 @@
 package network
 
 import (
+	"sync"
 	"testing"
+	"time"
 
+	xctx "github.com/wooyang2018/corechain/common/context"
 	"github.com/wooyang2018/corechain/logger"
 	mock "github.com/wooyang2018/corechain/mock/config"
 	netBase "github.com/wooyang2018/corechain/network/base"
@@ -112,3 +115,56 @@ func TestDispatcher(t *testing.T) {
 		}
 	}
 }
+
+func TestDispatcherStop(t *testing.T) {
+	ecfg, err := mock.GetMockEnvConf()
+	if err != nil {
+		t.Fatal(err)
+	}
+	logger.InitMLog(ecfg.GenConfFilePath(ecfg.LogConf), ecfg.GenDirAbsPath(ecfg.LogDir))
+	netCtx, _ := netBase.NewNetCtx(ecfg)
+	netCtx.P2PConf.DispatchWorkers = 1
+
+	dispatcher := NewDispatcher(netCtx)
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	handler := func(ctx xctx.Context, msg *protos.CoreMessage) (*protos.CoreMessage, error) {
+		started <- struct{}{}
+		<-release
+		return nil, nil
+	}
+	if err := dispatcher.Register(NewSubscriber(netCtx, protos.CoreMessage_GET_BLOCK, HandleFunc(handler))); err != nil {
+		t.Fatal(err)
+	}
+
+	// 唯一的工作协程阻塞在第一条消息上，第二条消息排队等待
+	go dispatcher.Dispatch(NewMessage(protos.CoreMessage_GET_BLOCK, &protos.CoreMessage{}, WithLogId("1")), &mockStream{})
+	<-started
+	done := make(chan error, 1)
+	go func() {
+		done <- dispatcher.Dispatch(NewMessage(protos.CoreMessage_GET_BLOCK, &protos.CoreMessage{}, WithLogId("2")), &mockStream{})
+	}()
+	time.Sleep(100 * time.Millisecond)
+
+	dispatcher.Stop()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("queued dispatch should return after stop")
+	}
+	close(release)
+	dispatcher.Stop()
+
+	err = dispatcher.Dispatch(NewMessage(protos.CoreMessage_GET_BLOCK, &protos.CoreMessage{}, WithLogId("3")), &mockStream{})
+	if err != ErrStopped {
+		t.Fatalf("expect stopped, got: %v", err)
+	}
+
+	// 工作协程已退出，直接入队的任务不会再被处理
+	queue := dispatcher.(*DispatcherImpl).queues[PriorityBlock]
+	queue <- &dispatchTask{wg: &sync.WaitGroup{}}
+	time.Sleep(100 * time.Millisecond)
+	if len(queue) != 1 {
+		t.Fatal("dispatcher workers not exited")
+	}
+}
@@ -0,0 +1,283 @@
+package memnet
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/wooyang2018/corechain/protos"
+)
+
+// Action 规则对一条消息的处理结果，nil表示正常投递
+type Action struct {
+	Drop  bool
+	Delay time.Duration
+}
+
+// Rule 故障注入规则，from和to为节点名
+type Rule func(from, to string, msg *protos.CoreMessage) *Action
+
+// Fabric 同一进程内的虚拟网络，节点地址为/memnet/<fabric>/<node>
+type Fabric struct {
+	name string
+
+	mutex    sync.RWMutex
+	nodes    map[string]*MemNetwork // node => network
+	accounts map[string]string      // account => node
+	rules    map[int]Rule
+	ruleIDs  []int
+	nextID   int
+
+	// 在途消息数，Flush等待全部投递完成
+	pending int
+	idle    *sync.Cond
+}
+
+var (
+	fabricMu sync.Mutex
+	fabrics  = make(map[string]*Fabric)
+)
+
+// GetFabric 按名字获取虚拟网络，不存在时创建
+func GetFabric(name string) *Fabric {
+	fabricMu.Lock()
+	defer fabricMu.Unlock()
+
+	if f, ok := fabrics[name]; ok {
+		return f
+	}
+	f := &Fabric{
+		name:     name,
+		nodes:    make(map[string]*MemNetwork),
+		accounts: make(map[string]string),
+		rules:    make(map[int]Rule),
+	}
+	f.idle = sync.NewCond(&f.mutex)
+	fabrics[name] = f
+	return f
+}
+
+// RemoveFabric 删除虚拟网络，节点需要先Stop
+func RemoveFabric(name string) {
+	fabricMu.Lock()
+	defer fabricMu.Unlock()
+	delete(fabrics, name)
+}
+
+func (f *Fabric) Name() string {
+	return f.name
+}
+
+// Nodes 返回已启动的节点名
+func (f *Fabric) Nodes() []string {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+
+	nodes := make([]string, 0, len(f.nodes))
+	for name := range f.nodes {
+		nodes = append(nodes, name)
+	}
+	sort.Strings(nodes)
+	return nodes
+}
+
+// Node 返回已启动的节点，不存在时返回nil
+func (f *Fabric) Node(name string) *MemNetwork {
+	return f.getNode(name)
+}
+
+// AddRule 添加规则，按添加顺序生效，返回的id用于RemoveRule
+func (f *Fabric) AddRule(rule Rule) int {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.nextID++
+	f.rules[f.nextID] = rule
+	f.ruleIDs = append(f.ruleIDs, f.nextID)
+	return f.nextID
+}
+
+func (f *Fabric) RemoveRule(id int) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if _, ok := f.rules[id]; !ok {
+		return
+	}
+	delete(f.rules, id)
+	for i, v := range f.ruleIDs {
+		if v == id {
+			f.ruleIDs = append(f.ruleIDs[:i], f.ruleIDs[i+1:]...)
+			break
+		}
+	}
+}
+
+// Heal 清除全部规则，恢复网络
+func (f *Fabric) Heal() {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.rules = make(map[int]Rule)
+	f.ruleIDs = nil
+}
+
+// Partition 把节点划分为若干分区，分区之间的消息全部丢弃，未列出的节点同属一个分区
+func (f *Fabric) Partition(groups ...[]string) int {
+	group := make(map[string]int)
+	for i, nodes := range groups {
+		for _, node := range nodes {
+			group[node] = i + 1
+		}
+	}
+	return f.AddRule(func(from, to string, msg *protos.CoreMessage) *Action {
+		if group[from] != group[to] {
+			return &Action{Drop: true}
+		}
+		return nil
+	})
+}
+
+// Isolate 隔离单个节点
+func (f *Fabric) Isolate(node string) int {
+	return f.AddRule(Link(node, "", &Action{Drop: true}))
+}
+
+// Flush 等待在途消息投递完成，包括延迟投递的消息
+func (f *Fabric) Flush() {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for f.pending > 0 {
+		f.idle.Wait()
+	}
+}
+
+func (f *Fabric) begin() {
+	f.mutex.Lock()
+	f.pending++
+	f.mutex.Unlock()
+}
+
+func (f *Fabric) done() {
+	f.mutex.Lock()
+	f.pending--
+	if f.pending == 0 {
+		f.idle.Broadcast()
+	}
+	f.mutex.Unlock()
+}
+
+// Link 匹配from和to之间双向的消息，空字符串匹配任意节点
+func Link(a, b string, action *Action) Rule {
+	match := func(x, y string) bool {
+		return (a == "" || a == x) && (b == "" || b == y)
+	}
+	return func(from, to string, msg *protos.CoreMessage) *Action {
+		if match(from, to) || match(to, from) {
+			return action
+		}
+		return nil
+	}
+}
+
+// ForTypes 只对指定类型的消息生效
+func ForTypes(rule Rule, types ...protos.CoreMessage_MessageType) Rule {
+	set := make(map[protos.CoreMessage_MessageType]bool, len(types))
+	for _, typ := range types {
+		set[typ] = true
+	}
+	return func(from, to string, msg *protos.CoreMessage) *Action {
+		if !set[msg.GetHeader().GetType()] {
+			return nil
+		}
+		return rule(from, to, msg)
+	}
+}
+
+// DropTypes 丢弃指定类型的消息
+func DropTypes(types ...protos.CoreMessage_MessageType) Rule {
+	return ForTypes(func(string, string, *protos.CoreMessage) *Action {
+		return &Action{Drop: true}
+	}, types...)
+}
+
+// Delay 所有消息延迟投递
+func Delay(d time.Duration) Rule {
+	return func(string, string, *protos.CoreMessage) *Action {
+		return &Action{Delay: d}
+	}
+}
+
+func (f *Fabric) attach(node *MemNetwork) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.nodes[node.name] = node
+	if node.account != "" {
+		f.accounts[node.account] = node.name
+	}
+}
+
+func (f *Fabric) detach(node *MemNetwork) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if f.nodes[node.name] == node {
+		delete(f.nodes, node.name)
+	}
+	if f.accounts[node.account] == node.name {
+		delete(f.accounts, node.account)
+	}
+}
+
+func (f *Fabric) getNode(name string) *MemNetwork {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	return f.nodes[name]
+}
+
+func (f *Fabric) getNodeByAccount(account string) *MemNetwork {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	return f.nodes[f.accounts[account]]
+}
+
+// peers 返回除self外的全部节点
+func (f *Fabric) peers(self string) []*MemNetwork {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+
+	peers := make([]*MemNetwork, 0, len(f.nodes))
+	for name, node := range f.nodes {
+		if name != self {
+			peers = append(peers, node)
+		}
+	}
+	sort.Slice(peers, func(i, j int) bool {
+		return peers[i].name < peers[j].name
+	})
+	return peers
+}
+
+// apply 依次执行规则，任一规则丢弃即丢弃，延迟累加
+func (f *Fabric) apply(from, to string, msg *protos.CoreMessage) (bool, time.Duration) {
+	f.mutex.RLock()
+	rules := make([]Rule, 0, len(f.ruleIDs))
+	for _, id := range f.ruleIDs {
+		rules = append(rules, f.rules[id])
+	}
+	f.mutex.RUnlock()
+
+	var delay time.Duration
+	for _, rule := range rules {
+		action := rule(from, to, msg)
+		if action == nil {
+			continue
+		}
+		if action.Drop {
+			return true, 0
+		}
+		delay += action.Delay
+	}
+	return false, delay
+}
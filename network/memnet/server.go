@@ -0,0 +1,364 @@
+package memnet
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/wooyang2018/corechain/common/address"
+	xctx "github.com/wooyang2018/corechain/common/context"
+	"github.com/wooyang2018/corechain/logger"
+	"github.com/wooyang2018/corechain/network"
+	netBase "github.com/wooyang2018/corechain/network/base"
+	"github.com/wooyang2018/corechain/protos"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	ServerName = "memnet"
+)
+
+func init() {
+	network.Register(ServerName, NewMemNetwork)
+}
+
+// define errors
+var (
+	ErrAddress     = errors.New("memnet address should be /memnet/<fabric>/<node>")
+	ErrLoadAccount = errors.New("load account error")
+	ErrEmptyPeer   = errors.New("empty peer")
+	ErrNoResponse  = errors.New("no response")
+	ErrDropped     = errors.New("message dropped")
+	ErrTimeout     = errors.New("wait response timeout")
+)
+
+// MemNetwork 进程内网络节点，消息经Fabric投递，投递前执行故障注入规则
+type MemNetwork struct {
+	ctx        *netBase.NetCtx
+	log        logger.Logger
+	fabric     *Fabric
+	name       string
+	account    string
+	dispatcher netBase.Dispatcher
+
+	mutex    sync.RWMutex
+	running  bool
+	reports  map[string][]netBase.PeerEvent // peer => events
+	registry netBase.NodeRegistry
+}
+
+var _ netBase.Network = &MemNetwork{}
+var _ netBase.AdmissionAware = &MemNetwork{}
+
+// NewMemNetwork create MemNetwork instance
+func NewMemNetwork() netBase.Network {
+	return &MemNetwork{}
+}
+
+// ParseAddress 解析/memnet/<fabric>/<node>
+func ParseAddress(addr string) (string, string, error) {
+	parts := strings.Split(strings.Trim(addr, "/"), "/")
+	if len(parts) != 3 || parts[0] != ServerName || parts[1] == "" || parts[2] == "" {
+		return "", "", ErrAddress
+	}
+	return parts[1], parts[2], nil
+}
+
+// MakeAddress 生成节点地址
+func MakeAddress(fabric, node string) string {
+	return fmt.Sprintf("/%s/%s/%s", ServerName, fabric, node)
+}
+
+func (t *MemNetwork) Init(ctx *netBase.NetCtx) error {
+	fabric, name, err := ParseAddress(ctx.P2PConf.Address)
+	if err != nil {
+		return err
+	}
+
+	t.ctx = ctx
+	t.log = ctx.GetLog()
+	t.fabric = GetFabric(fabric)
+	t.name = name
+	t.reports = make(map[string][]netBase.PeerEvent)
+
+	keyPath := ctx.EnvCfg.GenDataAbsPath(ctx.EnvCfg.KeyDir)
+	t.account, err = address.LoadAddress(keyPath)
+	if err != nil {
+		return ErrLoadAccount
+	}
+
+	t.dispatcher = network.NewDispatcher(ctx)
+	return nil
+}
+
+func (t *MemNetwork) Start() {
+	t.mutex.Lock()
+	t.running = true
+	t.mutex.Unlock()
+	t.fabric.attach(t)
+	t.log.Info("memnet node started", "fabric", t.fabric.Name(), "node", t.name, "account", t.account)
+}
+
+func (t *MemNetwork) Stop() {
+	t.mutex.Lock()
+	t.running = false
+	t.mutex.Unlock()
+	t.fabric.detach(t)
+	t.dispatcher.Stop()
+	t.log.Info("memnet node stopped", "fabric", t.fabric.Name(), "node", t.name)
+}
+
+func (t *MemNetwork) NewSubscriber(typ protos.CoreMessage_MessageType, v interface{},
+	opts ...netBase.SubscriberOption) netBase.Subscriber {
+	return network.NewSubscriber(t.ctx, typ, v, opts...)
+}
+
+func (t *MemNetwork) Register(sub netBase.Subscriber) error {
+	return t.dispatcher.Register(sub)
+}
+
+func (t *MemNetwork) UnRegister(sub netBase.Subscriber) error {
+	return t.dispatcher.UnRegister(sub)
+}
+
+// SendMessage 每个目标节点异步投递，Fabric.Flush等待投递完成
+func (t *MemNetwork) SendMessage(ctx xctx.Context, msg *protos.CoreMessage,
+	optFunc ...netBase.OptionFunc) error {
+	peers := t.targets(netBase.Apply(optFunc))
+	if len(peers) <= 0 {
+		t.log.Warn("SendMessage peer empty", "log_id", msg.GetHeader().GetLogid(),
+			"msgType", msg.GetHeader().GetType())
+		return ErrEmptyPeer
+	}
+
+	for _, peer := range peers {
+		t.fabric.begin()
+		go func(peer *MemNetwork) {
+			defer t.fabric.done()
+			if drop, delay := t.fabric.apply(t.name, peer.name, msg); drop {
+				ctx.GetLog().Debug("memnet drop message", "from", t.name, "to", peer.name,
+					"msgType", msg.GetHeader().GetType())
+				return
+			} else if delay > 0 {
+				time.Sleep(delay)
+			}
+			peer.handleMessage(discardStream{}, t.clone(msg))
+		}(peer)
+	}
+	return nil
+}
+
+// SendMessageWithResponse 并发请求目标节点，按Percent返回响应
+func (t *MemNetwork) SendMessageWithResponse(ctx xctx.Context, msg *protos.CoreMessage,
+	optFunc ...netBase.OptionFunc) ([]*protos.CoreMessage, error) {
+	opt := netBase.Apply(optFunc)
+	peers := t.targets(opt)
+	if len(peers) <= 0 {
+		t.log.Warn("SendMessageWithResponse peer empty", "log_id", msg.GetHeader().GetLogid(),
+			"msgType", msg.GetHeader().GetType())
+		return nil, ErrEmptyPeer
+	}
+
+	respCh := make(chan *protos.CoreMessage, len(peers))
+	var wg sync.WaitGroup
+	for _, peer := range peers {
+		wg.Add(1)
+		go func(peer *MemNetwork) {
+			defer wg.Done()
+			resp, err := t.request(peer, msg)
+			if err != nil {
+				ctx.GetLog().Debug("memnet request failed", "from", t.name, "to", peer.name,
+					"msgType", msg.GetHeader().GetType(), "err", err)
+				return
+			}
+			respCh <- resp
+		}(peer)
+	}
+	wg.Wait()
+	close(respCh)
+
+	if len(respCh) <= 0 {
+		return nil, ErrNoResponse
+	}
+	threshold := int(float32(len(peers)) * opt.Percent)
+	response := make([]*protos.CoreMessage, 0, len(peers))
+	for resp := range respCh {
+		response = append(response, resp)
+		if len(response) >= threshold {
+			break
+		}
+	}
+	return response, nil
+}
+
+func (t *MemNetwork) Context() *netBase.NetCtx {
+	return t.ctx
+}
+
+func (t *MemNetwork) PeerInfo() protos.PeerInfo {
+	var peers []*protos.PeerInfo
+	for _, peer := range t.fabric.peers(t.name) {
+		peers = append(peers, &protos.PeerInfo{
+			Id:      peer.name,
+			Address: MakeAddress(t.fabric.Name(), peer.name),
+			Account: peer.account,
+		})
+	}
+	return protos.PeerInfo{
+		Id:      t.name,
+		Address: MakeAddress(t.fabric.Name(), t.name),
+		Account: t.account,
+		Peer:    peers,
+	}
+}
+
+// ReportPeer 只记录上报事件，不封禁节点
+func (t *MemNetwork) ReportPeer(peerID string, event netBase.PeerEvent) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.reports[peerID] = append(t.reports[peerID], event)
+}
+
+// Reports 返回对指定节点上报过的事件
+func (t *MemNetwork) Reports(peerID string) []netBase.PeerEvent {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	return append([]netBase.PeerEvent(nil), t.reports[peerID]...)
+}
+
+// SetNodeRegistry memnet没有证书，不做准入检查，只记录登记表
+func (t *MemNetwork) SetNodeRegistry(registry netBase.NodeRegistry) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.registry = registry
+}
+
+// NodeRegistry 返回引擎设置的节点登记表
+func (t *MemNetwork) NodeRegistry() netBase.NodeRegistry {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	return t.registry
+}
+
+// targets 未指定目标时广播给全部节点
+func (t *MemNetwork) targets(opt *netBase.Option) []*MemNetwork {
+	var peers []*MemNetwork
+	if len(opt.Addresses) <= 0 && len(opt.PeerIDs) <= 0 && len(opt.Accounts) <= 0 {
+		peers = t.fabric.peers(t.name)
+	} else {
+		seen := make(map[string]bool)
+		add := func(peer *MemNetwork) {
+			if peer != nil && peer != t && !seen[peer.name] {
+				seen[peer.name] = true
+				peers = append(peers, peer)
+			}
+		}
+		for _, addr := range opt.Addresses {
+			if _, name, err := ParseAddress(addr); err == nil {
+				add(t.fabric.getNode(name))
+			}
+		}
+		for _, id := range opt.PeerIDs {
+			add(t.fabric.getNode(id))
+		}
+		for _, account := range opt.Accounts {
+			add(t.fabric.getNodeByAccount(account))
+		}
+	}
+
+	if len(opt.WhiteList) <= 0 {
+		return peers
+	}
+	var res []*MemNetwork
+	for _, peer := range peers {
+		if opt.WhiteList[peer.name] {
+			res = append(res, peer)
+		}
+	}
+	return res
+}
+
+// request 同步投递请求，响应同样经过规则过滤
+func (t *MemNetwork) request(peer *MemNetwork, msg *protos.CoreMessage) (*protos.CoreMessage, error) {
+	if drop, delay := t.fabric.apply(t.name, peer.name, msg); drop {
+		return nil, ErrDropped
+	} else if delay > 0 {
+		time.Sleep(delay)
+	}
+
+	stream := &respStream{ch: make(chan *protos.CoreMessage, 1)}
+	done := make(chan struct{})
+	go func() {
+		peer.handleMessage(stream, t.clone(msg))
+		close(done)
+	}()
+
+	timeout := time.Duration(t.ctx.P2PConf.Timeout) * time.Second
+	if timeout <= 0 {
+		timeout = netBase.DefaultTimeout * time.Second
+	}
+	var resp *protos.CoreMessage
+	select {
+	case resp = <-stream.ch:
+	case <-done:
+		select {
+		case resp = <-stream.ch:
+		default:
+			return nil, ErrNoResponse
+		}
+	case <-time.After(timeout):
+		return nil, ErrTimeout
+	}
+
+	if drop, delay := t.fabric.apply(peer.name, t.name, resp); drop {
+		return nil, ErrDropped
+	} else if delay > 0 {
+		time.Sleep(delay)
+	}
+	return peer.clone(resp), nil
+}
+
+// clone 每个接收方拿到独立的消息，From为发送节点
+func (t *MemNetwork) clone(msg *protos.CoreMessage) *protos.CoreMessage {
+	res := proto.Clone(msg).(*protos.CoreMessage)
+	if res.Header == nil {
+		res.Header = &protos.CoreMessage_MessageHeader{}
+	}
+	res.Header.From = t.name
+	return res
+}
+
+func (t *MemNetwork) handleMessage(stream netBase.Stream, msg *protos.CoreMessage) {
+	t.mutex.RLock()
+	running := t.running
+	t.mutex.RUnlock()
+	if !running {
+		return
+	}
+
+	if err := t.dispatcher.Dispatch(msg, stream); err != nil {
+		t.log.Debug("memnet dispatch error", "log_id", msg.GetHeader().GetLogid(),
+			"type", msg.GetHeader().GetType(), "from", msg.GetHeader().GetFrom(), "error", err)
+	}
+}
+
+type discardStream struct{}
+
+func (discardStream) Send(*protos.CoreMessage) error {
+	return nil
+}
+
+// respStream 只保留第一条响应
+type respStream struct {
+	ch chan *protos.CoreMessage
+}
+
+func (s *respStream) Send(msg *protos.CoreMessage) error {
+	select {
+	case s.ch <- msg:
+	default:
+	}
+	return nil
+}
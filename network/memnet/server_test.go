@@ -0,0 +1,168 @@
+package memnet
+
+import (
+	"testing"
+	"time"
+
+	xctx "github.com/wooyang2018/corechain/common/context"
+	"github.com/wooyang2018/corechain/common/utils"
+	"github.com/wooyang2018/corechain/logger"
+	mockNet "github.com/wooyang2018/corechain/mock/testnet"
+	"github.com/wooyang2018/corechain/network"
+	netBase "github.com/wooyang2018/corechain/network/base"
+	"github.com/wooyang2018/corechain/protos"
+)
+
+func newNode(t *testing.T, fabric, node string) (*MemNetwork, *netBase.NetCtx) {
+	ecfg, err := mockNet.GetMockEnvConf(node + "/conf/env.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	logger.InitMLog(ecfg.GenConfFilePath(ecfg.LogConf), ecfg.GenDirAbsPath(ecfg.LogDir))
+	ecfg.NetConf = "p2pv2.yaml"
+	ctx, err := netBase.NewNetCtx(ecfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx.P2PConf.Address = MakeAddress(fabric, node)
+
+	n := NewMemNetwork().(*MemNetwork)
+	if err := n.Init(ctx); err != nil {
+		t.Fatal(err)
+	}
+	n.Start()
+	return n, ctx
+}
+
+func newFabric(t *testing.T) (*Fabric, []*MemNetwork, []chan *protos.CoreMessage) {
+	name := t.Name()
+	var nodes []*MemNetwork
+	var chs []chan *protos.CoreMessage
+	for _, node := range []string{"node1", "node2", "node3"} {
+		n, ctx := newNode(t, name, node)
+		ch := make(chan *protos.CoreMessage, 16)
+		if err := n.Register(network.NewSubscriber(ctx, protos.CoreMessage_POSTTX, ch)); err != nil {
+			t.Fatal(err)
+		}
+		handler := func(ctx xctx.Context, msg *protos.CoreMessage) (*protos.CoreMessage, error) {
+			typ := network.GetRespMessageType(msg.Header.Type)
+			resp := network.NewMessage(typ, &protos.PeerInfo{Id: node}, network.WithLogId(msg.Header.Logid))
+			return resp, nil
+		}
+		if err := n.Register(network.NewSubscriber(ctx, protos.CoreMessage_GET_BLOCK, network.HandleFunc(handler))); err != nil {
+			t.Fatal(err)
+		}
+		nodes = append(nodes, n)
+		chs = append(chs, ch)
+	}
+	t.Cleanup(func() {
+		for _, n := range nodes {
+			n.Stop()
+		}
+		RemoveFabric(name)
+	})
+	return GetFabric(name), nodes, chs
+}
+
+func newTestMessage(typ protos.CoreMessage_MessageType) *protos.CoreMessage {
+	return network.NewMessage(typ, &protos.PeerInfo{Id: "test"}, network.WithLogId(utils.GenLogId()))
+}
+
+func TestParseAddress(t *testing.T) {
+	fabric, node, err := ParseAddress(MakeAddress("f", "node1"))
+	if err != nil || fabric != "f" || node != "node1" {
+		t.Fatalf("parse address failed: %s %s %v", fabric, node, err)
+	}
+	for _, addr := range []string{"", "/memnet/f", "/ip4/127.0.0.1/tcp/47101", "/memnet//node1"} {
+		if _, _, err := ParseAddress(addr); err != ErrAddress {
+			t.Errorf("address %q should be invalid", addr)
+		}
+	}
+}
+
+func TestBroadcastAndPartition(t *testing.T) {
+	fabric, nodes, chs := newFabric(t)
+	if got := len(nodes[0].PeerInfo().Peer); got != 2 {
+		t.Fatalf("expect 2 peers, got %d", got)
+	}
+
+	if err := nodes[0].SendMessage(nodes[0].Context(), newTestMessage(protos.CoreMessage_POSTTX)); err != nil {
+		t.Fatal(err)
+	}
+	fabric.Flush()
+	for i := 1; i < 3; i++ {
+		if len(chs[i]) != 1 {
+			t.Fatalf("node%d expect 1 message, got %d", i+1, len(chs[i]))
+		}
+		if from := (<-chs[i]).GetHeader().GetFrom(); from != "node1" {
+			t.Errorf("unexpected from: %s", from)
+		}
+	}
+
+	fabric.Partition([]string{"node1", "node2"}, []string{"node3"})
+	nodes[0].SendMessage(nodes[0].Context(), newTestMessage(protos.CoreMessage_POSTTX))
+	fabric.Flush()
+	if len(chs[1]) != 1 || len(chs[2]) != 0 {
+		t.Fatalf("partition not applied: node2=%d node3=%d", len(chs[1]), len(chs[2]))
+	}
+	<-chs[1]
+
+	fabric.Heal()
+	nodes[0].SendMessage(nodes[0].Context(), newTestMessage(protos.CoreMessage_POSTTX))
+	fabric.Flush()
+	if len(chs[1]) != 1 || len(chs[2]) != 1 {
+		t.Fatalf("heal not applied: node2=%d node3=%d", len(chs[1]), len(chs[2]))
+	}
+}
+
+func TestDropAndDelay(t *testing.T) {
+	fabric, nodes, chs := newFabric(t)
+
+	id := fabric.AddRule(DropTypes(protos.CoreMessage_POSTTX))
+	nodes[0].SendMessage(nodes[0].Context(), newTestMessage(protos.CoreMessage_POSTTX))
+	fabric.Flush()
+	if len(chs[1]) != 0 || len(chs[2]) != 0 {
+		t.Fatal("message should be dropped")
+	}
+	fabric.RemoveRule(id)
+
+	fabric.AddRule(Link("node1", "node3", &Action{Delay: 100 * time.Millisecond}))
+	start := time.Now()
+	nodes[0].SendMessage(nodes[0].Context(), newTestMessage(protos.CoreMessage_POSTTX))
+	fabric.Flush()
+	if len(chs[1]) != 1 || len(chs[2]) != 1 {
+		t.Fatalf("message lost: node2=%d node3=%d", len(chs[1]), len(chs[2]))
+	}
+	if time.Since(start) < 100*time.Millisecond {
+		t.Error("message should be delayed")
+	}
+}
+
+func TestSendMessageWithResponse(t *testing.T) {
+	fabric, nodes, _ := newFabric(t)
+
+	responses, err := nodes[0].SendMessageWithResponse(nodes[0].Context(), newTestMessage(protos.CoreMessage_GET_BLOCK))
+	if err != nil || len(responses) != 2 {
+		t.Fatalf("expect 2 responses, got %d err %v", len(responses), err)
+	}
+
+	account := nodes[2].PeerInfo().Account
+	responses, err = nodes[0].SendMessageWithResponse(nodes[0].Context(),
+		newTestMessage(protos.CoreMessage_GET_BLOCK), netBase.WithAccounts([]string{account}))
+	if err != nil || len(responses) != 1 || responses[0].GetHeader().GetFrom() != "node3" {
+		t.Fatalf("unexpected responses: %v err %v", responses, err)
+	}
+
+	fabric.Isolate("node3")
+	_, err = nodes[0].SendMessageWithResponse(nodes[0].Context(),
+		newTestMessage(protos.CoreMessage_GET_BLOCK), netBase.WithAccounts([]string{account}))
+	if err != ErrNoResponse {
+		t.Fatalf("expect no response, got %v", err)
+	}
+
+	// 没有处理函数的消息类型没有响应
+	_, err = nodes[0].SendMessageWithResponse(nodes[0].Context(), newTestMessage(protos.CoreMessage_GET_RPC_PORT))
+	if err != ErrNoResponse {
+		t.Fatalf("expect no response, got %v", err)
+	}
+}
@@ -1,6 +1,7 @@
 package network
 
 import (
+	"errors"
 	"fmt"
 	"sort"
 	"sync"
@@ -13,6 +14,13 @@ import (
 // 创建P2PServer实例方法
 type NewP2PServFunc func() netBase.Network
 
+var (
+	// ErrGossipDisabled p2p服务未开启或不支持gossip
+	ErrGossipDisabled = errors.New("gossip disabled")
+	// ErrGossipIgnore gossip校验器返回该错误时只丢弃消息，不降低转发节点的信誉分
+	ErrGossipIgnore = errors.New("gossip message ignored")
+)
+
 var (
 	servMu   sync.RWMutex
 	services = make(map[string]NewP2PServFunc)
@@ -63,6 +71,9 @@ type NetworkImpl struct {
 	p2pServ netBase.Network
 }
 
+var _ netBase.AdmissionAware = &NetworkImpl{}
+var _ netBase.GossipAware = &NetworkImpl{}
+
 func (t *NetworkImpl) Init(ctx *netBase.NetCtx) error {
 	if ctx == nil {
 		return fmt.Errorf("new network failed because context set error")
@@ -160,6 +171,37 @@ func (t *NetworkImpl) PeerInfo() protos.PeerInfo {
 	return t.p2pServ.PeerInfo()
 }
 
+func (t *NetworkImpl) ReportPeer(peerID string, event netBase.PeerEvent) {
+	if !t.isInit() {
+		return
+	}
+
+	t.p2pServ.ReportPeer(peerID, event)
+}
+
+// SetNodeRegistry 透传给支持准入检查的p2p服务
+func (t *NetworkImpl) SetNodeRegistry(registry netBase.NodeRegistry) {
+	if !t.isInit() {
+		return
+	}
+
+	if aware, ok := t.p2pServ.(netBase.AdmissionAware); ok {
+		aware.SetNodeRegistry(registry)
+	}
+}
+
+// AddGossipValidator 透传给支持gossip的p2p服务
+func (t *NetworkImpl) AddGossipValidator(typ protos.CoreMessage_MessageType, validator netBase.GossipValidator) error {
+	if !t.isInit() || validator == nil {
+		return fmt.Errorf("network not init or param set error")
+	}
+
+	if aware, ok := t.p2pServ.(netBase.GossipAware); ok {
+		return aware.AddGossipValidator(typ, validator)
+	}
+	return ErrGossipDisabled
+}
+
 func (t *NetworkImpl) isInit() bool {
 	if t.ctx == nil || t.p2pServ == nil {
 		return false
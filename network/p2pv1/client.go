@@ -11,6 +11,8 @@ import (
 	"github.com/wooyang2018/corechain/network"
 	"github.com/wooyang2018/corechain/network/base"
 	"github.com/wooyang2018/corechain/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -132,6 +134,9 @@ func (p *P2PServerV1) sendMessageWithResponse(ctx xctx.Context, msg *protos.Core
 
 			resp, err := conn.SendMessageWithResponse(ctx, msg)
 			if err != nil {
+				if status.Code(err) == codes.DeadlineExceeded {
+					p.reportPeer(peerID, base.PeerEventTimeout)
+				}
 				return
 			}
 			resp.Header.From = peerID
@@ -152,6 +157,8 @@ func (p *P2PServerV1) sendMessageWithResponse(ctx xctx.Context, msg *protos.Core
 	for resp := range respCh {
 		if network.VerifyChecksum(resp) {
 			response = append(response, resp)
+		} else {
+			p.reportPeer(resp.GetHeader().GetFrom(), base.PeerEventInvalidMessage)
 		}
 
 		i++
@@ -200,7 +207,7 @@ func (p *P2PServerV1) getFilter(msg *protos.CoreMessage, opt *base.Option) PeerF
 		}
 	}
 
-	return NewMultiStrategy(peerFilters, peerIDs)
+	return &ReputationFilter{srv: p, filter: NewMultiStrategy(peerFilters, peerIDs)}
 }
 
 func (p *P2PServerV1) GetPeerIdByAccount(account string) (string, error) {
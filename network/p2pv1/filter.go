@@ -33,6 +33,29 @@ func (ss *StaticNodeStrategy) Filter() ([]string, error) {
 	return peers, nil
 }
 
+// ReputationFilter a peer filter that skips low score peers
+type ReputationFilter struct {
+	srv    *P2PServerV1
+	filter PeerFilter
+}
+
+// Filter 过滤掉信誉分过低或已封禁的节点
+func (rf *ReputationFilter) Filter() ([]string, error) {
+	peers, err := rf.filter.Filter()
+	if err != nil {
+		return peers, err
+	}
+
+	res := make([]string, 0, len(peers))
+	for _, peer := range peers {
+		if rf.srv.reputation.IsLowScore(peer) {
+			continue
+		}
+		res = append(res, peer)
+	}
+	return res, nil
+}
+
 // MultiStrategy a peer filter that contains multiple filters
 type MultiStrategy struct {
 	filters []PeerFilter
@@ -31,6 +31,7 @@ var (
 	ErrAddressIllegal  = errors.New("address illegal")
 	ErrLoadAccount     = errors.New("load account error")
 	ErrAccountNotExist = errors.New("account not exist")
+	ErrPeerBanned      = errors.New("peer banned")
 )
 
 func init() {
@@ -46,6 +47,7 @@ type P2PServerV1 struct {
 	address    multiaddr.Multiaddr
 	pool       *ConnPool
 	dispatcher netBase.Dispatcher
+	reputation *network.Reputation
 
 	bootNodes    []string
 	staticNodes  map[string][]string
@@ -77,6 +79,7 @@ func (p *P2PServerV1) Init(ctx *netBase.NetCtx) error {
 	p.config = ctx.P2PConf
 	p.pool = pool
 	p.dispatcher = network.NewDispatcher(ctx)
+	p.reputation = network.NewReputation(ctx)
 
 	// address
 	p.address, err = multiaddr.NewMultiaddr(ctx.P2PConf.Address)
@@ -117,6 +120,7 @@ func (p *P2PServerV1) Start() {
 
 func (p *P2PServerV1) Stop() {
 	p.log.Info("StopP2PServer", "address", p.config.Address)
+	p.dispatcher.Stop()
 }
 
 // serve
@@ -180,6 +184,11 @@ func (p *P2PServerV1) SendP2PMessage(stream protos.P2PService_SendP2PMessageServ
 		}()
 	}
 
+	// p2pv1的From为对端自报地址，只用于丢弃已封禁节点的消息
+	if p.reputation.IsBanned(msg.GetHeader().GetFrom()) {
+		return ErrPeerBanned
+	}
+
 	if err = p.dispatcher.Dispatch(msg, stream); err != nil {
 		p.log.Warn("handle new message dispatch error", "log_id", msg.GetHeader().GetLogid(),
 			"type", msg.GetHeader().GetType(), "from", msg.GetHeader().GetFrom(), "error", err)
@@ -188,6 +197,17 @@ func (p *P2PServerV1) SendP2PMessage(stream protos.P2PService_SendP2PMessageServ
 	return nil
 }
 
+// ReportPeer 引擎依据消息头的From上报事件，p2pv1的From由对端自行填写，无法认证，
+// 计入信誉分会让恶意节点冒用他人地址使其被封禁，因此忽略，只有本节点主动请求时观察到的事件才调整信誉分
+func (p *P2PServerV1) ReportPeer(peerID string, event netBase.PeerEvent) {
+	p.log.Debug("p2pv1 ignore peer report, sender is not authenticated", "peerID", peerID, "event", event.String())
+}
+
+// reportPeer 调整本节点主动连接的对端的信誉分，分数过低的节点在广播和同步时被跳过
+func (p *P2PServerV1) reportPeer(peerID string, event netBase.PeerEvent) {
+	p.reputation.Report(peerID, event)
+}
+
 func (p *P2PServerV1) NewSubscriber(typ protos.CoreMessage_MessageType, v interface{}, opts ...netBase.SubscriberOption) netBase.Subscriber {
 	return network.NewSubscriber(p.ctx, typ, v, opts...)
 }
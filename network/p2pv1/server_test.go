@@ -96,3 +96,28 @@ func TestP2PServerV1(t *testing.T) {
 	startNode2(t)
 	startNode3(t)
 }
+
+func TestReportPeer(t *testing.T) {
+	mcfg, _ := mockConf.GetMockEnvConf()
+	logger.InitMLog(mcfg.GenConfFilePath(mcfg.LogConf), mcfg.GenDirAbsPath(mcfg.LogDir))
+	ecfg, _ := mockNet.GetMockEnvConf("node1/conf/env.yaml")
+	ecfg.NetConf = NetConf
+	ctx, _ := netBase.NewNetCtx(ecfg)
+	ctx.P2PConf.BanListPath = ""
+
+	p := &P2PServerV1{log: ctx.GetLog(), reputation: network.NewReputation(ctx)}
+	peerID := "/ip4/127.0.0.1/tcp/47102"
+	// 依据消息头From上报的事件不可信，不影响信誉分
+	for i := 0; i < 20; i++ {
+		p.ReportPeer(peerID, netBase.PeerEventInvalidMessage)
+	}
+	if p.reputation.IsBanned(peerID) {
+		t.Fatal("peer should not be banned by unauthenticated reports")
+	}
+	for i := 0; i < 20; i++ {
+		p.reportPeer(peerID, netBase.PeerEventInvalidMessage)
+	}
+	if !p.reputation.IsBanned(peerID) {
+		t.Fatal("peer should be banned by local reports")
+	}
+}
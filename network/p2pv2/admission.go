@@ -0,0 +1,207 @@
+package p2pv2
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	netBase "github.com/wooyang2018/corechain/network/base"
+)
+
+// AccountURIScheme 证书SAN中以URI携带链上账户，形如account:TeyyPLpp9L7QAcxHangtcHTu7HUZ6iydY
+const AccountURIScheme = "account"
+
+const (
+	// MaxPendingPeers 节点登记表就绪前最多临时准入的节点数
+	MaxPendingPeers = 256
+	// AdmissionRecheckInterval 按节点登记表重新检查已连接节点的间隔
+	AdmissionRecheckInterval = time.Minute
+)
+
+var (
+	ErrAdmissionPolicy     = errors.New("unknown admission policy")
+	ErrIssuerCert          = errors.New("load admission issuer cert error")
+	ErrPeerNotAllowed      = errors.New("peer account not in allowlist")
+	ErrPeerNotIssued       = errors.New("peer cert not issued by admission issuer")
+	ErrPeerNotRegistered   = errors.New("peer not registered in node registry")
+	ErrPeerAccountMismatch = errors.New("peer cert account mismatch with node registry")
+	ErrTooManyPending      = errors.New("too many peers pending for node registry")
+)
+
+// CertAccount 返回证书携带的链上账户，没有时返回空
+func CertAccount(cert *x509.Certificate) string {
+	for _, uri := range cert.URIs {
+		if uri.Scheme == AccountURIScheme {
+			return uri.Opaque
+		}
+	}
+	return ""
+}
+
+// Admission 在tls握手后检查对端证书，配置的策略全部通过才准入
+type Admission struct {
+	policies map[string]bool
+	accounts map[string]bool
+	issuers  []*x509.Certificate
+
+	mutex    sync.Mutex
+	registry netBase.NodeRegistry
+	// 按节点登记表准入的节点证书，登记表就绪后及之后定期重新检查，断开连接时移除
+	peers map[peer.ID]*x509.Certificate
+}
+
+func NewAdmission(cfg *netBase.NetConf) (*Admission, error) {
+	a := &Admission{
+		policies: make(map[string]bool),
+		accounts: make(map[string]bool),
+		peers:    make(map[peer.ID]*x509.Certificate),
+	}
+	for _, policy := range cfg.Admission.Policies {
+		switch policy {
+		case netBase.AdmissionAllowlist, netBase.AdmissionCA, netBase.AdmissionRegistry:
+			a.policies[policy] = true
+		default:
+			return nil, fmt.Errorf("%w: %s", ErrAdmissionPolicy, policy)
+		}
+	}
+	for _, account := range cfg.Admission.Accounts {
+		a.accounts[account] = true
+	}
+
+	if a.policies[netBase.AdmissionCA] {
+		bs, err := ioutil.ReadFile(filepath.Join(cfg.KeyPath, cfg.Admission.IssuerCert))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrIssuerCert, err)
+		}
+		for block, rest := pem.Decode(bs); block != nil; block, rest = pem.Decode(rest) {
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %v", ErrIssuerCert, err)
+			}
+			a.issuers = append(a.issuers, cert)
+		}
+		if len(a.issuers) == 0 {
+			return nil, ErrIssuerCert
+		}
+	}
+	return a, nil
+}
+
+func (a *Admission) Enabled() bool {
+	return a != nil && len(a.policies) > 0
+}
+
+// Check id为证书公钥对应的节点ID
+func (a *Admission) Check(cert *x509.Certificate, id peer.ID) error {
+	if !a.Enabled() {
+		return nil
+	}
+	if !a.policies[netBase.AdmissionRegistry] {
+		return a.check(cert, id, nil)
+	}
+
+	a.mutex.Lock()
+	registry := a.registry
+	_, known := a.peers[id]
+	full := len(a.peers) >= MaxPendingPeers
+	a.mutex.Unlock()
+	// 登记表就绪前临时准入的节点数有上限
+	if registry == nil && !known && full {
+		return ErrTooManyPending
+	}
+	if err := a.check(cert, id, registry); err != nil {
+		return err
+	}
+
+	a.mutex.Lock()
+	a.peers[id] = cert
+	a.mutex.Unlock()
+	return nil
+}
+
+// check registry为空时节点登记表尚未就绪，临时准入
+func (a *Admission) check(cert *x509.Certificate, id peer.ID, registry netBase.NodeRegistry) error {
+	if a.policies[netBase.AdmissionCA] && !a.issued(cert) {
+		return ErrPeerNotIssued
+	}
+
+	account := CertAccount(cert)
+	pending := a.policies[netBase.AdmissionRegistry] && registry == nil
+	if a.policies[netBase.AdmissionRegistry] && registry != nil {
+		registered, err := registry.GetAccountByPeerID(id.Pretty())
+		if err != nil || registered == "" {
+			return ErrPeerNotRegistered
+		}
+		if account != "" && account != registered {
+			return ErrPeerAccountMismatch
+		}
+		account = registered
+	}
+
+	// 账户需要从登记表获取时，等登记表就绪后再检查白名单
+	if a.policies[netBase.AdmissionAllowlist] && !a.accounts[account] && !(pending && account == "") {
+		return ErrPeerNotAllowed
+	}
+	return nil
+}
+
+// SetNodeRegistry 设置节点登记表，返回已准入但未通过检查的节点
+func (a *Admission) SetNodeRegistry(registry netBase.NodeRegistry) []peer.ID {
+	a.mutex.Lock()
+	a.registry = registry
+	a.mutex.Unlock()
+	return a.Recheck()
+}
+
+// Recheck 按最新的节点登记表重新检查已准入的节点，节点轮换或注销登记后返回未通过检查的节点
+func (a *Admission) Recheck() []peer.ID {
+	a.mutex.Lock()
+	registry := a.registry
+	peers := make(map[peer.ID]*x509.Certificate, len(a.peers))
+	for id, cert := range a.peers {
+		peers[id] = cert
+	}
+	a.mutex.Unlock()
+	if registry == nil {
+		return nil
+	}
+
+	var rejected []peer.ID
+	for id, cert := range peers {
+		if err := a.check(cert, id, registry); err != nil {
+			rejected = append(rejected, id)
+			a.Forget(id)
+		}
+	}
+	return rejected
+}
+
+// Forget 节点断开连接后不再需要重新检查
+func (a *Admission) Forget(id peer.ID) {
+	if !a.Enabled() {
+		return
+	}
+	a.mutex.Lock()
+	delete(a.peers, id)
+	a.mutex.Unlock()
+}
+
+// RegistryEnabled 是否按节点登记表准入
+func (a *Admission) RegistryEnabled() bool {
+	return a.Enabled() && a.policies[netBase.AdmissionRegistry]
+}
+
+func (a *Admission) issued(cert *x509.Certificate) bool {
+	for _, issuer := range a.issuers {
+		if cert.CheckSignatureFrom(issuer) == nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,270 @@
+package p2pv2
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/url"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/wooyang2018/corechain/logger"
+	mock "github.com/wooyang2018/corechain/mock/config"
+	mockNet "github.com/wooyang2018/corechain/mock/testnet"
+	netBase "github.com/wooyang2018/corechain/network/base"
+)
+
+const (
+	testAccount1 = "TeyyPLpp9L7QAcxHangtcHTu7HUZ6iydY"
+	testAccount2 = "SmJG3rH2ZzYQ9ojxhbRCPwFiE9y6pD1Co"
+)
+
+type fakeRegistry map[string]string
+
+func (r fakeRegistry) GetAccountByPeerID(peerID string) (string, error) {
+	if account, ok := r[peerID]; ok {
+		return account, nil
+	}
+	return "", errors.New("not registered")
+}
+
+func genCert(t *testing.T, cn, account string, parent *x509.Certificate, parentKey *rsa.PrivateKey) (*x509.Certificate, *rsa.PrivateKey) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: cn},
+		DNSNames:              []string{cn},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  parent == nil,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+	if account != "" {
+		tmpl.URIs = []*url.URL{{Scheme: AccountURIScheme, Opaque: account}}
+	}
+	if parent == nil {
+		parent, parentKey = tmpl, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, key
+}
+
+func TestAdmissionAllowlistAndCA(t *testing.T) {
+	ca, caKey := genCert(t, "ca", "", nil, nil)
+	other, otherKey := genCert(t, "other", "", nil, nil)
+	cert1, _ := genCert(t, "node1", testAccount1, ca, caKey)
+	cert2, _ := genCert(t, "node2", testAccount2, ca, caKey)
+	cert3, _ := genCert(t, "node3", testAccount1, other, otherKey)
+
+	dir := t.TempDir()
+	pemData := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.Raw})
+	if err := ioutil.WriteFile(filepath.Join(dir, "cacert.pem"), pemData, 0600); err != nil {
+		t.Fatal(err)
+	}
+	cfg := &netBase.NetConf{KeyPath: dir}
+	cfg.Admission.Policies = []string{netBase.AdmissionAllowlist, netBase.AdmissionCA}
+	cfg.Admission.Accounts = []string{testAccount1}
+	cfg.Admission.IssuerCert = "cacert.pem"
+	a, err := NewAdmission(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	id, _ := peer.Decode(testPeer1)
+	if err := a.Check(cert1, id); err != nil {
+		t.Errorf("expect admitted, got %v", err)
+	}
+	if err := a.Check(cert2, id); err != ErrPeerNotAllowed {
+		t.Errorf("expect %v, got %v", ErrPeerNotAllowed, err)
+	}
+	if err := a.Check(cert3, id); err != ErrPeerNotIssued {
+		t.Errorf("expect %v, got %v", ErrPeerNotIssued, err)
+	}
+
+	cfg.Admission.Policies = []string{"unknown"}
+	if _, err := NewAdmission(cfg); !errors.Is(err, ErrAdmissionPolicy) {
+		t.Errorf("expect %v, got %v", ErrAdmissionPolicy, err)
+	}
+}
+
+func TestAdmissionRegistry(t *testing.T) {
+	cert1, _ := genCert(t, "node1", testAccount1, nil, nil)
+	cert2, _ := genCert(t, "node2", "", nil, nil)
+
+	cfg := &netBase.NetConf{}
+	cfg.Admission.Policies = []string{netBase.AdmissionRegistry, netBase.AdmissionAllowlist}
+	cfg.Admission.Accounts = []string{testAccount1, testAccount2}
+	a, err := NewAdmission(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 登记表就绪前临时准入
+	id1, _ := peer.Decode(testPeer1)
+	id2, _ := peer.Decode(testPeer2)
+	if err := a.Check(cert1, id1); err != nil {
+		t.Errorf("expect admitted, got %v", err)
+	}
+	if err := a.Check(cert2, id2); err != nil {
+		t.Errorf("expect admitted, got %v", err)
+	}
+
+	// 节点1证书账户与登记的账户不一致，被拒绝
+	registry := fakeRegistry{testPeer1: testAccount2, testPeer2: testAccount2}
+	rejected := a.SetNodeRegistry(registry)
+	if len(rejected) != 1 || rejected[0] != id1 {
+		t.Fatalf("unexpected rejected peers: %v", rejected)
+	}
+
+	if err := a.Check(cert2, id2); err != nil {
+		t.Errorf("expect admitted, got %v", err)
+	}
+	delete(registry, testPeer2)
+	if err := a.Check(cert2, id2); err != ErrPeerNotRegistered {
+		t.Errorf("expect %v, got %v", ErrPeerNotRegistered, err)
+	}
+}
+
+func newTestTransport(t *testing.T, cn string, ca *x509.Certificate, caKey *rsa.PrivateKey, admission *Admission) *Transport {
+	cert, key := genCert(t, cn, "", ca, caKey)
+	privKey, _, err := crypto.KeyPairFromStdKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := peer.IDFromPrivateKey(privKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pool := x509.NewCertPool()
+	pool.AddCert(ca)
+	return &Transport{
+		config: &tls.Config{
+			ServerName:   "node",
+			Certificates: []tls.Certificate{{Certificate: [][]byte{cert.Raw}, PrivateKey: key}},
+			RootCAs:      pool,
+			ClientCAs:    pool,
+			ClientAuth:   tls.RequireAndVerifyClientCert,
+		},
+		privKey:   privKey,
+		localPeer: id,
+		admission: admission,
+	}
+}
+
+func TestSecureRejectUnregistered(t *testing.T) {
+	ca, caKey := genCert(t, "ca", "", nil, nil)
+	cfg := &netBase.NetConf{}
+	cfg.Admission.Policies = []string{netBase.AdmissionRegistry}
+	serverAdmission, _ := NewAdmission(cfg)
+	clientAdmission, _ := NewAdmission(cfg)
+	server := newTestTransport(t, "node", ca, caKey, serverAdmission)
+	client := newTestTransport(t, "node", ca, caKey, clientAdmission)
+
+	// 客户端已登记，服务端未登记
+	registry := fakeRegistry{client.localPeer.Pretty(): testAccount1}
+	serverAdmission.SetNodeRegistry(registry)
+	clientAdmission.SetNodeRegistry(registry)
+
+	c1, c2 := net.Pipe()
+	errCh := make(chan error, 1)
+	go func() {
+		defer c1.Close()
+		_, err := server.SecureInbound(context.Background(), c1, "")
+		errCh <- err
+	}()
+	if _, err := client.SecureOutbound(context.Background(), c2, server.localPeer); err != ErrPeerNotRegistered {
+		t.Errorf("expect %v, got %v", ErrPeerNotRegistered, err)
+	}
+	if err := <-errCh; err != nil {
+		t.Errorf("expect admitted, got %v", err)
+	}
+
+	// 客户端注销登记、服务端登记后，重新检查时客户端被拒绝
+	delete(registry, client.localPeer.Pretty())
+	registry[server.localPeer.Pretty()] = testAccount2
+	rejected := serverAdmission.Recheck()
+	if len(rejected) != 1 || rejected[0] != client.localPeer {
+		t.Fatalf("unexpected rejected peers: %v", rejected)
+	}
+
+	c1, c2 = net.Pipe()
+	go func() {
+		defer c1.Close()
+		_, err := server.SecureInbound(context.Background(), c1, "")
+		errCh <- err
+	}()
+	client.SecureOutbound(context.Background(), c2, server.localPeer)
+	c2.Close()
+	if err := <-errCh; err != ErrPeerNotRegistered {
+		t.Errorf("expect %v, got %v", ErrPeerNotRegistered, err)
+	}
+}
+
+func TestAdmissionMaxPending(t *testing.T) {
+	cert, _ := genCert(t, "node", "", nil, nil)
+	cfg := &netBase.NetConf{}
+	cfg.Admission.Policies = []string{netBase.AdmissionRegistry}
+	a, err := NewAdmission(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < MaxPendingPeers; i++ {
+		if err := a.Check(cert, peer.ID(fmt.Sprint(i))); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := a.Check(cert, peer.ID("full")); err != ErrTooManyPending {
+		t.Errorf("expect %v, got %v", ErrTooManyPending, err)
+	}
+	// 已临时准入的节点重连不受限制，断开的节点不再占用名额
+	if err := a.Check(cert, peer.ID("0")); err != nil {
+		t.Errorf("expect admitted, got %v", err)
+	}
+	a.Forget(peer.ID("0"))
+	if err := a.Check(cert, peer.ID("full")); err != nil {
+		t.Errorf("expect admitted, got %v", err)
+	}
+}
+
+func TestAdmissionRequireTls(t *testing.T) {
+	econf, _ := mock.GetMockEnvConf()
+	logger.InitMLog(econf.GenConfFilePath(econf.LogConf), econf.GenDirAbsPath(econf.LogDir))
+	ecfg, err := mockNet.GetMockEnvConf("node1/conf/env.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecfg.NetConf = NetConf
+	ctx, err := netBase.NewNetCtx(ecfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx.P2PConf.IsTls = false
+	ctx.P2PConf.Admission.Policies = []string{netBase.AdmissionRegistry}
+
+	if err := NewP2PServerV2().Init(ctx); err != ErrAdmissionNoTls {
+		t.Errorf("expect %v, got %v", ErrAdmissionNoTls, err)
+	}
+}
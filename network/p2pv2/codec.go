@@ -0,0 +1,256 @@
+package p2pv2
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	"github.com/wooyang2018/corechain/network"
+	netBase "github.com/wooyang2018/corechain/network/base"
+	"github.com/wooyang2018/corechain/protos"
+	"google.golang.org/protobuf/proto"
+)
+
+// 流上支持的压缩算法
+const (
+	CodecNone   = "none"
+	CodecSnappy = "snappy"
+	CodecZstd   = "zstd"
+)
+
+const (
+	// 分块传输时每块的最大长度
+	chunkSize = 1 << 20
+	// 分块标记，表示后面还有数据块
+	chunkFlagMore = 1 << 0
+)
+
+var (
+	ErrUnknownCodec    = errors.New("unknown codec")
+	ErrMessageTooLarge = errors.New("message too large")
+)
+
+// chunkProtocolID 分块传输协议，协议ID中携带压缩算法，建立流时由multistream协商双方都支持的算法
+func chunkProtocolID(codec string) protocol.ID {
+	return protocol.ID(fmt.Sprintf("%s/chunk/%s", netBase.ProtocolVersion, codec))
+}
+
+// supportedProtocols 按偏好排序的协议列表，最后兼容不支持分块传输的旧节点
+func supportedProtocols(cfg *netBase.NetConf) []protocol.ID {
+	protocols := make([]protocol.ID, 0, len(cfg.Codecs)+2)
+	for _, codec := range cfg.Codecs {
+		if codec == CodecSnappy || codec == CodecZstd {
+			protocols = append(protocols, chunkProtocolID(codec))
+		}
+	}
+	protocols = append(protocols, chunkProtocolID(CodecNone), netBase.ProtocolVersion)
+	return protocols
+}
+
+// codecOfProtocol 返回协议对应的压缩算法，旧协议返回空
+func codecOfProtocol(id protocol.ID) string {
+	for _, codec := range []string{CodecNone, CodecSnappy, CodecZstd} {
+		if id == chunkProtocolID(codec) {
+			return codec
+		}
+	}
+	return ""
+}
+
+// compressor 压缩和解压整条消息，解压后的长度不超过maxSize
+type compressor struct {
+	codec   string
+	maxSize int
+	encoder *zstd.Encoder
+	decoder *zstd.Decoder
+}
+
+func newCompressor(codec string, maxSize int) (*compressor, error) {
+	c := &compressor{codec: codec, maxSize: maxSize}
+	switch codec {
+	case CodecNone, CodecSnappy:
+	case CodecZstd:
+		var err error
+		if c.encoder, err = zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1)); err != nil {
+			return nil, err
+		}
+		c.decoder, err = zstd.NewReader(nil, zstd.WithDecoderConcurrency(1),
+			zstd.WithDecoderMaxMemory(uint64(maxSize)))
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, ErrUnknownCodec
+	}
+	return c, nil
+}
+
+func (c *compressor) encode(data []byte) []byte {
+	switch c.codec {
+	case CodecSnappy:
+		return snappy.Encode(nil, data)
+	case CodecZstd:
+		return c.encoder.EncodeAll(data, nil)
+	default:
+		return data
+	}
+}
+
+func (c *compressor) decode(data []byte) ([]byte, error) {
+	switch c.codec {
+	case CodecSnappy:
+		n, err := snappy.DecodedLen(data)
+		if err != nil {
+			return nil, err
+		}
+		if n > c.maxSize {
+			return nil, ErrMessageTooLarge
+		}
+		return snappy.Decode(nil, data)
+	case CodecZstd:
+		return c.decoder.DecodeAll(data, nil)
+	default:
+		return data, nil
+	}
+}
+
+func (c *compressor) Close() {
+	if c.decoder != nil {
+		c.decoder.Close()
+	}
+}
+
+// rawMessage 流上已经压缩时，消息体不再单独snappy压缩，避免重复压缩
+// 返回新消息，不修改原消息，原消息可能同时发往其他节点
+func rawMessage(msg *protos.CoreMessage) *protos.CoreMessage {
+	if !msg.GetHeader().GetEnableCompress() || len(msg.GetData().GetMsgInfo()) == 0 {
+		return msg
+	}
+	data, err := network.Decompress(msg)
+	if err != nil {
+		return msg
+	}
+
+	raw := &protos.CoreMessage{
+		Header: proto.Clone(msg.Header).(*protos.CoreMessage_MessageHeader),
+		Data:   &protos.CoreMessage_MessageData{MsgInfo: data},
+	}
+	raw.Header.EnableCompress = false
+	raw.Header.DataCheckSum = network.Checksum(raw)
+	return raw
+}
+
+// NewChunkWriter 整条消息压缩后按chunkSize分块写入
+// 帧格式: varint(长度) | 标记(1字节) | 数据块
+func NewChunkWriter(w io.Writer, c *compressor) WriteCloser {
+	return &chunkWriter{w: w, c: c, lenBuf: make([]byte, binary.MaxVarintLen64)}
+}
+
+type chunkWriter struct {
+	w      io.Writer
+	c      *compressor
+	lenBuf []byte
+}
+
+func (cw *chunkWriter) WriteMsg(msg proto.Message) error {
+	if cm, ok := msg.(*protos.CoreMessage); ok && cw.c.codec != CodecNone {
+		msg = rawMessage(cm)
+	}
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	data = cw.c.encode(data)
+
+	for {
+		chunk, flag := data, byte(0)
+		if len(chunk) > chunkSize {
+			chunk, flag = data[:chunkSize], chunkFlagMore
+		}
+		n := binary.PutUvarint(cw.lenBuf, uint64(len(chunk)+1))
+		if _, err := cw.w.Write(cw.lenBuf[:n]); err != nil {
+			return err
+		}
+		if _, err := cw.w.Write([]byte{flag}); err != nil {
+			return err
+		}
+		if _, err := cw.w.Write(chunk); err != nil {
+			return err
+		}
+		data = data[len(chunk):]
+		if flag&chunkFlagMore == 0 {
+			return nil
+		}
+	}
+}
+
+func (cw *chunkWriter) Close() error {
+	if closer, ok := cw.w.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// NewChunkReader 读取并合并数据块，合并后的消息长度不超过compressor的maxSize
+func NewChunkReader(r io.Reader, c *compressor) ReadCloser {
+	var closer io.Closer
+	if cl, ok := r.(io.Closer); ok {
+		closer = cl
+	}
+	return &chunkReader{r: bufio.NewReader(r), c: c, closer: closer}
+}
+
+type chunkReader struct {
+	r      *bufio.Reader
+	c      *compressor
+	closer io.Closer
+}
+
+func (cr *chunkReader) ReadMsg(msg proto.Message) error {
+	var data []byte
+	for {
+		length64, err := binary.ReadUvarint(cr.r)
+		if err != nil {
+			return err
+		}
+		if length64 < 1 || length64 > chunkSize+1 {
+			return io.ErrShortBuffer
+		}
+		length := int(length64) - 1
+		if len(data)+length > cr.c.maxSize {
+			return ErrMessageTooLarge
+		}
+
+		flag, err := cr.r.ReadByte()
+		if err != nil {
+			return err
+		}
+		offset := len(data)
+		data = append(data, make([]byte, length)...)
+		if _, err := io.ReadFull(cr.r, data[offset:]); err != nil {
+			return err
+		}
+		if flag&chunkFlagMore == 0 {
+			break
+		}
+	}
+
+	data, err := cr.c.decode(data)
+	if err != nil {
+		return err
+	}
+	return proto.Unmarshal(data, msg)
+}
+
+func (cr *chunkReader) Close() error {
+	cr.c.Close()
+	if cr.closer != nil {
+		return cr.closer.Close()
+	}
+	return nil
+}
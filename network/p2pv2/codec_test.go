@@ -0,0 +1,87 @@
+package p2pv2
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/wooyang2018/corechain/network"
+	netBase "github.com/wooyang2018/corechain/network/base"
+	"github.com/wooyang2018/corechain/protos"
+)
+
+func TestChunkReadWriter(t *testing.T) {
+	// 随机数据不可压缩，压缩后仍然超过一个数据块
+	txs := &protos.Transactions{}
+	for i := 0; i < 3; i++ {
+		data := make([]byte, chunkSize/2)
+		rand.Read(data)
+		txs.Txs = append(txs.Txs, &protos.Transaction{Txid: data[:32], Desc: data})
+	}
+
+	for _, codec := range []string{CodecNone, CodecSnappy, CodecZstd} {
+		c, err := newCompressor(codec, 4*chunkSize)
+		if err != nil {
+			t.Fatal(err)
+		}
+		buf := new(bytes.Buffer)
+		writer := NewChunkWriter(buf, c)
+		reader := NewChunkReader(buf, c)
+
+		msg := network.NewMessage(protos.CoreMessage_BATCHPOSTTX, txs)
+		if err := writer.WriteMsg(msg); err != nil {
+			t.Fatal(err)
+		}
+		if err := writer.WriteMsg(network.NewMessage(protos.CoreMessage_POSTTX, nil)); err != nil {
+			t.Fatal(err)
+		}
+
+		got := new(protos.CoreMessage)
+		if err := reader.ReadMsg(got); err != nil {
+			t.Fatalf("%s read error: %v", codec, err)
+		}
+		var gotTxs protos.Transactions
+		if err := network.Unmarshal(got, &gotTxs); err != nil {
+			t.Fatalf("%s unmarshal error: %v", codec, err)
+		}
+		if len(gotTxs.Txs) != len(txs.Txs) || !bytes.Equal(gotTxs.Txs[2].Desc, txs.Txs[2].Desc) {
+			t.Fatalf("%s message mismatch", codec)
+		}
+		if GossipMessageID(got) != GossipMessageID(msg) {
+			t.Fatalf("%s gossip message id changed", codec)
+		}
+		if err := reader.ReadMsg(got); err != nil || got.GetHeader().GetType() != protos.CoreMessage_POSTTX {
+			t.Fatalf("%s read second message error: %v", codec, err)
+		}
+	}
+}
+
+func TestChunkReaderLimit(t *testing.T) {
+	data := make([]byte, 2*chunkSize)
+	rand.Read(data)
+	msg := network.NewMessage(protos.CoreMessage_POSTTX, &protos.Transaction{Desc: data})
+
+	c, _ := newCompressor(CodecNone, 4*chunkSize)
+	buf := new(bytes.Buffer)
+	if err := NewChunkWriter(buf, c).WriteMsg(msg); err != nil {
+		t.Fatal(err)
+	}
+
+	small, _ := newCompressor(CodecNone, chunkSize)
+	if err := NewChunkReader(buf, small).ReadMsg(new(protos.CoreMessage)); err != ErrMessageTooLarge {
+		t.Fatalf("expect message too large, got: %v", err)
+	}
+}
+
+func TestSupportedProtocols(t *testing.T) {
+	cfg := netBase.GetDefP2PConf()
+	// 不支持的算法被忽略
+	cfg.Codecs = []string{CodecZstd, "lz4", CodecSnappy}
+	protocols := supportedProtocols(cfg)
+	if len(protocols) != 4 || protocols[0] != chunkProtocolID(CodecZstd) {
+		t.Fatalf("unexpected protocols: %v", protocols)
+	}
+	if codecOfProtocol(protocols[1]) != CodecSnappy || codecOfProtocol(protocols[3]) != "" {
+		t.Fatalf("unexpected codec of protocols: %v", protocols)
+	}
+}
@@ -0,0 +1,64 @@
+package p2pv2
+
+import (
+	"context"
+	"strings"
+
+	libnet "github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/wooyang2018/corechain/logger"
+)
+
+const (
+	dnsaddrPrefix = "dnsaddr="
+)
+
+// txtResolver 查询DNS TXT记录，默认使用net.DefaultResolver
+type txtResolver interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+}
+
+// resolveSeeds 查询种子域名的TXT记录，每条记录形如dnsaddr=/ip4/1.2.3.4/tcp/47101/p2p/Qm...
+func resolveSeeds(ctx context.Context, resolver txtResolver, seeds []string, log logger.Logger) []string {
+	var addrs []string
+	for _, seed := range seeds {
+		txts, err := resolver.LookupTXT(ctx, seed)
+		if err != nil {
+			log.Warn("p2p: lookup dns seed error", "seed", seed, "error", err)
+			continue
+		}
+		found := parseDnsaddrs(txts)
+		log.Debug("p2p: resolve dns seed", "seed", seed, "peers", found)
+		addrs = append(addrs, found...)
+	}
+	return addrs
+}
+
+// parseDnsaddrs 只保留带/p2p/节点ID的地址
+func parseDnsaddrs(txts []string) []string {
+	var addrs []string
+	for _, txt := range txts {
+		if !strings.HasPrefix(txt, dnsaddrPrefix) {
+			continue
+		}
+		addr := strings.TrimPrefix(txt, dnsaddrPrefix)
+		if _, err := GetPeerIDByAddress(addr); err != nil {
+			continue
+		}
+		addrs = append(addrs, addr)
+	}
+	return addrs
+}
+
+// mdnsNotifee 局域网内通过libp2p mdns发现的节点交给connectPeer建立连接
+type mdnsNotifee struct {
+	srv *P2PServerV2
+}
+
+func (n *mdnsNotifee) HandlePeerFound(info peer.AddrInfo) {
+	if info.ID == n.srv.host.ID() || n.srv.host.Network().Connectedness(info.ID) == libnet.Connected {
+		return
+	}
+	n.srv.log.Debug("p2p: mdns found peer", "peer", info)
+	n.srv.connectPeer([]peer.AddrInfo{info})
+}
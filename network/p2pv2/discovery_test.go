@@ -0,0 +1,100 @@
+package p2pv2
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/libp2p/go-libp2p"
+	libnet "github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/wooyang2018/corechain/logger"
+	mock "github.com/wooyang2018/corechain/mock/config"
+	mockNet "github.com/wooyang2018/corechain/mock/testnet"
+	netBase "github.com/wooyang2018/corechain/network/base"
+)
+
+const (
+	testPeer1 = "Qmf2HeHe4sspGkfRCTq6257Vm3UHzvh2TeQJHHvHzzuFw6"
+	testPeer2 = "QmQKp8pLWSgV4JiGjuULKV1JsdpxUtnDEUMP8sGaaUbwVL"
+)
+
+type fakeResolver map[string][]string
+
+func (r fakeResolver) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	if txts, ok := r[name]; ok {
+		return txts, nil
+	}
+	return nil, errors.New("no such host")
+}
+
+func TestResolveSeeds(t *testing.T) {
+	ecfg, err := mock.GetMockEnvConf()
+	if err != nil {
+		t.Fatal(err)
+	}
+	logger.InitMLog(ecfg.GenConfFilePath(ecfg.LogConf), ecfg.GenDirAbsPath(ecfg.LogDir))
+	log, _ := logger.NewLogger("", "network")
+
+	resolver := fakeResolver{
+		"seed1.example.com": {
+			"dnsaddr=/ip4/10.0.0.1/tcp/47101/p2p/" + testPeer1,
+			"v=spf1 -all",
+			"dnsaddr=/ip4/10.0.0.2/tcp/47101",
+		},
+		"seed2.example.com": {"dnsaddr=/ip4/10.0.0.3/tcp/47101/p2p/" + testPeer2},
+	}
+	addrs := resolveSeeds(context.Background(), resolver,
+		[]string{"seed1.example.com", "missing.example.com", "seed2.example.com"}, log)
+	expect := []string{
+		"/ip4/10.0.0.1/tcp/47101/p2p/" + testPeer1,
+		"/ip4/10.0.0.3/tcp/47101/p2p/" + testPeer2,
+	}
+	if len(addrs) != len(expect) {
+		t.Fatalf("expect %v, got %v", expect, addrs)
+	}
+	for i := range expect {
+		if addrs[i] != expect[i] {
+			t.Errorf("expect %s, got %s", expect[i], addrs[i])
+		}
+	}
+}
+
+func TestMdnsNotifee(t *testing.T) {
+	ecfg, err := mock.GetMockEnvConf()
+	if err != nil {
+		t.Fatal(err)
+	}
+	logger.InitMLog(ecfg.GenConfFilePath(ecfg.LogConf), ecfg.GenDirAbsPath(ecfg.LogDir))
+	necfg, err := mockNet.GetMockEnvConf("node1/conf/env.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	necfg.NetConf = NetConf
+	ctx, err := netBase.NewNetCtx(necfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	h1, err := libp2p.New(libp2p.ListenAddrStrings("/ip4/127.0.0.1/tcp/0"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer h1.Close()
+	h2, err := libp2p.New(libp2p.ListenAddrStrings("/ip4/127.0.0.1/tcp/0"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer h2.Close()
+
+	n := &mdnsNotifee{srv: &P2PServerV2{ctx: ctx, log: ctx.GetLog(), host: h2}}
+	// 发现自身时忽略
+	n.HandlePeerFound(peer.AddrInfo{ID: h2.ID(), Addrs: h2.Addrs()})
+	if len(h2.Network().Peers()) != 0 {
+		t.Fatal("should not connect to self")
+	}
+	n.HandlePeerFound(peer.AddrInfo{ID: h1.ID(), Addrs: h1.Addrs()})
+	if h2.Network().Connectedness(h1.ID()) != libnet.Connected {
+		t.Fatal("found peer not connected")
+	}
+}
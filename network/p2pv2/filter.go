@@ -106,6 +106,29 @@ func (ss *StaticNodeStrategy) Filter() ([]peer.ID, error) {
 	return ss.srv.staticNodes[ss.bcname], nil
 }
 
+// ReputationFilter a peer filter that skips low score peers
+type ReputationFilter struct {
+	srv    *P2PServerV2
+	filter PeerFilter
+}
+
+// Filter 过滤掉信誉分过低或已封禁的节点
+func (rf *ReputationFilter) Filter() ([]peer.ID, error) {
+	peers, err := rf.filter.Filter()
+	if err != nil {
+		return peers, err
+	}
+
+	peerIDs := make([]peer.ID, 0, len(peers))
+	for _, peerID := range peers {
+		if rf.srv.reputation.IsLowScore(peerID.Pretty()) {
+			continue
+		}
+		peerIDs = append(peerIDs, peerID)
+	}
+	return peerIDs, nil
+}
+
 // MultiStrategy a peer filter that contains multiple filters
 type MultiStrategy struct {
 	filters []PeerFilter
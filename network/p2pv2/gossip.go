@@ -0,0 +1,370 @@
+package p2pv2
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/peer"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/wooyang2018/corechain/logger"
+	"github.com/wooyang2018/corechain/network"
+	netBase "github.com/wooyang2018/corechain/network/base"
+	"github.com/wooyang2018/corechain/protos"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// 消息去重的保留时间，需覆盖消息在全网传播的时间
+	gossipSeenTTL = 2 * time.Minute
+	// 每个主题订阅的消息缓冲
+	gossipSubBufSize = 1024
+)
+
+var (
+	ErrGossipType     = errors.New("message type not broadcast by gossip")
+	ErrGossipNoStream = errors.New("gossip message has no stream to response")
+)
+
+// gossipTypes 走gossip广播的消息类型
+var gossipTypes = map[protos.CoreMessage_MessageType]bool{
+	protos.CoreMessage_POSTTX:      true,
+	protos.CoreMessage_BATCHPOSTTX: true,
+	protos.CoreMessage_SENDBLOCK:   true,
+	protos.CoreMessage_NEW_BLOCKID: true,
+}
+
+// Gossip 基于libp2p gossipsub广播区块和交易，每类消息为一个主题，链名在消息头中，
+// 消息经主题校验器校验通过后才分发给本地订阅者，并由gossipsub继续转发给mesh节点
+type Gossip struct {
+	ctx        context.Context
+	cancel     context.CancelFunc
+	log        logger.Logger
+	id         peer.ID
+	ps         *pubsub.PubSub
+	topics     map[protos.CoreMessage_MessageType]*pubsub.Topic
+	dispatcher netBase.Dispatcher
+	report     func(peerID string, event netBase.PeerEvent)
+	mutex      sync.RWMutex
+	validators map[protos.CoreMessage_MessageType][]netBase.GossipValidator
+}
+
+// NewGossip create Gossip instance, report is called when peer forwards invalid message
+func NewGossip(ctx *netBase.NetCtx, host host.Host, dispatcher netBase.Dispatcher,
+	reputation *network.Reputation, report func(peerID string, event netBase.PeerEvent)) (*Gossip, error) {
+	gctx, cancel := context.WithCancel(ctx)
+	g := &Gossip{
+		ctx:        gctx,
+		cancel:     cancel,
+		log:        ctx.GetLog(),
+		id:         host.ID(),
+		topics:     make(map[protos.CoreMessage_MessageType]*pubsub.Topic),
+		dispatcher: dispatcher,
+		report:     report,
+		validators: make(map[protos.CoreMessage_MessageType][]netBase.GossipValidator),
+	}
+
+	opts := []pubsub.Option{
+		pubsub.WithGossipSubParams(gossipSubParams(ctx.P2PConf.GossipDegree)),
+		// 静态节点作为direct peer，始终推送全部消息
+		pubsub.WithDirectPeers(staticPeers(ctx)),
+		pubsub.WithMessageIdFn(gossipMessageID),
+		pubsub.WithSeenMessagesTTL(gossipSeenTTL),
+		pubsub.WithMaxMessageSize(int(ctx.P2PConf.MaxMessageSize) << 20),
+		// 信誉分过低的节点不参与gossip
+		pubsub.WithPeerFilter(func(pid peer.ID, topic string) bool {
+			return !reputation.IsLowScore(pid.Pretty())
+		}),
+	}
+	ps, err := pubsub.NewGossipSub(gctx, host, opts...)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	g.ps = ps
+
+	for typ := range gossipTypes {
+		topic, err := ps.Join(GossipTopic(typ))
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		if err := ps.RegisterTopicValidator(topic.String(), g.topicValidator(typ)); err != nil {
+			cancel()
+			return nil, err
+		}
+		g.topics[typ] = topic
+		g.AddValidator(typ, validateChecksum)
+	}
+	g.AddValidator(protos.CoreMessage_POSTTX, validateTx)
+	g.AddValidator(protos.CoreMessage_BATCHPOSTTX, validateTxs)
+	g.AddValidator(protos.CoreMessage_SENDBLOCK, validateBlock)
+	g.AddValidator(protos.CoreMessage_NEW_BLOCKID, validateBlock)
+	return g, nil
+}
+
+// gossipSubParams 按配置的mesh节点数调整gossipsub参数，需满足Dout < Dlo <= D <= Dhi
+func gossipSubParams(degree int) pubsub.GossipSubParams {
+	params := pubsub.DefaultGossipSubParams()
+	if degree <= 0 {
+		degree = netBase.DefaultGossipDegree
+	}
+	params.D = degree
+	if params.Dlo > degree {
+		params.Dlo = degree
+	}
+	if params.Dhi < 2*degree {
+		params.Dhi = 2 * degree
+	}
+	if params.Dscore > degree {
+		params.Dscore = degree
+	}
+	if params.Dout > degree/2 {
+		params.Dout = degree / 2
+	}
+	if params.Dout >= params.Dlo {
+		params.Dout = params.Dlo - 1
+	}
+	return params
+}
+
+func staticPeers(ctx *netBase.NetCtx) []peer.AddrInfo {
+	var peers []peer.AddrInfo
+	seen := make(map[peer.ID]bool)
+	for _, addrs := range ctx.P2PConf.StaticNodes {
+		for _, addr := range addrs {
+			maddr, err := multiaddr.NewMultiaddr(addr)
+			if err != nil {
+				continue
+			}
+			info, err := peer.AddrInfoFromP2pAddr(maddr)
+			if err != nil || seen[info.ID] {
+				continue
+			}
+			seen[info.ID] = true
+			peers = append(peers, *info)
+		}
+	}
+	return peers
+}
+
+// IsGossipMessage check whether the message is broadcast by gossip
+func IsGossipMessage(msg *protos.CoreMessage) bool {
+	return gossipTypes[msg.GetHeader().GetType()]
+}
+
+// GossipTopic return the gossipsub topic of message type
+func GossipTopic(typ protos.CoreMessage_MessageType) string {
+	return fmt.Sprintf("/%s/gossip/%s", netBase.Namespace, typ.String())
+}
+
+// GossipMessageID 按消息内容生成ID，与logid、发送方以及是否压缩无关，同一消息经不同路径到达时ID相同
+func GossipMessageID(msg *protos.CoreMessage) string {
+	data, err := network.Decompress(msg)
+	if err != nil {
+		data = msg.GetData().GetMsgInfo()
+	}
+	h := sha256.New()
+	h.Write([]byte(msg.GetHeader().GetBcname()))
+	h.Write([]byte(GossipTopic(msg.GetHeader().GetType())))
+	h.Write(data)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func gossipMessageID(m *pb.Message) string {
+	var msg protos.CoreMessage
+	if err := proto.Unmarshal(m.GetData(), &msg); err != nil {
+		return pubsub.DefaultMsgIdFn(m)
+	}
+	return GossipMessageID(&msg)
+}
+
+// AddValidator add validator for message type, validators are called in order
+func (g *Gossip) AddValidator(typ protos.CoreMessage_MessageType, validator netBase.GossipValidator) error {
+	if !gossipTypes[typ] {
+		return ErrGossipType
+	}
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	g.validators[typ] = append(g.validators[typ], validator)
+	return nil
+}
+
+// Validate 依次执行消息类型的校验器，返回第一个错误
+func (g *Gossip) Validate(msg *protos.CoreMessage) error {
+	g.mutex.RLock()
+	validators := g.validators[msg.GetHeader().GetType()]
+	g.mutex.RUnlock()
+
+	for _, validator := range validators {
+		if err := validator(msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// topicValidator 校验失败的消息不再分发和转发，并降低转发该消息的节点的信誉分
+func (g *Gossip) topicValidator(typ protos.CoreMessage_MessageType) pubsub.ValidatorEx {
+	return func(ctx context.Context, from peer.ID, m *pubsub.Message) pubsub.ValidationResult {
+		msg := new(protos.CoreMessage)
+		err := proto.Unmarshal(m.GetData(), msg)
+		if err == nil && msg.GetHeader().GetType() != typ {
+			err = ErrGossipType
+		}
+		if err == nil {
+			err = g.Validate(msg)
+		}
+
+		switch {
+		case err == nil:
+			m.ValidatorData = msg
+			return pubsub.ValidationAccept
+		case errors.Is(err, network.ErrGossipIgnore):
+			return pubsub.ValidationIgnore
+		default:
+			g.log.Debug("gossip: validate message failed", "log_id", msg.GetHeader().GetLogid(),
+				"type", typ, "from", from, "error", err)
+			if from != g.id {
+				g.report(from.Pretty(), netBase.PeerEventInvalidMessage)
+			}
+			return pubsub.ValidationReject
+		}
+	}
+}
+
+// Start 订阅全部主题，收到的消息分发给本地订阅者
+func (g *Gossip) Start() error {
+	for _, topic := range g.topics {
+		sub, err := topic.Subscribe(pubsub.WithBufferSize(gossipSubBufSize))
+		if err != nil {
+			return err
+		}
+		go g.readLoop(sub)
+	}
+	return nil
+}
+
+// Stop 关闭gossipsub，订阅随之退出
+func (g *Gossip) Stop() {
+	g.cancel()
+}
+
+func (g *Gossip) readLoop(sub *pubsub.Subscription) {
+	defer sub.Cancel()
+	for {
+		m, err := sub.Next(g.ctx)
+		if err != nil {
+			return
+		}
+		// 本节点发布的消息不再分发
+		if m.ReceivedFrom == g.id {
+			continue
+		}
+		msg, ok := m.ValidatorData.(*protos.CoreMessage)
+		if !ok {
+			continue
+		}
+
+		// 消息来源取gossipsub签名认证的发布者，消息头中的from可被伪造
+		msg.Header.From = m.GetFrom().Pretty()
+		if err := g.dispatcher.Dispatch(msg, gossipStream{}); err != nil {
+			g.log.Debug("gossip: dispatch message failed", "log_id", msg.GetHeader().GetLogid(),
+				"type", msg.GetHeader().GetType(), "from", msg.GetHeader().GetFrom(), "error", err)
+		}
+	}
+}
+
+// Publish 发布消息到消息类型对应的主题，已经收到过的消息不会重复转发
+func (g *Gossip) Publish(msg *protos.CoreMessage) error {
+	topic, ok := g.topics[msg.GetHeader().GetType()]
+	if !ok {
+		return ErrGossipType
+	}
+
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return topic.Publish(g.ctx, data)
+}
+
+// gossipStream gossip消息没有对端的流，不能回复
+type gossipStream struct{}
+
+func (gossipStream) Send(*protos.CoreMessage) error {
+	return ErrGossipNoStream
+}
+
+func validateChecksum(msg *protos.CoreMessage) error {
+	if !network.VerifyChecksum(msg) {
+		return network.ErrMessageChecksum
+	}
+	return nil
+}
+
+func validateTx(msg *protos.CoreMessage) error {
+	var tx protos.Transaction
+	if err := network.Unmarshal(msg, &tx); err != nil {
+		return err
+	}
+	if len(tx.Txid) == 0 {
+		return errors.New("txid is empty")
+	}
+	return nil
+}
+
+func validateTxs(msg *protos.CoreMessage) error {
+	var txs protos.Transactions
+	if err := network.Unmarshal(msg, &txs); err != nil {
+		return err
+	}
+	if len(txs.Txs) == 0 {
+		return errors.New("txs is empty")
+	}
+	return nil
+}
+
+func validateBlock(msg *protos.CoreMessage) error {
+	var block protos.InternalBlock
+	if err := network.Unmarshal(msg, &block); err != nil {
+		return err
+	}
+	if len(block.Blockid) == 0 {
+		return errors.New("blockid is empty")
+	}
+	return nil
+}
+
+// gossipDispatcher 经流直接收到的gossip类消息同样需要通过校验
+type gossipDispatcher struct {
+	netBase.Dispatcher
+	srv *P2PServerV2
+}
+
+func (d *gossipDispatcher) Dispatch(msg *protos.CoreMessage, stream netBase.Stream) error {
+	if msg.GetHeader() == nil || !IsGossipMessage(msg) {
+		return d.Dispatcher.Dispatch(msg, stream)
+	}
+
+	err := d.srv.gossip.Validate(msg)
+	switch {
+	case err == nil:
+		return d.Dispatcher.Dispatch(msg, stream)
+	case errors.Is(err, network.ErrGossipIgnore):
+		return nil
+	default:
+		// 按流的对端上报，消息头中的from未经认证
+		if s, ok := stream.(*StreamImpl); ok {
+			d.srv.ReportPeer(s.PeerID(), netBase.PeerEventInvalidMessage)
+		}
+		return err
+	}
+}
@@ -0,0 +1,176 @@
+package p2pv2
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/wooyang2018/corechain/logger"
+	mock "github.com/wooyang2018/corechain/mock/config"
+	"github.com/wooyang2018/corechain/network"
+	netBase "github.com/wooyang2018/corechain/network/base"
+	"github.com/wooyang2018/corechain/protos"
+)
+
+type testGossipNode struct {
+	host       host.Host
+	gossip     *Gossip
+	reputation *network.Reputation
+	ch         chan *protos.CoreMessage
+}
+
+func newTestGossipNode(t *testing.T) *testGossipNode {
+	ecfg, err := mock.GetMockEnvConf()
+	if err != nil {
+		t.Fatal(err)
+	}
+	logger.InitMLog(ecfg.GenConfFilePath(ecfg.LogConf), ecfg.GenDirAbsPath(ecfg.LogDir))
+	ctx, err := netBase.NewNetCtx(ecfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx.P2PConf.BanListPath = ""
+	ctx.P2PConf.StaticNodes = nil
+
+	h, err := libp2p.New(libp2p.ListenAddrStrings("/ip4/127.0.0.1/tcp/0"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	node := &testGossipNode{
+		host:       h,
+		reputation: network.NewReputation(ctx),
+		ch:         make(chan *protos.CoreMessage, 16),
+	}
+	dispatcher := network.NewDispatcher(ctx)
+	report := func(peerID string, event netBase.PeerEvent) {
+		node.reputation.Report(peerID, event)
+	}
+	node.gossip, err = NewGossip(ctx, h, dispatcher, node.reputation, report)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := dispatcher.Register(network.NewSubscriber(ctx, protos.CoreMessage_POSTTX, node.ch)); err != nil {
+		t.Fatal(err)
+	}
+	if err := node.gossip.Start(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		node.gossip.Stop()
+		h.Close()
+	})
+	return node
+}
+
+func recvGossip(ch chan *protos.CoreMessage, timeout time.Duration) *protos.CoreMessage {
+	select {
+	case msg := <-ch:
+		return msg
+	case <-time.After(timeout):
+		return nil
+	}
+}
+
+func TestGossip(t *testing.T) {
+	node1 := newTestGossipNode(t)
+	node2 := newTestGossipNode(t)
+	err := node1.host.Connect(context.Background(), peer.AddrInfo{ID: node2.host.ID(), Addrs: node2.host.Addrs()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 等待主题订阅在节点间同步并建立mesh
+	time.Sleep(2 * time.Second)
+
+	msg := network.NewMessage(protos.CoreMessage_POSTTX, &protos.Transaction{Txid: []byte("txid")},
+		network.WithBCName("corechain"))
+	msg.Header.From = "forged"
+	if err := node1.gossip.Publish(msg); err != nil {
+		t.Fatalf("publish error: %v", err)
+	}
+	got := recvGossip(node2.ch, 5*time.Second)
+	if got == nil {
+		t.Fatal("gossip message not received")
+	}
+	if got.GetHeader().GetFrom() != node1.host.ID().Pretty() {
+		t.Fatalf("from should be the publisher, got: %s", got.GetHeader().GetFrom())
+	}
+	if recvGossip(node1.ch, 500*time.Millisecond) != nil {
+		t.Fatal("local published message should not be dispatched")
+	}
+
+	// 同一内容使用新的logid再次发布，按内容去重
+	dup := network.NewMessage(protos.CoreMessage_POSTTX, &protos.Transaction{Txid: []byte("txid")},
+		network.WithBCName("corechain"))
+	if err := node1.gossip.Publish(dup); err != nil {
+		t.Fatalf("publish duplicate error: %v", err)
+	}
+	if recvGossip(node2.ch, time.Second) != nil {
+		t.Fatal("duplicate message should be dropped")
+	}
+
+	errBad := errors.New("bad tx")
+	node2.gossip.AddValidator(protos.CoreMessage_POSTTX, func(msg *protos.CoreMessage) error {
+		var tx protos.Transaction
+		if err := network.Unmarshal(msg, &tx); err != nil {
+			return err
+		}
+		if string(tx.Txid) == "bad" {
+			return errBad
+		}
+		return nil
+	})
+	bad := network.NewMessage(protos.CoreMessage_POSTTX, &protos.Transaction{Txid: []byte("bad")},
+		network.WithBCName("corechain"))
+	if err := node1.gossip.Publish(bad); err != nil {
+		t.Fatalf("publish error: %v", err)
+	}
+	if recvGossip(node2.ch, 2*time.Second) != nil {
+		t.Fatal("message rejected by validator should not be dispatched")
+	}
+	if node2.reputation.Score(node1.host.ID().Pretty()) >= node2.reputation.Score("unknown") {
+		t.Fatal("peer forwarding invalid message should be reported")
+	}
+}
+
+func TestGossipValidate(t *testing.T) {
+	node := newTestGossipNode(t)
+
+	msg := network.NewMessage(protos.CoreMessage_SENDBLOCK, &protos.InternalBlock{}, network.WithBCName("corechain"))
+	if err := node.gossip.Validate(msg); err == nil {
+		t.Fatal("expect empty blockid invalid")
+	}
+
+	block := &protos.InternalBlock{Blockid: []byte("blockid")}
+	msg = network.NewMessage(protos.CoreMessage_SENDBLOCK, block, network.WithBCName("corechain"))
+	msg.Header.DataCheckSum++
+	if err := node.gossip.Validate(msg); err != network.ErrMessageChecksum {
+		t.Fatalf("expect checksum invalid, got: %v", err)
+	}
+
+	if err := node.gossip.AddValidator(protos.CoreMessage_GET_BLOCK, validateBlock); err != ErrGossipType {
+		t.Fatalf("expect non gossip type rejected, got: %v", err)
+	}
+	// 本节点未加载的链只忽略，不影响发布者
+	node.gossip.AddValidator(protos.CoreMessage_POSTTX, func(msg *protos.CoreMessage) error {
+		return network.ErrGossipIgnore
+	})
+	msg = network.NewMessage(protos.CoreMessage_POSTTX, &protos.Transaction{Txid: []byte("txid")},
+		network.WithBCName("unknown"))
+	if err := node.gossip.Validate(msg); err != network.ErrGossipIgnore {
+		t.Fatalf("expect ignore, got: %v", err)
+	}
+}
+
+func TestGossipSubParams(t *testing.T) {
+	for _, degree := range []int{0, 1, 2, 3, 6, 10} {
+		params := gossipSubParams(degree)
+		if !(params.Dout < params.Dlo && params.Dlo <= params.D && params.D <= params.Dhi &&
+			params.Dout <= params.D/2 && params.Dscore <= params.D) {
+			t.Fatalf("invalid gossipsub params for degree %d: %+v", degree, params)
+		}
+	}
+}
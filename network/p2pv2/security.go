@@ -28,11 +28,12 @@ type Transport struct {
 
 	privKey   crypto.PrivKey
 	localPeer peer.ID
+	admission *Admission
 }
 
 var _ sec.SecureTransport = &Transport{}
 
-func NewTLS(path, serviceName string) func(key crypto.PrivKey) (*Transport, error) {
+func NewTLS(path, serviceName string, admission *Admission) func(key crypto.PrivKey) (*Transport, error) {
 	return func(key crypto.PrivKey) (*Transport, error) {
 		bs, err := ioutil.ReadFile(filepath.Join(path, "cacert.pem"))
 		if err != nil {
@@ -65,6 +66,7 @@ func NewTLS(path, serviceName string) func(key crypto.PrivKey) (*Transport, erro
 			},
 			privKey:   key,
 			localPeer: id,
+			admission: admission,
 		}, nil
 	}
 }
@@ -82,6 +84,11 @@ func (t *Transport) SecureInbound(ctx context.Context, insecure net.Conn, p peer
 		return nil, err
 	}
 
+	if err := t.admit(conn, remotePubKey); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
 	return t.setupConn(conn, remotePubKey)
 }
 
@@ -98,6 +105,11 @@ func (t *Transport) SecureOutbound(ctx context.Context, insecure net.Conn, p pee
 		return nil, err
 	}
 
+	if err := t.admit(conn, remotePubKey); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
 	return t.setupConn(conn, remotePubKey)
 }
 
@@ -115,6 +127,18 @@ func (t *Transport) getPeerPubKey(conn *tls.Conn) (crypto.PubKey, error) {
 	return crypto.UnmarshalRsaPublicKey(certKeyPub)
 }
 
+// admit 按准入策略检查对端证书
+func (t *Transport) admit(conn *tls.Conn, remotePubKey crypto.PubKey) error {
+	if !t.admission.Enabled() {
+		return nil
+	}
+	id, err := peer.IDFromPublicKey(remotePubKey)
+	if err != nil {
+		return err
+	}
+	return t.admission.Check(conn.ConnectionState().PeerCertificates[0], id)
+}
+
 func (t *Transport) setupConn(tlsConn *tls.Conn, remotePubKey crypto.PubKey) (sec.SecureConn, error) {
 	remotePeerID, err := peer.IDFromPublicKey(remotePubKey)
 	if err != nil {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"sync"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/libp2p/go-libp2p-core/protocol"
 	dht "github.com/libp2p/go-libp2p-kad-dht"
 	record "github.com/libp2p/go-libp2p-record"
+	"github.com/libp2p/go-libp2p/p2p/discovery/mdns"
 	tls "github.com/libp2p/go-libp2p/p2p/security/tls"
 	"github.com/multiformats/go-multiaddr"
 	"github.com/patrickmn/go-cache"
@@ -48,8 +50,12 @@ var (
 	ErrLoadAccount      = errors.New("load account error")
 	ErrStoreAccount     = errors.New("dht store account error")
 	ErrConnect          = errors.New("connect all boot and static peer error")
+	ErrQuicWithTls      = errors.New("quic transport not supported with tls")
+	ErrAdmissionNoTls   = errors.New("admission policies require tls")
 	ErrEmptyPeer        = errors.New("empty peer")
 	ErrNoResponse       = errors.New("no response")
+	ErrPeerBanned       = errors.New("peer banned")
+	ErrCreateGossip     = errors.New("create gossip error")
 )
 
 // P2PServerV2 is the node in the libnet
@@ -63,6 +69,10 @@ type P2PServerV2 struct {
 	kdht       *dht.IpfsDHT
 	streamPool *StreamPool
 	dispatcher netBase.Dispatcher
+	reputation *network.Reputation
+	gossip     *Gossip
+	admission  *Admission
+	mdns       mdns.Service
 
 	cancel      context.CancelFunc
 	staticNodes map[string][]peer.ID
@@ -74,6 +84,8 @@ type P2PServerV2 struct {
 }
 
 var _ netBase.Network = &P2PServerV2{}
+var _ netBase.AdmissionAware = &P2PServerV2{}
+var _ netBase.GossipAware = &P2PServerV2{}
 
 // NewP2PServerV2 create P2PServerV2 instance
 func NewP2PServerV2() netBase.Network {
@@ -88,7 +100,18 @@ func (p *P2PServerV2) Init(ctx *netBase.NetCtx) error {
 
 	// host
 	cfg := ctx.P2PConf
-	opts, err := genHostOption(ctx)
+	admission, err := NewAdmission(cfg)
+	if err != nil {
+		p.log.Error("create admission error", "error", err)
+		return err
+	}
+	p.admission = admission
+	// 准入检查在tls握手中进行，未开启tls时拒绝启动，避免误以为已开启准入
+	if p.admission.Enabled() && !cfg.IsTls {
+		p.log.Error("admission policies require tls", "policies", cfg.Admission.Policies)
+		return ErrAdmissionNoTls
+	}
+	opts, err := genHostOption(ctx, p.admission)
 	if err != nil {
 		p.log.Error("genHostOption error", "error", err)
 		return ErrGenerateOpts
@@ -102,6 +125,14 @@ func (p *P2PServerV2) Init(ctx *netBase.NetCtx) error {
 
 	p.id = ho.ID()
 	p.host = ho
+	// 断开连接的节点不再需要按节点登记表重新检查
+	ho.Network().Notify(&libnet.NotifyBundle{
+		DisconnectedF: func(n libnet.Network, conn libnet.Conn) {
+			if n.Connectedness(conn.RemotePeer()) != libnet.Connected {
+				p.admission.Forget(conn.RemotePeer())
+			}
+		},
+	})
 	p.log.Debug("Host", "address", p.getMultiAddr(p.host.ID(), p.host.Addrs()), "config", *cfg)
 	prefix := fmt.Sprintf("/%s", netBase.Namespace)
 	// dht
@@ -132,8 +163,17 @@ func (p *P2PServerV2) Init(ctx *netBase.NetCtx) error {
 	p.accounts = cache.New(cache.NoExpiration, cache.NoExpiration)
 	// dispatcher
 	p.dispatcher = network.NewDispatcher(ctx)
+	p.reputation = network.NewReputation(ctx)
+	if cfg.EnableGossip {
+		p.gossip, err = NewGossip(ctx, p.host, p.dispatcher, p.reputation, p.ReportPeer)
+		if err != nil {
+			p.log.Error("create gossip error", "error", err)
+			return ErrCreateGossip
+		}
+		p.dispatcher = &gossipDispatcher{Dispatcher: p.dispatcher, srv: p}
+	}
 
-	p.streamPool, err = NewStreamPool(ctx, p.host, p.dispatcher)
+	p.streamPool, err = NewStreamPool(ctx, p.host, p.dispatcher, p.reputation)
 	if err != nil {
 		return ErrCreateStreamPool
 	}
@@ -152,18 +192,32 @@ func (p *P2PServerV2) Init(ctx *netBase.NetCtx) error {
 	return nil
 }
 
-func genHostOption(ctx *netBase.NetCtx) ([]libp2p.Option, error) {
+func genHostOption(ctx *netBase.NetCtx, admission *Admission) ([]libp2p.Option, error) {
 	cfg := ctx.P2PConf
 	muAddr, err := multiaddr.NewMultiaddr(cfg.Address)
 	if err != nil {
 		return nil, err
 	}
 
+	listenAddrs := []multiaddr.Multiaddr{muAddr}
 	opts := []libp2p.Option{
-		libp2p.ListenAddrs(muAddr),
 		libp2p.EnableRelay(),
 	}
 
+	// 默认传输已包含tcp、quic和websocket，这里只增加quic监听地址
+	// quic自带libp2p tls握手，不经过自定义证书和准入检查，因此不能与isTls同时开启
+	if cfg.QuicAddress != "" {
+		if cfg.IsTls {
+			return nil, ErrQuicWithTls
+		}
+		quicAddr, err := multiaddr.NewMultiaddr(cfg.QuicAddress)
+		if err != nil {
+			return nil, err
+		}
+		listenAddrs = append(listenAddrs, quicAddr)
+	}
+	opts = append(opts, libp2p.ListenAddrs(listenAddrs...))
+
 	if cfg.IsNat {
 		opts = append(opts, libp2p.NATPortMap())
 	}
@@ -174,7 +228,7 @@ func genHostOption(ctx *netBase.NetCtx) ([]libp2p.Option, error) {
 			return nil, err
 		}
 		opts = append(opts, libp2p.Identity(priv))
-		opts = append(opts, libp2p.Security(ID, NewTLS(cfg.KeyPath, cfg.ServiceName)))
+		opts = append(opts, libp2p.Security(ID, NewTLS(cfg.KeyPath, cfg.ServiceName, admission)))
 	} else {
 		priv, err := GetKeyPairFromPath(cfg.KeyPath)
 		if err != nil {
@@ -225,13 +279,32 @@ func (p *P2PServerV2) setKdhtValue() {
 // Start start the node
 func (p *P2PServerV2) Start() {
 	p.log.Debug("StartP2PServer", "address", p.host.Addrs())
-	p.host.SetStreamHandler(netBase.ProtocolVersion, p.streamHandler)
+	for _, id := range supportedProtocols(p.config) {
+		p.host.SetStreamHandler(id, p.streamHandler)
+	}
 
 	p.setKdhtValue()
 
+	if p.gossip != nil {
+		if err := p.gossip.Start(); err != nil {
+			p.log.Warn("p2p: start gossip error", "error", err)
+		}
+	}
+
 	ctx, cancel := context.WithCancel(p.ctx)
 	p.cancel = cancel
 
+	if p.config.EnableMdns {
+		p.mdns = mdns.NewMdnsService(p.host, mdns.ServiceName, &mdnsNotifee{srv: p})
+		if err := p.mdns.Start(); err != nil {
+			p.log.Warn("p2p: start mdns error", "error", err)
+		}
+	}
+
+	if p.admission.RegistryEnabled() {
+		go p.recheckAdmission(ctx)
+	}
+
 	t := time.NewTicker(time.Second * 180)
 	go func() {
 		defer t.Stop()
@@ -255,8 +328,11 @@ func (p *P2PServerV2) connect() error {
 	for _, ps := range p.config.StaticNodes {
 		multiAddrs = append(multiAddrs, ps...)
 	}
+	// DNS种子与BootNodes同等对待
+	seeds := resolveSeeds(p.ctx, net.DefaultResolver, p.config.DnsSeeds, p.log)
+	multiAddrs = append(multiAddrs, seeds...)
 	success := p.connectPeerByAddress(multiAddrs)
-	if success == 0 && len(p.config.BootNodes) != 0 {
+	if success == 0 && len(p.config.BootNodes)+len(seeds) != 0 {
 		return ErrConnectBootStrap
 	}
 
@@ -272,11 +348,18 @@ func (p *P2PServerV2) streamHandler(netStream libnet.Stream) {
 // Stop stop the node
 func (p *P2PServerV2) Stop() {
 	p.log.Info("StopP2PServer")
+	if p.gossip != nil {
+		p.gossip.Stop()
+	}
+	if p.mdns != nil {
+		p.mdns.Close()
+	}
 	p.kdht.Close()
 	p.host.Close()
 	if p.cancel != nil {
 		p.cancel()
 	}
+	p.dispatcher.Stop()
 }
 
 // PeerID return the peer ID
@@ -326,6 +409,50 @@ func (p *P2PServerV2) HandleMessage(stream netBase.Stream, msg *protos.CoreMessa
 	return nil
 }
 
+// ReportPeer 调整对端信誉分，节点被封禁时断开连接并移出路由表
+func (p *P2PServerV2) ReportPeer(peerID string, event netBase.PeerEvent) {
+	if !p.reputation.Report(peerID, event) {
+		return
+	}
+
+	id, err := peer.Decode(peerID)
+	if err != nil {
+		p.log.Warn("p2p: ReportPeer parse peer ID failed", "pid", peerID, "error", err)
+		return
+	}
+	p.streamPool.DelPeer(id)
+	p.kdht.RoutingTable().RemovePeer(id)
+	p.host.Network().ClosePeer(id)
+}
+
+// SetNodeRegistry 节点登记表就绪后，断开临时准入但未登记的节点
+func (p *P2PServerV2) SetNodeRegistry(registry netBase.NodeRegistry) {
+	p.rejectPeers(p.admission.SetNodeRegistry(registry))
+}
+
+// recheckAdmission 节点登记变更后，定期断开不再满足准入条件的已连接节点
+func (p *P2PServerV2) recheckAdmission(ctx context.Context) {
+	t := time.NewTicker(AdmissionRecheckInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			p.rejectPeers(p.admission.Recheck())
+		}
+	}
+}
+
+func (p *P2PServerV2) rejectPeers(ids []peer.ID) {
+	for _, id := range ids {
+		p.log.Warn("p2p: peer rejected by node registry", "pid", id)
+		p.streamPool.DelPeer(id)
+		p.kdht.RoutingTable().RemovePeer(id)
+		p.host.Network().ClosePeer(id)
+	}
+}
+
 func (p *P2PServerV2) Context() *netBase.NetCtx {
 	return p.ctx
 }
@@ -454,6 +581,12 @@ func (p *P2PServerV2) SendMessage(ctx xctx.Context, msg *protos.CoreMessage,
 	}()
 
 	opt := netBase.Apply(optFunc)
+	if p.gossip != nil && IsGossipMessage(msg) && isBroadcast(opt) {
+		err := p.gossip.Publish(msg)
+		ctx.GetTimer().Mark("publish")
+		return err
+	}
+
 	filter := p.getFilter(msg, opt)
 	peers, _ := filter.Filter()
 	ctx.GetTimer().Mark("filter")
@@ -592,6 +725,9 @@ func (p *P2PServerV2) sendMessageWithResponse(ctx xctx.Context, msg *protos.Core
 			if err != nil {
 				p.log.Warn("p2p: SendMessageWithResponse error", "log_id", msg.GetHeader().GetLogid(),
 					"msgType", msg.GetHeader().GetType(), "error", err)
+				if errors.Is(err, context.DeadlineExceeded) {
+					p.ReportPeer(peerID.Pretty(), netBase.PeerEventTimeout)
+				}
 				return
 			}
 
@@ -614,6 +750,8 @@ func (p *P2PServerV2) sendMessageWithResponse(ctx xctx.Context, msg *protos.Core
 	for resp := range respCh {
 		if network.VerifyChecksum(resp) {
 			response = append(response, resp)
+		} else {
+			p.ReportPeer(resp.GetHeader().GetFrom(), netBase.PeerEventInvalidMessage)
 		}
 
 		i++
@@ -634,7 +772,7 @@ func (p *P2PServerV2) getFilter(msg *protos.CoreMessage, opt *netBase.Option) Pe
 
 	bcname := msg.GetHeader().GetBcname()
 	if len(p.getStaticNodes(bcname)) != 0 {
-		return &StaticNodeStrategy{srv: p, bcname: bcname}
+		return &ReputationFilter{srv: p, filter: &StaticNodeStrategy{srv: p, bcname: bcname}}
 	}
 
 	peerFilters := make([]PeerFilter, 0)
@@ -685,7 +823,7 @@ func (p *P2PServerV2) getFilter(msg *protos.CoreMessage, opt *netBase.Option) Pe
 			peerIDs = append(peerIDs, peerID)
 		}
 	}
-	return NewMultiStrategy(peerFilters, peerIDs)
+	return &ReputationFilter{srv: p, filter: NewMultiStrategy(peerFilters, peerIDs)}
 }
 
 func (p *P2PServerV2) GetPeerIdByAccount(account string) (peer.ID, error) {
@@ -708,6 +846,20 @@ func (p *P2PServerV2) GetPeerIdByAccount(account string) (peer.ID, error) {
 	return peerID, nil
 }
 
+// AddGossipValidator add validator called before the gossip message is dispatched and forwarded
+func (p *P2PServerV2) AddGossipValidator(typ protos.CoreMessage_MessageType, validator netBase.GossipValidator) error {
+	if p.gossip == nil {
+		return network.ErrGossipDisabled
+	}
+	return p.gossip.AddValidator(typ, validator)
+}
+
+// isBroadcast 未指定目标节点的消息视为广播
+func isBroadcast(opt *netBase.Option) bool {
+	return len(opt.Addresses) <= 0 && len(opt.PeerIDs) <= 0 &&
+		len(opt.Accounts) <= 0 && len(opt.WhiteList) <= 0
+}
+
 // GetStaticNodes get StaticNode a chain
 func (p *P2PServerV2) getStaticNodes(bcname string) []peer.ID {
 	return p.staticNodes[bcname]
@@ -45,6 +45,7 @@ type StreamImpl struct {
 	rc         ReadCloser
 	valid      bool
 	grpcPort   string
+	codec      string
 }
 
 // NewStream create Stream instance
@@ -52,6 +53,20 @@ func NewStream(ctx *netBase.NetCtx, netStream libnet.Stream, dispatcher netBase.
 	w := bufio.NewWriter(netStream)
 	wc := NewDelimitedWriter(w)
 	maxMsgSize := int(ctx.P2PConf.MaxMessageSize) << 20
+	rc := NewDelimitedReader(netStream, maxMsgSize)
+	// 协商了压缩算法的流使用分块传输
+	codec := codecOfProtocol(netStream.Protocol())
+	if codec != "" {
+		if size := int(ctx.P2PConf.MaxChunkedMessageSize) << 20; size > maxMsgSize {
+			maxMsgSize = size
+		}
+		c, err := newCompressor(codec, maxMsgSize)
+		if err != nil {
+			return nil, err
+		}
+		wc = NewChunkWriter(w, c)
+		rc = NewChunkReader(netStream, c)
+	}
 	stream := &StreamImpl{
 		ctx:        ctx,
 		config:     ctx.P2PConf,
@@ -62,10 +77,11 @@ func NewStream(ctx *netBase.NetCtx, netStream libnet.Stream, dispatcher netBase.
 		streamMu:   new(sync.Mutex),
 		id:         netStream.Conn().RemotePeer(),
 		addr:       netStream.Conn().RemoteMultiaddr(),
-		rc:         NewDelimitedReader(netStream, maxMsgSize),
+		rc:         rc,
 		w:          w,
 		wc:         wc,
 		valid:      true,
+		codec:      codec,
 	}
 	stream.Start()
 	return stream, nil
@@ -143,6 +159,10 @@ func (s *StreamImpl) LoopRecv() {
 			s.Close()
 			return
 		}
+		// 以连接认证的对端ID为准，避免伪造From使其他节点被扣分
+		if msg.Header != nil {
+			msg.Header.From = s.PeerID()
+		}
 		err = s.HandleMessage(msg)
 		if err != nil {
 			s.Close()
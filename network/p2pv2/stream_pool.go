@@ -7,11 +7,13 @@ import (
 	"github.com/libp2p/go-libp2p-core/host"
 	libnet "github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/protocol"
 	dht "github.com/libp2p/go-libp2p-kad-dht"
 	"github.com/libp2p/go-libp2p/p2p/net/swarm"
 	"github.com/wooyang2018/corechain/common/cache"
 	xctx "github.com/wooyang2018/corechain/common/context"
 	"github.com/wooyang2018/corechain/logger"
+	"github.com/wooyang2018/corechain/network"
 	netBase "github.com/wooyang2018/corechain/network/base"
 )
 
@@ -28,13 +30,16 @@ type StreamPool struct {
 	host           host.Host
 	kdht           *dht.IpfsDHT
 	limit          *StreamLimit
+	reputation     *network.Reputation
 	mutex          sync.Mutex
 	streams        *cache.LRUCache // key: peer id, value: Stream
 	maxStreamLimit int32
+	protocols      []protocol.ID
 }
 
 // NewStreamPool create StreamPool instance
-func NewStreamPool(ctx *netBase.NetCtx, ho host.Host, dispatcher netBase.Dispatcher) (*StreamPool, error) {
+func NewStreamPool(ctx *netBase.NetCtx, ho host.Host, dispatcher netBase.Dispatcher,
+	reputation *network.Reputation) (*StreamPool, error) {
 	cfg := ctx.P2PConf
 	limit := &StreamLimit{}
 	limit.Init(ctx)
@@ -42,11 +47,13 @@ func NewStreamPool(ctx *netBase.NetCtx, ho host.Host, dispatcher netBase.Dispatc
 		ctx:            ctx,
 		log:            ctx.GetLog(),
 		limit:          limit,
+		reputation:     reputation,
 		host:           ho,
 		dispatcher:     dispatcher,
 		mutex:          sync.Mutex{},
 		streams:        cache.NewLRUCache(int(cfg.MaxStreamLimits)),
 		maxStreamLimit: cfg.MaxStreamLimits,
+		protocols:      supportedProtocols(cfg),
 	}, nil
 }
 
@@ -77,7 +84,7 @@ func (sp *StreamPool) Get(ctx xctx.Context, peerId peer.ID) (*StreamImpl, error)
 		}
 	}
 
-	netStream, err := sp.host.NewStream(sp.ctx, peerId, netBase.ProtocolVersion)
+	netStream, err := sp.host.NewStream(sp.ctx, peerId, sp.protocols...)
 	if err != nil {
 		if errors.Is(err, swarm.ErrDialToSelf) {
 			ctx.GetLog().Info("new net stream error", "peerId", peerId, "error", err)
@@ -92,6 +99,13 @@ func (sp *StreamPool) Get(ctx xctx.Context, peerId peer.ID) (*StreamImpl, error)
 
 // Add used to add a new net stream into pool
 func (sp *StreamPool) NewStream(ctx xctx.Context, netStream libnet.Stream) (*StreamImpl, error) {
+	remotePeer := netStream.Conn().RemotePeer()
+	if sp.reputation != nil && sp.reputation.IsBanned(remotePeer.Pretty()) {
+		netStream.Reset()
+		ctx.GetLog().Debug("refuse stream of banned peer", "peerID", remotePeer)
+		return nil, ErrPeerBanned
+	}
+
 	stream, err := NewStream(sp.ctx, netStream, sp.dispatcher, sp.host)
 	if err != nil {
 		return nil, err
@@ -127,6 +141,15 @@ func (sp *StreamPool) AddStream(ctx xctx.Context, stream *StreamImpl) error {
 	return nil
 }
 
+// DelPeer delete the stream of peer if exists
+func (sp *StreamPool) DelPeer(peerID peer.ID) {
+	if v, ok := sp.streams.Get(peerID.Pretty()); ok {
+		if stream, ok := v.(*StreamImpl); ok {
+			sp.DelStream(stream)
+		}
+	}
+}
+
 // DelStream delete a stream
 func (sp *StreamPool) DelStream(stream *StreamImpl) error {
 	stream.Close()
@@ -0,0 +1,77 @@
+package p2pv2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/wooyang2018/corechain/logger"
+	mock "github.com/wooyang2018/corechain/mock/config"
+	mockNet "github.com/wooyang2018/corechain/mock/testnet"
+	netBase "github.com/wooyang2018/corechain/network/base"
+)
+
+func newQuicHost(t *testing.T, node string) host.Host {
+	ecfg, err := mockNet.GetMockEnvConf(node + "/conf/env.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecfg.NetConf = NetConf
+	ctx, err := netBase.NewNetCtx(ecfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := ctx.P2PConf
+	cfg.Address = "/ip4/127.0.0.1/tcp/0"
+	cfg.QuicAddress = "/ip4/127.0.0.1/udp/0/quic"
+	if _, err := genHostOption(ctx, nil); err != ErrQuicWithTls {
+		t.Fatalf("expect %v, got %v", ErrQuicWithTls, err)
+	}
+
+	cfg.IsTls = false
+	opts, err := genHostOption(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h, err := libp2p.New(opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return h
+}
+
+func TestQuicTransport(t *testing.T) {
+	econf, _ := mock.GetMockEnvConf()
+	logger.InitMLog(econf.GenConfFilePath(econf.LogConf), econf.GenDirAbsPath(econf.LogDir))
+
+	h1 := newQuicHost(t, "node1")
+	defer h1.Close()
+	h2 := newQuicHost(t, "node2")
+	defer h2.Close()
+
+	// 只使用quic地址连接
+	var quicAddrs []multiaddr.Multiaddr
+	for _, addr := range h1.Addrs() {
+		if _, err := addr.ValueForProtocol(multiaddr.P_QUIC); err == nil {
+			quicAddrs = append(quicAddrs, addr)
+		}
+	}
+	if len(quicAddrs) == 0 {
+		t.Fatalf("no quic listen addr: %v", h1.Addrs())
+	}
+	if err := h2.Connect(context.Background(), peer.AddrInfo{ID: h1.ID(), Addrs: quicAddrs}); err != nil {
+		t.Fatal(err)
+	}
+
+	conns := h2.Network().ConnsToPeer(h1.ID())
+	if len(conns) == 0 {
+		t.Fatal("no connection to peer")
+	}
+	if _, err := conns[0].RemoteMultiaddr().ValueForProtocol(multiaddr.P_QUIC); err != nil {
+		t.Errorf("expect quic conn, got %s", conns[0].RemoteMultiaddr())
+	}
+}
@@ -0,0 +1,124 @@
+package network
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+	netBase "github.com/wooyang2018/corechain/network/base"
+	"github.com/wooyang2018/corechain/protos"
+)
+
+const (
+	// 节点令牌桶长时间未使用后清理，避免节点数量增长导致内存增长
+	peerBucketTTL = 10 * time.Minute
+)
+
+// tokenBucket 令牌桶，按rate匀速补充令牌，最多积累burst个
+type tokenBucket struct {
+	mutex  sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, burst int, now time.Time) *tokenBucket {
+	if burst <= 0 {
+		burst = int(rate)
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucket{rate: rate, burst: float64(burst), tokens: float64(burst), last: now}
+}
+
+func (b *tokenBucket) allow(now time.Time) bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens += elapsed * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+		b.last = now
+	}
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// RateLimiter 按消息类型限流，同时限制全部节点的总速率和单个节点的速率
+type RateLimiter struct {
+	limits map[protos.CoreMessage_MessageType]netBase.RateLimitConf
+	mutex  sync.Mutex
+	global map[protos.CoreMessage_MessageType]*tokenBucket
+	peers  *cache.Cache // key: peerID/type, value: *tokenBucket
+	now    func() time.Time
+}
+
+// NewRateLimiter create RateLimiter instance, unknown message types in config are ignored
+func NewRateLimiter(limits map[string]netBase.RateLimitConf) *RateLimiter {
+	r := &RateLimiter{
+		limits: make(map[protos.CoreMessage_MessageType]netBase.RateLimitConf),
+		global: make(map[protos.CoreMessage_MessageType]*tokenBucket),
+		peers:  cache.New(peerBucketTTL, peerBucketTTL),
+		now:    time.Now,
+	}
+	// 配置文件中的key会被转为小写
+	for name, limit := range limits {
+		typ, ok := protos.CoreMessage_MessageType_value[strings.ToUpper(name)]
+		if !ok {
+			continue
+		}
+		r.limits[protos.CoreMessage_MessageType(typ)] = limit
+	}
+	return r
+}
+
+// Allow 消耗一个令牌，令牌不足时返回false
+func (r *RateLimiter) Allow(msg *protos.CoreMessage) bool {
+	typ := msg.GetHeader().GetType()
+	limit, ok := r.limits[typ]
+	if !ok {
+		return true
+	}
+
+	now := r.now()
+	if limit.PeerRate > 0 && !r.peerBucket(msg.GetHeader().GetFrom(), typ, limit, now).allow(now) {
+		return false
+	}
+	if limit.Rate > 0 && !r.globalBucket(typ, limit, now).allow(now) {
+		return false
+	}
+	return true
+}
+
+func (r *RateLimiter) globalBucket(typ protos.CoreMessage_MessageType, limit netBase.RateLimitConf,
+	now time.Time) *tokenBucket {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	bucket, ok := r.global[typ]
+	if !ok {
+		bucket = newTokenBucket(limit.Rate, limit.Burst, now)
+		r.global[typ] = bucket
+	}
+	return bucket
+}
+
+func (r *RateLimiter) peerBucket(peerID string, typ protos.CoreMessage_MessageType,
+	limit netBase.RateLimitConf, now time.Time) *tokenBucket {
+	key := peerID + "/" + typ.String()
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if v, ok := r.peers.Get(key); ok {
+		r.peers.SetDefault(key, v)
+		return v.(*tokenBucket)
+	}
+	bucket := newTokenBucket(limit.PeerRate, limit.PeerBurst, now)
+	r.peers.SetDefault(key, bucket)
+	return bucket
+}
@@ -0,0 +1,104 @@
+package network
+
+import (
+	"testing"
+	"time"
+
+	xctx "github.com/wooyang2018/corechain/common/context"
+	"github.com/wooyang2018/corechain/logger"
+	mock "github.com/wooyang2018/corechain/mock/config"
+	netBase "github.com/wooyang2018/corechain/network/base"
+	"github.com/wooyang2018/corechain/protos"
+)
+
+func TestRateLimiter(t *testing.T) {
+	now := time.Unix(1000000, 0)
+	r := NewRateLimiter(map[string]netBase.RateLimitConf{
+		"posttx":  {Rate: 10, Burst: 3, PeerRate: 1, PeerBurst: 2},
+		"UNKNOWN": {Rate: 1},
+	})
+	r.now = func() time.Time { return now }
+
+	newMsg := func(from string) *protos.CoreMessage {
+		msg := NewMessage(protos.CoreMessage_POSTTX, nil)
+		msg.Header.From = from
+		return msg
+	}
+
+	// 单节点超过突发上限
+	if !r.Allow(newMsg("peerA")) || !r.Allow(newMsg("peerA")) || r.Allow(newMsg("peerA")) {
+		t.Fatal("peer limit not applied")
+	}
+	// 总速率超过突发上限
+	if !r.Allow(newMsg("peerB")) || r.Allow(newMsg("peerC")) {
+		t.Fatal("global limit not applied")
+	}
+	// 未配置的消息类型不限流
+	if !r.Allow(NewMessage(protos.CoreMessage_SENDBLOCK, nil)) {
+		t.Fatal("unlimited type limited")
+	}
+
+	now = now.Add(time.Second)
+	if !r.Allow(newMsg("peerA")) || r.Allow(newMsg("peerA")) {
+		t.Fatal("peer bucket not refilled by rate")
+	}
+}
+
+func TestDispatcherPriority(t *testing.T) {
+	ecfg, err := mock.GetMockEnvConf()
+	if err != nil {
+		t.Fatal(err)
+	}
+	logger.InitMLog(ecfg.GenConfFilePath(ecfg.LogConf), ecfg.GenDirAbsPath(ecfg.LogDir))
+	netCtx, _ := netBase.NewNetCtx(ecfg)
+	netCtx.P2PConf.DispatchWorkers = 1
+	netCtx.P2PConf.RateLimits = nil
+	dispatcher := NewDispatcher(netCtx)
+
+	block := make(chan struct{})
+	started := make(chan struct{})
+	handled := make(chan protos.CoreMessage_MessageType, 3)
+	handle := func(ctx xctx.Context, msg *protos.CoreMessage) (*protos.CoreMessage, error) {
+		if msg.Header.Type == protos.CoreMessage_GET_BLOCK {
+			close(started)
+			<-block
+		}
+		handled <- msg.Header.Type
+		return msg, nil
+	}
+	types := []protos.CoreMessage_MessageType{
+		protos.CoreMessage_GET_BLOCK,
+		protos.CoreMessage_POSTTX,
+		protos.CoreMessage_CHAINED_BFT_VOTE_MSG,
+	}
+	for _, typ := range types {
+		if err := dispatcher.Register(NewSubscriber(netCtx, typ, HandleFunc(handle))); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// 唯一的工作协程被阻塞后，交易消息先入队，共识消息后入队
+	go dispatcher.Dispatch(NewMessage(protos.CoreMessage_GET_BLOCK, nil), &mockStream{})
+	<-started
+	go dispatcher.Dispatch(NewMessage(protos.CoreMessage_POSTTX, nil), &mockStream{})
+	time.Sleep(50 * time.Millisecond)
+	go dispatcher.Dispatch(NewMessage(protos.CoreMessage_CHAINED_BFT_VOTE_MSG, nil), &mockStream{})
+	time.Sleep(50 * time.Millisecond)
+	close(block)
+
+	expect := []protos.CoreMessage_MessageType{
+		protos.CoreMessage_GET_BLOCK,
+		protos.CoreMessage_CHAINED_BFT_VOTE_MSG,
+		protos.CoreMessage_POSTTX,
+	}
+	for _, typ := range expect {
+		select {
+		case got := <-handled:
+			if got != typ {
+				t.Fatalf("handle order error, expect:%s, got:%s", typ, got)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("handle message timeout")
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package network
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"math"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/wooyang2018/corechain/logger"
+	netBase "github.com/wooyang2018/corechain/network/base"
+)
+
+const (
+	// 信誉分上限，避免节点长期积累分数后作恶不被封禁
+	maxPeerScore = 100
+)
+
+type peerScore struct {
+	score   float64
+	updated time.Time
+}
+
+// Reputation 节点信誉分，依据消息处理结果增减，并按半衰期衰减回0
+// 分数过低的节点在广播和同步时被跳过，低于封禁分数的节点被封禁一段时间，封禁列表持久化到文件
+type Reputation struct {
+	log         logger.Logger
+	mutex       sync.Mutex
+	scores      map[string]*peerScore
+	banned      map[string]int64 // key: peerID, value: 解封时间(unix秒)
+	banListPath string
+	lowScore    float64
+	banScore    float64
+	banTime     time.Duration
+	halfLife    time.Duration
+	now         func() time.Time
+}
+
+// NewReputation create Reputation instance and load the persisted ban list
+func NewReputation(ctx *netBase.NetCtx) *Reputation {
+	cfg := ctx.P2PConf
+	r := &Reputation{
+		log:         ctx.GetLog(),
+		scores:      make(map[string]*peerScore),
+		banned:      make(map[string]int64),
+		banListPath: cfg.BanListPath,
+		lowScore:    float64(cfg.PeerLowScore),
+		banScore:    float64(cfg.PeerBanScore),
+		banTime:     time.Duration(cfg.PeerBanTime) * time.Second,
+		halfLife:    time.Duration(cfg.PeerScoreHalfLife) * time.Second,
+		now:         time.Now,
+	}
+	if r.banScore >= 0 {
+		r.banScore = netBase.DefaultPeerBanScore
+	}
+	if r.lowScore >= 0 || r.lowScore < r.banScore {
+		r.lowScore = r.banScore
+	}
+	if r.banTime <= 0 {
+		r.banTime = netBase.DefaultPeerBanTime * time.Second
+	}
+	if r.halfLife <= 0 {
+		r.halfLife = netBase.DefaultPeerScoreHalfLife * time.Second
+	}
+	r.load()
+	return r
+}
+
+// Report 记录事件并返回节点是否因此被封禁
+func (r *Reputation) Report(peerID string, event netBase.PeerEvent) bool {
+	if peerID == "" {
+		return false
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.isBanned(peerID) {
+		return false
+	}
+
+	score := r.score(peerID) + netBase.PeerEventScore[event]
+	if score > maxPeerScore {
+		score = maxPeerScore
+	}
+	r.scores[peerID] = &peerScore{score: score, updated: r.now()}
+	if score > r.banScore {
+		return false
+	}
+
+	r.banned[peerID] = r.now().Add(r.banTime).Unix()
+	delete(r.scores, peerID)
+	r.save()
+	r.log.Warn("reputation: peer banned", "peerID", peerID, "event", event.String(), "score", score)
+	return true
+}
+
+// Score return the decayed score of peer
+func (r *Reputation) Score(peerID string) float64 {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.score(peerID)
+}
+
+// IsBanned check whether the peer is banned
+func (r *Reputation) IsBanned(peerID string) bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.isBanned(peerID)
+}
+
+// IsLowScore check whether the peer should be skipped for broadcasts and sync requests
+func (r *Reputation) IsLowScore(peerID string) bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.isBanned(peerID) || r.score(peerID) <= r.lowScore
+}
+
+// BanList return the banned peers and the unix time they are unbanned
+func (r *Reputation) BanList() map[string]int64 {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	banList := make(map[string]int64, len(r.banned))
+	for peerID, expire := range r.banned {
+		if expire > r.now().Unix() {
+			banList[peerID] = expire
+		}
+	}
+	return banList
+}
+
+func (r *Reputation) score(peerID string) float64 {
+	ps, ok := r.scores[peerID]
+	if !ok {
+		return 0
+	}
+	elapsed := r.now().Sub(ps.updated)
+	if elapsed <= 0 {
+		return ps.score
+	}
+	return ps.score * math.Pow(0.5, float64(elapsed)/float64(r.halfLife))
+}
+
+func (r *Reputation) isBanned(peerID string) bool {
+	expire, ok := r.banned[peerID]
+	if !ok {
+		return false
+	}
+	if expire > r.now().Unix() {
+		return true
+	}
+	delete(r.banned, peerID)
+	r.save()
+	return false
+}
+
+func (r *Reputation) load() {
+	if r.banListPath == "" {
+		return
+	}
+	data, err := ioutil.ReadFile(r.banListPath)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			r.log.Warn("reputation: read ban list error", "path", r.banListPath, "error", err)
+		}
+		return
+	}
+
+	banned := make(map[string]int64)
+	if err := json.Unmarshal(data, &banned); err != nil {
+		r.log.Warn("reputation: unmarshal ban list error", "path", r.banListPath, "error", err)
+		return
+	}
+	now := r.now().Unix()
+	for peerID, expire := range banned {
+		if expire > now {
+			r.banned[peerID] = expire
+		}
+	}
+}
+
+// save 先写临时文件再重命名，避免进程退出时文件写坏
+func (r *Reputation) save() {
+	if r.banListPath == "" {
+		return
+	}
+	data, err := json.Marshal(r.banned)
+	if err != nil {
+		r.log.Warn("reputation: marshal ban list error", "error", err)
+		return
+	}
+	tmpPath := r.banListPath + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, data, 0644); err != nil {
+		r.log.Warn("reputation: write ban list error", "path", tmpPath, "error", err)
+		return
+	}
+	if err := os.Rename(tmpPath, r.banListPath); err != nil {
+		r.log.Warn("reputation: rename ban list error", "path", r.banListPath, "error", err)
+	}
+}
@@ -0,0 +1,90 @@
+package network
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/wooyang2018/corechain/logger"
+	mock "github.com/wooyang2018/corechain/mock/config"
+	netBase "github.com/wooyang2018/corechain/network/base"
+)
+
+func newTestReputation(t *testing.T, banListPath string, now *time.Time) *Reputation {
+	ecfg, err := mock.GetMockEnvConf()
+	if err != nil {
+		t.Fatal(err)
+	}
+	logger.InitMLog(ecfg.GenConfFilePath(ecfg.LogConf), ecfg.GenDirAbsPath(ecfg.LogDir))
+	netCtx, err := netBase.NewNetCtx(ecfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	netCtx.P2PConf.BanListPath = banListPath
+
+	r := NewReputation(netCtx)
+	r.now = func() time.Time { return *now }
+	// 重新加载，使过期判断使用测试时间
+	r.banned = make(map[string]int64)
+	r.load()
+	return r
+}
+
+func TestReputationDecay(t *testing.T) {
+	now := time.Unix(1000000, 0)
+	r := newTestReputation(t, "", &now)
+
+	for i := 0; i < 5; i++ {
+		r.Report("peerA", netBase.PeerEventInvalidMessage)
+	}
+	if !r.IsLowScore("peerA") || r.IsBanned("peerA") {
+		t.Fatalf("peerA should be low score but not banned, score=%f", r.Score("peerA"))
+	}
+
+	now = now.Add(time.Duration(netBase.DefaultPeerScoreHalfLife) * time.Second)
+	if score := r.Score("peerA"); score != -25 {
+		t.Fatalf("score should decay by half, got %f", score)
+	}
+	if r.IsLowScore("peerA") {
+		t.Fatal("peerA should recover after decay")
+	}
+
+	for i := 0; i < 200; i++ {
+		r.Report("peerB", netBase.PeerEventUsefulResponse)
+	}
+	if r.Score("peerB") != maxPeerScore {
+		t.Fatalf("score should be capped, got %f", r.Score("peerB"))
+	}
+}
+
+func TestReputationBan(t *testing.T) {
+	now := time.Unix(1000000, 0)
+	banListPath := filepath.Join(t.TempDir(), netBase.DefaultBanListPath)
+	r := newTestReputation(t, banListPath, &now)
+
+	banned := false
+	for i := 0; i < 4; i++ {
+		banned = r.Report("peerA", netBase.PeerEventInvalidBlock)
+	}
+	if !banned || !r.IsBanned("peerA") {
+		t.Fatal("peerA should be banned")
+	}
+	if r.Report("peerA", netBase.PeerEventInvalidBlock) {
+		t.Fatal("banned peer should not be banned twice")
+	}
+
+	// 封禁列表重启后仍然有效
+	r = newTestReputation(t, banListPath, &now)
+	if !r.IsBanned("peerA") || len(r.BanList()) != 1 {
+		t.Fatal("ban list should be persisted")
+	}
+
+	now = now.Add(time.Duration(netBase.DefaultPeerBanTime) * time.Second)
+	if r.IsBanned("peerA") || r.Score("peerA") != 0 {
+		t.Fatal("peerA should be unbanned with a clean score")
+	}
+	r = newTestReputation(t, banListPath, &now)
+	if len(r.BanList()) != 0 {
+		t.Fatal("expired ban should be removed from ban list")
+	}
+}
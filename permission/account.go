@@ -91,10 +91,13 @@ func update(ctx contractBase.KContext, aclJSON []byte, accountName string, metho
 	permissionRule := acl.GetPm().GetRule()
 
 	switch permissionRule {
-	case protos.PermissionRule_SIGN_THRESHOLD:
+	case protos.PermissionRule_SIGN_THRESHOLD, protos.PermissionRule_SIGN_RATE, protos.PermissionRule_SIGN_SUM:
 		return updateForThreshold(ctx, aksWeight, accountName, method)
 	case protos.PermissionRule_SIGN_AKSET:
 		return updateForAKSet(ctx, akSets, accountName, method)
+	case protos.PermissionRule_CA_SERVER, protos.PermissionRule_COMMUNITY_VOTE:
+		// CA_SERVER和COMMUNITY_VOTE的成员不固定，没有ak到账户的映射
+		return nil
 	default:
 		return errors.New("update ak to account reflection failed, permission model is not found")
 	}
@@ -0,0 +1,24 @@
+package base
+
+import (
+	"encoding/pem"
+	"errors"
+
+	"github.com/wooyang2018/corechain/crypto/core/gmsm/sm2"
+)
+
+// ParseCertChain 解析PEM格式的证书链，第一个为成员证书，其后为签发它的中间CA证书
+func ParseCertChain(data []byte) ([]*sm2.Certificate, error) {
+	var certs []*sm2.Certificate
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		cert, err := sm2.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, errors.New("no certificate found")
+	}
+	return certs, nil
+}
@@ -15,6 +15,10 @@ const (
 	contract2AccountBucket = "XCContract2Account"
 	account2ContractBucket = "XCAccount2Contract"
 	ak2AccountBucket       = "XCAK2Account"
+	caBucket               = "XCCAServer"
+	akCertBucket           = "XCAKCert"
+	crlKeyInfix            = "crl"
+	proposalIDArg          = "$proposal_id"
 	akLimit                = 1024
 	aclSeparator           = "\x01"
 	accountBcnameSep       = "@"
@@ -111,3 +115,28 @@ func GetContractNameMinSize() int {
 func GetAK2AccountBucket() string {
 	return ak2AccountBucket
 }
+
+// GetCABucket return the bucket of CA certificates and revocation lists, keyed by CA account
+func GetCABucket() string {
+	return caBucket
+}
+
+// GetAKCertBucket return the bucket of certificates registered by aks
+func GetAKCertBucket() string {
+	return akCertBucket
+}
+
+// MakeCRLKey generate the key of a revoked certificate serial number issued by CA account
+func MakeCRLKey(caAccount string, serial string) string {
+	return caAccount + aclSeparator + crlKeyInfix + aclSeparator + serial
+}
+
+// GetProposalIDArg get the reserved invoke arg which refers to a passed proposal
+func GetProposalIDArg() string {
+	return proposalIDArg
+}
+
+// GetProposalID return the proposal id referenced by invoke args, empty if not found
+func GetProposalID(args map[string][]byte) string {
+	return string(args[proposalIDArg])
+}
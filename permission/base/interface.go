@@ -8,4 +8,6 @@ type AclManager interface {
 	GetAccountACL(accountName string) (*protos.Acl, error)
 	GetContractMethodACL(contractName, methodName string) (*protos.Acl, error)
 	GetAccountAddresses(accountName string) ([]string, error)
+	// 从最新确认快照中读取数据，供需要访问链上状态的ACL规则使用
+	GetObjectBySnapshot(bucket string, object []byte) ([]byte, error)
 }
@@ -0,0 +1,84 @@
+package base
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"sort"
+)
+
+// COMMUNITY_VOTE提案中绑定被授权调用的参数名
+const (
+	ProposalInitiatorArg = "acl_initiator"
+	ProposalContractArg  = "acl_contract"
+	ProposalMethodArg    = "acl_method"
+	ProposalArgsHashArg  = "acl_args_hash"
+)
+
+// ProposalRequest 引用提案的一次合约调用
+// COMMUNITY_VOTE提案只授权其绑定的发起人、合约方法和参数，其他调用引用该提案既不能获得授权也不会消耗提案
+type ProposalRequest struct {
+	ProposalID string
+	Initiator  string
+	Contract   string
+	Method     string
+	Args       map[string][]byte
+}
+
+// NewProposalRequest 调用参数中没有引用提案时返回nil
+func NewProposalRequest(initiator, contract, method string, args map[string][]byte) *ProposalRequest {
+	proposalID := GetProposalID(args)
+	if proposalID == "" {
+		return nil
+	}
+	return &ProposalRequest{
+		ProposalID: proposalID,
+		Initiator:  initiator,
+		Contract:   contract,
+		Method:     method,
+		Args:       args,
+	}
+}
+
+// Match 提案参数中绑定的发起人、合约、方法和参数摘要需与调用完全一致
+func (r *ProposalRequest) Match(proposalArgs map[string]interface{}) bool {
+	if r == nil {
+		return false
+	}
+	expect := map[string]string{
+		ProposalInitiatorArg: r.Initiator,
+		ProposalContractArg:  r.Contract,
+		ProposalMethodArg:    r.Method,
+		ProposalArgsHashArg:  MakeProposalArgsHash(r.Args),
+	}
+	for key, value := range expect {
+		bound, _ := proposalArgs[key].(string)
+		if bound == "" || bound != value {
+			return false
+		}
+	}
+	return true
+}
+
+// MakeProposalArgsHash 计算调用参数的摘要，不包含提案id参数
+// 按参数名排序后依次写入4字节大端长度前缀的参数名和参数值，取sha256的十六进制编码
+func MakeProposalArgsHash(args map[string][]byte) string {
+	keys := make([]string, 0, len(args))
+	for key := range args {
+		if key != proposalIDArg {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	h := sha256.New()
+	var size [4]byte
+	for _, key := range keys {
+		for _, buf := range [][]byte{[]byte(key), args[key]} {
+			binary.BigEndian.PutUint32(size[:], uint32(len(buf)))
+			h.Write(size[:])
+			h.Write(buf)
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,120 @@
+package permission
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+
+	contractBase "github.com/wooyang2018/corechain/contract/base"
+	"github.com/wooyang2018/corechain/contract/sandbox"
+	"github.com/wooyang2018/corechain/crypto/common/account"
+	"github.com/wooyang2018/corechain/crypto/core/gmsm/sm2"
+	"github.com/wooyang2018/corechain/permission/base"
+)
+
+// SetCA 为账户登记CA根证书，支持ECDSA和SM2证书，账户权限由交易验证时的读写集检查保证
+func (t *KernMethod) SetCA(ctx contractBase.KContext) (*contractBase.Response, error) {
+	if ctx.ResourceLimit().XFee < t.NewAccountResourceAmount/1000 {
+		return nil, fmt.Errorf("gas not enough, expect no less than %d", t.NewAccountResourceAmount/1000)
+	}
+	args := ctx.Args()
+	accountName := string(args["account_name"])
+	certPEM := args["ca_cert"]
+	if err := t.checkAccountExist(ctx, accountName); err != nil {
+		return nil, err
+	}
+	if _, err := sm2.ReadCertificateFromMem(certPEM); err != nil {
+		return nil, fmt.Errorf("parse ca cert failed: %v", err)
+	}
+
+	err := ctx.Put(base.GetCABucket(), []byte(accountName), certPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.AddResourceUsed(contractBase.Limits{
+		XFee: t.NewAccountResourceAmount / 1000,
+	})
+	return &contractBase.Response{
+		Status:  base.StatusOK,
+		Message: "success",
+	}, nil
+}
+
+// RevokeCert 将CA签发的证书序列号加入链上吊销列表
+func (t *KernMethod) RevokeCert(ctx contractBase.KContext) (*contractBase.Response, error) {
+	if ctx.ResourceLimit().XFee < t.NewAccountResourceAmount/1000 {
+		return nil, fmt.Errorf("gas not enough, expect no less than %d", t.NewAccountResourceAmount/1000)
+	}
+	args := ctx.Args()
+	accountName := string(args["account_name"])
+	serial, ok := new(big.Int).SetString(string(args["serial"]), 10)
+	if !ok {
+		return nil, fmt.Errorf("revoke cert failed, serial should be a decimal number")
+	}
+	caCert, err := ctx.Get(base.GetCABucket(), []byte(accountName))
+	if err != nil && err != sandbox.ErrNotFound {
+		return nil, err
+	}
+	if len(caCert) == 0 {
+		return nil, fmt.Errorf("revoke cert failed, CA of account %s not found", accountName)
+	}
+
+	key := base.MakeCRLKey(accountName, serial.String())
+	err = ctx.Put(base.GetCABucket(), []byte(key), []byte("true"))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.AddResourceUsed(contractBase.Limits{
+		XFee: t.NewAccountResourceAmount / 1000,
+	})
+	return &contractBase.Response{
+		Status:  base.StatusOK,
+		Message: "success",
+	}, nil
+}
+
+// RegisterCert 登记发起者自己的证书，可附带中间CA证书，成员证书公钥对应的地址必须为交易发起者
+func (t *KernMethod) RegisterCert(ctx contractBase.KContext) (*contractBase.Response, error) {
+	certPEM := ctx.Args()["cert"]
+	certs, err := base.ParseCertChain(certPEM)
+	if err != nil {
+		return nil, fmt.Errorf("parse cert failed: %v", err)
+	}
+	pubKey, ok := certs[0].PublicKey.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("register cert failed, only ECDSA and SM2 certs supported")
+	}
+	ak, err := account.GetAddressFromPublicKey(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	if ak != ctx.Initiator() {
+		return nil, fmt.Errorf("register cert failed, cert belongs to %s rather than initiator", ak)
+	}
+
+	err = ctx.Put(base.GetAKCertBucket(), []byte(ak), certPEM)
+	if err != nil {
+		return nil, err
+	}
+	return &contractBase.Response{
+		Status:  base.StatusOK,
+		Message: "success",
+		Body:    []byte(ak),
+	}, nil
+}
+
+func (t *KernMethod) checkAccountExist(ctx contractBase.KContext, accountName string) error {
+	if base.IsAccount(accountName) != 1 {
+		return fmt.Errorf("%s is not a valid account", accountName)
+	}
+	acl, err := ctx.Get(base.GetAccountBucket(), []byte(accountName))
+	if err != nil && err != sandbox.ErrNotFound {
+		return err
+	}
+	if acl == nil {
+		return fmt.Errorf("account %s not found", accountName)
+	}
+	return nil
+}
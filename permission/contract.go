@@ -3,6 +3,7 @@ package permission
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 
 	contractBase "github.com/wooyang2018/corechain/contract/base"
 	"github.com/wooyang2018/corechain/contract/sandbox"
@@ -193,6 +194,32 @@ func validACL(acl *protos.Acl) error {
 			if aksWeight == nil || len(aksWeight) > base.GetAkLimit() {
 				return fmt.Errorf("valid acl failed, aksWeight is nil or size of aksWeight is very big")
 			}
+		} else if permissionRule == protos.PermissionRule_SIGN_RATE || permissionRule == protos.PermissionRule_SIGN_SUM {
+			if len(aksWeight) == 0 || len(aksWeight) > base.GetAkLimit() {
+				return fmt.Errorf("valid acl failed, aksWeight is empty or size of aksWeight is very big")
+			}
+			if err := validAcceptValue(permissionRule, permissionModel.GetAcceptValue(), len(aksWeight)); err != nil {
+				return err
+			}
+		} else if permissionRule == protos.PermissionRule_CA_SERVER {
+			if len(aksWeight) == 0 || len(aksWeight) > base.GetAkLimit() {
+				return fmt.Errorf("valid acl failed, CA accounts is empty or size of CA accounts is very big")
+			}
+			for caAccount := range aksWeight {
+				if base.IsAccount(caAccount) != 1 {
+					return fmt.Errorf("valid acl failed, CA %s is not an account", caAccount)
+				}
+			}
+			acceptValue := permissionModel.GetAcceptValue()
+			if acceptValue < 1 || acceptValue != math.Trunc(acceptValue) {
+				return fmt.Errorf("valid acl failed, acceptValue of CA_SERVER should be a positive integer")
+			}
+		} else if permissionRule == protos.PermissionRule_COMMUNITY_VOTE {
+			// acceptValue为提案要求的最低投票比例(百分比)
+			acceptValue := permissionModel.GetAcceptValue()
+			if acceptValue <= 0 || acceptValue > 100 {
+				return fmt.Errorf("valid acl failed, acceptValue of COMMUNITY_VOTE should be in (0, 100]")
+			}
 		} else if permissionRule == protos.PermissionRule_SIGN_AKSET {
 			if akSets != nil {
 				sets := akSets.GetSets()
@@ -211,3 +238,18 @@ func validACL(acl *protos.Acl) error {
 
 	return nil
 }
+
+// validAcceptValue 签名率需在(0,1]之间，签名个数需为不超过成员总数的正整数
+func validAcceptValue(rule protos.PermissionRule, acceptValue float64, members int) error {
+	switch rule {
+	case protos.PermissionRule_SIGN_RATE:
+		if acceptValue <= 0 || acceptValue > 1 {
+			return fmt.Errorf("valid acl failed, acceptValue of SIGN_RATE should be in (0, 1]")
+		}
+	case protos.PermissionRule_SIGN_SUM:
+		if acceptValue < 1 || acceptValue > float64(members) || acceptValue != math.Trunc(acceptValue) {
+			return fmt.Errorf("valid acl failed, acceptValue of SIGN_SUM should be an integer in [1, %d]", members)
+		}
+	}
+	return nil
+}
@@ -30,6 +30,9 @@ func NewACLManager(ctx *base.AclCtx) (base.AclManager, error) {
 	register.RegisterKernMethod(base.SubModName, "NewAccount", t.NewAccount)
 	register.RegisterKernMethod(base.SubModName, "SetAccountAcl", t.SetAccountACL)
 	register.RegisterKernMethod(base.SubModName, "SetMethodAcl", t.SetMethodACL)
+	register.RegisterKernMethod(base.SubModName, "SetCA", t.SetCA)
+	register.RegisterKernMethod(base.SubModName, "RevokeCert", t.RevokeCert)
+	register.RegisterKernMethod(base.SubModName, "RegisterCert", t.RegisterCert)
 	register.RegisterShortcut("NewAccount", base.SubModName, "NewAccount")
 	register.RegisterShortcut("SetAccountAcl", base.SubModName, "SetAccountAcl")
 	register.RegisterShortcut("SetMethodAcl", base.SubModName, "SetMethodAcl")
@@ -104,7 +107,7 @@ func (mgr *Manager) getAddressesByACL(acl *protos.Acl) ([]string, error) {
 	addresses := make([]string, 0)
 
 	switch acl.GetPm().GetRule() {
-	case protos.PermissionRule_SIGN_THRESHOLD:
+	case protos.PermissionRule_SIGN_THRESHOLD, protos.PermissionRule_SIGN_RATE, protos.PermissionRule_SIGN_SUM:
 		for ak := range acl.GetAksWeight() {
 			addresses = append(addresses, ak)
 		}
@@ -113,6 +116,9 @@ func (mgr *Manager) getAddressesByACL(acl *protos.Acl) ([]string, error) {
 			aks := set.GetAks()
 			addresses = append(addresses, aks...)
 		}
+	case protos.PermissionRule_CA_SERVER, protos.PermissionRule_COMMUNITY_VOTE:
+		// 成员由CA签发证书或治理代币投票决定，没有固定的地址列表
+		return addresses, nil
 	default:
 		return nil, errors.New("Unknown permission rule")
 	}
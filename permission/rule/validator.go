@@ -3,6 +3,7 @@ package rule
 import (
 	"errors"
 
+	"github.com/wooyang2018/corechain/permission/base"
 	"github.com/wooyang2018/corechain/protos"
 )
 
@@ -15,7 +16,13 @@ type ACLValidator interface {
 }
 
 // ACLValidatorFactory create ACLValidator for specified permission model
+// AclMgr is used by validators which need to read chain state, such as CA_SERVER
+// Proposal and ContractName are used by COMMUNITY_VOTE, which refer to the contract request
+// referencing a proposal in tx and the contract of method perm tree
 type ACLValidatorFactory struct {
+	AclMgr       base.AclManager
+	Proposal     *base.ProposalRequest
+	ContractName string
 }
 
 // GetACLValidator returns ACLValidator for specified permission model
@@ -26,18 +33,13 @@ func (vf *ACLValidatorFactory) GetACLValidator(pr protos.PermissionRule) (ACLVal
 	case protos.PermissionRule_SIGN_AKSET:
 		return NewAKSetsValidator(), nil
 	case protos.PermissionRule_SIGN_RATE:
-		return vf.notImplementedValidator()
+		return NewRateValidator(), nil
 	case protos.PermissionRule_SIGN_SUM:
-		return vf.notImplementedValidator()
+		return NewSumValidator(), nil
 	case protos.PermissionRule_CA_SERVER:
-		return vf.notImplementedValidator()
+		return NewCAValidator(vf.AclMgr), nil
 	case protos.PermissionRule_COMMUNITY_VOTE:
-		return vf.notImplementedValidator()
+		return NewCommunityVoteValidator(vf.AclMgr, vf.Proposal, vf.ContractName), nil
 	}
 	return nil, errors.New("Unknown permission rule")
 }
-
-// notImplementedValidator return error for not implemented validator of PermissionRule
-func (vf *ACLValidatorFactory) notImplementedValidator() (ACLValidator, error) {
-	return nil, errors.New("This permission rule is not implemented")
-}
@@ -0,0 +1,128 @@
+package rule
+
+import (
+	"errors"
+
+	"github.com/wooyang2018/corechain/crypto/core/gmsm/sm2"
+	"github.com/wooyang2018/corechain/permission/base"
+)
+
+// CAValidator is Valiator for CA_SERVER permission model
+// aksWeight中为受信任的CA账户，ak在链上登记的证书由其中任一CA签发且未被吊销即视为授权成员，
+// 授权成员签名个数不少于AcceptValue即可通过
+type CAValidator struct {
+	aclMgr base.AclManager
+}
+
+// NewCAValidator return instance of CAValidator
+func NewCAValidator(aclMgr base.AclManager) *CAValidator {
+	return &CAValidator{
+		aclMgr: aclMgr,
+	}
+}
+
+// Validate implements the interface of ACLValidator
+func (cv *CAValidator) Validate(pnode *PermNode) (bool, error) {
+	if pnode == nil || pnode.ACL == nil || pnode.ACL.Pm == nil {
+		return false, InvalidErr
+	}
+	if cv.aclMgr == nil {
+		return false, errors.New("CA validator requires acl manager")
+	}
+
+	cas := make(map[string]*sm2.Certificate, len(pnode.ACL.AksWeight))
+	for caAccount := range pnode.ACL.AksWeight {
+		caCert, err := cv.loadCert(base.GetCABucket(), caAccount)
+		if err != nil {
+			return false, err
+		}
+		// CA尚未登记证书时跳过
+		if caCert != nil {
+			cas[caAccount] = caCert
+		}
+	}
+
+	certified := 0
+	for _, node := range pnode.Children {
+		// only ak with valid signature could present certificate
+		if node.Status != Success || base.IsAccount(node.Name) != 0 {
+			continue
+		}
+		ok, err := cv.isCertified(node.Name, cas)
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			certified++
+		}
+	}
+	return float64(certified) >= pnode.ACL.Pm.AcceptValue, nil
+}
+
+// isCertified 成员证书可经登记的中间CA证书链到任一CA，链上证书均未被该CA吊销即视为授权
+// 校验时间取成员证书的生效时间，不依赖本地时间以保证各节点结果一致
+func (cv *CAValidator) isCertified(ak string, cas map[string]*sm2.Certificate) (bool, error) {
+	certPEM, err := cv.aclMgr.GetObjectBySnapshot(base.GetAKCertBucket(), []byte(ak))
+	if err != nil || len(certPEM) == 0 {
+		return false, err
+	}
+	certs, err := base.ParseCertChain(certPEM)
+	if err != nil {
+		return false, err
+	}
+	cert := certs[0]
+	intermediates := sm2.NewCertPool()
+	for _, c := range certs[1:] {
+		intermediates.AddCert(c)
+	}
+
+	for caAccount, caCert := range cas {
+		roots := sm2.NewCertPool()
+		roots.AddCert(caCert)
+		chains, err := cert.Verify(sm2.VerifyOptions{
+			Roots:         roots,
+			Intermediates: intermediates,
+			CurrentTime:   cert.NotBefore,
+			KeyUsages:     []sm2.ExtKeyUsage{sm2.ExtKeyUsageAny},
+		})
+		if err != nil {
+			continue
+		}
+		for _, chain := range chains {
+			revoked, err := cv.isRevoked(caAccount, chain[:len(chain)-1])
+			if err != nil {
+				return false, err
+			}
+			if !revoked {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// isRevoked 成员证书或中间CA证书的序列号在CA账户的吊销列表中
+func (cv *CAValidator) isRevoked(caAccount string, certs []*sm2.Certificate) (bool, error) {
+	for _, cert := range certs {
+		revoked, err := cv.aclMgr.GetObjectBySnapshot(base.GetCABucket(),
+			[]byte(base.MakeCRLKey(caAccount, cert.SerialNumber.String())))
+		if err != nil {
+			return false, err
+		}
+		if len(revoked) != 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (cv *CAValidator) loadCert(bucket, key string) (*sm2.Certificate, error) {
+	certPEM, err := cv.aclMgr.GetObjectBySnapshot(bucket, []byte(key))
+	if err != nil {
+		return nil, err
+	}
+	if len(certPEM) == 0 {
+		return nil, nil
+	}
+	return sm2.ReadCertificateFromMem(certPEM)
+}
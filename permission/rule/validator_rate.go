@@ -0,0 +1,24 @@
+package rule
+
+// RateValidator is Valiator for SignRate permission model
+// 签名成功的成员个数占aksWeight成员总数的比例不低于AcceptValue即可通过
+type RateValidator struct{}
+
+// NewRateValidator return instance of RateValidator
+func NewRateValidator() *RateValidator {
+	return &RateValidator{}
+}
+
+// Validate implements the interface of ACLValidator
+func (rv *RateValidator) Validate(pnode *PermNode) (bool, error) {
+	if pnode == nil || pnode.ACL == nil || pnode.ACL.Pm == nil {
+		return false, InvalidErr
+	}
+
+	total := len(pnode.ACL.AksWeight)
+	if total == 0 {
+		return false, nil
+	}
+	signed := countSignedMembers(pnode)
+	return float64(signed)/float64(total) >= pnode.ACL.Pm.AcceptValue, nil
+}
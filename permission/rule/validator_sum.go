@@ -0,0 +1,39 @@
+package rule
+
+// SumValidator is Valiator for SignSum permission model
+// aksWeight中的成员只计数不计权重，签名成功的成员个数不少于AcceptValue即可通过
+type SumValidator struct{}
+
+// NewSumValidator return instance of SumValidator
+func NewSumValidator() *SumValidator {
+	return &SumValidator{}
+}
+
+// Validate implements the interface of ACLValidator
+func (sv *SumValidator) Validate(pnode *PermNode) (bool, error) {
+	if pnode == nil || pnode.ACL == nil || pnode.ACL.Pm == nil {
+		return false, InvalidErr
+	}
+
+	signed := countSignedMembers(pnode)
+	return float64(signed) >= pnode.ACL.Pm.AcceptValue, nil
+}
+
+// countSignedMembers 统计通过验证且属于ACL成员的子节点个数，子节点可以是ak或者嵌套的账户
+func countSignedMembers(pnode *PermNode) int {
+	if len(pnode.ACL.AksWeight) == 0 {
+		return 0
+	}
+
+	signed := 0
+	for _, node := range pnode.Children {
+		// the child account/ak must be passed the validation before
+		if node.Status != Success {
+			continue
+		}
+		if _, ok := pnode.ACL.AksWeight[node.Name]; ok {
+			signed++
+		}
+	}
+	return signed
+}
@@ -1,8 +1,19 @@
 package rule
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
 	"testing"
+	"time"
 
+	"github.com/wooyang2018/corechain/contract/proposal/utils"
+	"github.com/wooyang2018/corechain/crypto/core/gmsm/sm2"
+	"github.com/wooyang2018/corechain/permission/base"
 	"github.com/wooyang2018/corechain/protos"
 )
 
@@ -14,9 +25,9 @@ func Test_ValidatorFactory(t *testing.T) {
 		return
 	}
 
-	_, err = vf.GetACLValidator(protos.PermissionRule_CA_SERVER)
-	if err == nil || err.Error() != "This permission rule is not implemented" {
-		t.Error("CA_SERVER error not match")
+	_, err = vf.GetACLValidator(protos.PermissionRule_COMMUNITY_VOTE)
+	if err != nil {
+		t.Error("COMMUNITY_VOTE create failed")
 		return
 	}
 
@@ -149,3 +160,330 @@ func Test_AkSetsValidator(t *testing.T) {
 		return
 	}
 }
+
+func Test_SumValidator(t *testing.T) {
+	vf := ACLValidatorFactory{}
+	sv, err := vf.GetACLValidator(protos.PermissionRule_SIGN_SUM)
+	if err != nil {
+		t.Error("SIGN_SUM create failed")
+		return
+	}
+	aclObj := &protos.Acl{
+		Pm: &protos.PermissionModel{
+			Rule:        protos.PermissionRule_SIGN_SUM,
+			AcceptValue: 2,
+		},
+		AksWeight: map[string]float64{"ak1": 1, "ak2": 1, "ak3": 1, "XC1111111111111111@xuper": 1},
+	}
+
+	rootNode := NewPermNode("XC2222222222222222@xuper", aclObj)
+	ak1Node := NewPermNode("ak1", nil)
+	ak1Node.Status = Success
+	// ak4 is not member of ACL, should not be counted
+	ak4Node := NewPermNode("ak4", nil)
+	ak4Node.Status = Success
+	rootNode.Children = append(rootNode.Children, ak1Node, ak4Node)
+	result, err := sv.Validate(rootNode)
+	if err != nil || result {
+		t.Error("validate failed, should have no error and result is false")
+		return
+	}
+
+	// nested account which failed validation should not be counted
+	accountNode := NewPermNode("XC1111111111111111@xuper", nil)
+	accountNode.Status = Failed
+	rootNode.Children = append(rootNode.Children, accountNode)
+	result, err = sv.Validate(rootNode)
+	if err != nil || result {
+		t.Error("validate failed, should have no error and result is false")
+		return
+	}
+
+	accountNode.Status = Success
+	result, err = sv.Validate(rootNode)
+	if err != nil || !result {
+		t.Error("validate failed, should have no error and result is true. result=", result)
+		return
+	}
+}
+
+func Test_RateValidator(t *testing.T) {
+	vf := ACLValidatorFactory{}
+	rv, err := vf.GetACLValidator(protos.PermissionRule_SIGN_RATE)
+	if err != nil {
+		t.Error("SIGN_RATE create failed")
+		return
+	}
+	aclObj := &protos.Acl{
+		Pm: &protos.PermissionModel{
+			Rule:        protos.PermissionRule_SIGN_RATE,
+			AcceptValue: 0.6,
+		},
+		AksWeight: map[string]float64{"ak1": 1, "ak2": 1, "ak3": 1, "ak4": 1, "ak5": 1},
+	}
+
+	rootNode := NewPermNode("Alice", aclObj)
+	for _, name := range []string{"ak1", "ak2"} {
+		node := NewPermNode(name, nil)
+		node.Status = Success
+		rootNode.Children = append(rootNode.Children, node)
+	}
+	result, err := rv.Validate(rootNode)
+	if err != nil || result {
+		t.Error("validate failed, should have no error and result is false")
+		return
+	}
+
+	ak3Node := NewPermNode("ak3", nil)
+	ak3Node.Status = Success
+	rootNode.Children = append(rootNode.Children, ak3Node)
+	result, err = rv.Validate(rootNode)
+	if err != nil || !result {
+		t.Error("validate failed, should have no error and result is true. result=", result)
+		return
+	}
+
+	if _, err := rv.Validate(NewPermNode("Bob", nil)); err != InvalidErr {
+		t.Error("validate nil acl should return InvalidErr")
+	}
+}
+
+type fakeAclManager struct {
+	data map[string][]byte
+}
+
+func (f *fakeAclManager) GetAccountACL(accountName string) (*protos.Acl, error) {
+	return nil, nil
+}
+
+func (f *fakeAclManager) GetContractMethodACL(contractName, methodName string) (*protos.Acl, error) {
+	return nil, nil
+}
+
+func (f *fakeAclManager) GetAccountAddresses(accountName string) ([]string, error) {
+	return nil, nil
+}
+
+func (f *fakeAclManager) GetObjectBySnapshot(bucket string, object []byte) ([]byte, error) {
+	return f.data[bucket+"/"+string(object)], nil
+}
+
+func (f *fakeAclManager) put(bucket, key string, value []byte) {
+	f.data[bucket+"/"+key] = value
+}
+
+func newTestCertTemplate(serial int64, isCA bool) *x509.Certificate {
+	return &x509.Certificate{
+		SerialNumber:          big.NewInt(serial),
+		Subject:               pkix.Name{CommonName: "corechain test"},
+		NotBefore:             time.Unix(1000, 0),
+		NotAfter:              time.Unix(100000, 0),
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+	}
+}
+
+func createECDSACert(t *testing.T, template, parent *x509.Certificate, pub *ecdsa.PublicKey, priv *ecdsa.PrivateKey) []byte {
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, pub, priv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func Test_CAValidator(t *testing.T) {
+	aclMgr := &fakeAclManager{data: make(map[string][]byte)}
+	vf := ACLValidatorFactory{AclMgr: aclMgr}
+	cv, err := vf.GetACLValidator(protos.PermissionRule_CA_SERVER)
+	if err != nil {
+		t.Fatal("CA_SERVER create failed")
+	}
+
+	// ECDSA CA and member certs
+	caKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	caTemplate := newTestCertTemplate(1, true)
+	aclMgr.put(base.GetCABucket(), "XC1111111111111111@xuper",
+		createECDSACert(t, caTemplate, caTemplate, &caKey.PublicKey, caKey))
+	memberKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	aclMgr.put(base.GetAKCertBucket(), "ak1",
+		createECDSACert(t, newTestCertTemplate(2, false), caTemplate, &memberKey.PublicKey, caKey))
+	// self signed cert should not be accepted
+	otherKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	otherTemplate := newTestCertTemplate(3, false)
+	aclMgr.put(base.GetAKCertBucket(), "ak2",
+		createECDSACert(t, otherTemplate, otherTemplate, &otherKey.PublicKey, otherKey))
+
+	// SM2 CA and member certs
+	sm2CAKey, err := sm2.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sm2CATemplate := &sm2.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "corechain sm2 ca"},
+		NotBefore:             time.Unix(1000, 0),
+		NotAfter:              time.Unix(100000, 0),
+		SignatureAlgorithm:    sm2.SM2WithSM3,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		KeyUsage:              sm2.KeyUsageCertSign,
+	}
+	sm2CAPEM, err := sm2.CreateCertificateToMem(sm2CATemplate, sm2CATemplate, &sm2CAKey.PublicKey, sm2CAKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	aclMgr.put(base.GetCABucket(), "XC2222222222222222@xuper", sm2CAPEM)
+	sm2MemberKey, _ := sm2.GenerateKey()
+	sm2MemberTemplate := *sm2CATemplate
+	sm2MemberTemplate.SerialNumber = big.NewInt(4)
+	sm2MemberTemplate.IsCA = false
+	sm2MemberPEM, err := sm2.CreateCertificateToMem(&sm2MemberTemplate, sm2CATemplate, &sm2MemberKey.PublicKey, sm2CAKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	aclMgr.put(base.GetAKCertBucket(), "ak3", sm2MemberPEM)
+
+	aclObj := &protos.Acl{
+		Pm: &protos.PermissionModel{
+			Rule:        protos.PermissionRule_CA_SERVER,
+			AcceptValue: 2,
+		},
+		AksWeight: map[string]float64{"XC1111111111111111@xuper": 1, "XC2222222222222222@xuper": 1},
+	}
+	rootNode := NewPermNode("Alice", aclObj)
+	for _, name := range []string{"ak1", "ak2"} {
+		node := NewPermNode(name, nil)
+		node.Status = Success
+		rootNode.Children = append(rootNode.Children, node)
+	}
+	result, err := cv.Validate(rootNode)
+	if err != nil || result {
+		t.Error("validate failed, should have no error and result is false. err=", err)
+		return
+	}
+
+	ak3Node := NewPermNode("ak3", nil)
+	ak3Node.Status = Success
+	rootNode.Children = append(rootNode.Children, ak3Node)
+	result, err = cv.Validate(rootNode)
+	if err != nil || !result {
+		t.Error("validate failed, should have no error and result is true. err=", err)
+		return
+	}
+
+	// revoked cert should not be accepted
+	aclMgr.put(base.GetCABucket(), base.MakeCRLKey("XC2222222222222222@xuper", "4"), []byte("true"))
+	result, err = cv.Validate(rootNode)
+	if err != nil || result {
+		t.Error("validate failed, should have no error and result is false after revocation. err=", err)
+		return
+	}
+
+	// cert issued by an intermediate CA is accepted with the intermediate cert registered
+	interKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	interTemplate := newTestCertTemplate(5, true)
+	interTemplate.Subject.CommonName = "corechain intermediate"
+	interPEM := createECDSACert(t, interTemplate, caTemplate, &interKey.PublicKey, caKey)
+	leafKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	leafTemplate := newTestCertTemplate(6, false)
+	leafTemplate.Subject.CommonName = "corechain member"
+	leafPEM := createECDSACert(t, leafTemplate, interTemplate, &leafKey.PublicKey, interKey)
+
+	aclObj.Pm.AcceptValue = 1
+	aclObj.AksWeight = map[string]float64{"XC1111111111111111@xuper": 1}
+	ak4Node := NewPermNode("ak4", nil)
+	ak4Node.Status = Success
+	rootNode.Children = []*PermNode{ak4Node}
+	aclMgr.put(base.GetAKCertBucket(), "ak4", leafPEM)
+	if result, err = cv.Validate(rootNode); err != nil || result {
+		t.Error("validate failed, should be false without the intermediate cert. err=", err)
+	}
+	aclMgr.put(base.GetAKCertBucket(), "ak4", append(leafPEM, interPEM...))
+	if result, err = cv.Validate(rootNode); err != nil || !result {
+		t.Error("validate failed, should be true with the intermediate cert. err=", err)
+	}
+	aclMgr.put(base.GetCABucket(), base.MakeCRLKey("XC1111111111111111@xuper", "5"), []byte("true"))
+	if result, err = cv.Validate(rootNode); err != nil || result {
+		t.Error("validate failed, should be false after the intermediate cert revoked. err=", err)
+	}
+}
+
+func Test_CommunityVoteValidator(t *testing.T) {
+	aclMgr := &fakeAclManager{data: make(map[string][]byte)}
+	args := map[string][]byte{"delta": []byte("1")}
+	putProposal := func(id, status, target string) {
+		buf, _ := utils.UnParse(&utils.Proposal{
+			Args: map[string]interface{}{
+				"min_vote_percent":        "60",
+				"stop_vote_height":        "100",
+				"acl_target":              target,
+				base.ProposalInitiatorArg: "alice",
+				base.ProposalContractArg:  "counter",
+				base.ProposalMethodArg:    "increase",
+				base.ProposalArgsHashArg:  base.MakeProposalArgsHash(args),
+			},
+			Status: status,
+		})
+		aclMgr.put(utils.GetProposalBucket(), utils.MakeProposalKey(id), buf)
+	}
+	putProposal("1", utils.ProposalStatusPassed, base.MakeContractMethodKey("counter", "increase"))
+	putProposal("2", utils.ProposalStatusVoting, base.MakeContractMethodKey("counter", "increase"))
+	putProposal("3", utils.ProposalStatusPassed, "XC1111111111111111@xuper")
+
+	request := func(id, initiator, method string, delta string) *base.ProposalRequest {
+		return base.NewProposalRequest(initiator, "counter", method, map[string][]byte{
+			"delta":                 []byte(delta),
+			base.GetProposalIDArg(): []byte(id),
+		})
+	}
+	methodNode := NewPermNode("increase", &protos.Acl{
+		Pm: &protos.PermissionModel{
+			Rule:        protos.PermissionRule_COMMUNITY_VOTE,
+			AcceptValue: 51,
+		},
+	})
+	testCases := []struct {
+		proposal *base.ProposalRequest
+		contract string
+		node     *PermNode
+		expect   bool
+	}{
+		{nil, "counter", methodNode, false},
+		{request("1", "alice", "increase", "1"), "counter", methodNode, true},
+		{request("1", "alice", "increase", "1"), "other", methodNode, false},
+		{request("2", "alice", "increase", "1"), "counter", methodNode, false},
+		{request("3", "alice", "increase", "1"), "counter", methodNode, false},
+		{request("3", "alice", "increase", "1"), "", NewPermNode("XC1111111111111111@xuper", methodNode.ACL), true},
+		// 提案只授权绑定的发起人、方法和参数
+		{request("1", "mallory", "increase", "1"), "counter", methodNode, false},
+		{request("1", "alice", "decrease", "1"), "counter", methodNode, false},
+		{request("1", "alice", "increase", "100"), "counter", methodNode, false},
+		{request("3", "mallory", "increase", "1"), "", NewPermNode("XC1111111111111111@xuper", methodNode.ACL), false},
+	}
+	for i, tc := range testCases {
+		vf := ACLValidatorFactory{AclMgr: aclMgr, Proposal: tc.proposal, ContractName: tc.contract}
+		cv, err := vf.GetACLValidator(protos.PermissionRule_COMMUNITY_VOTE)
+		if err != nil {
+			t.Fatal(err)
+		}
+		result, err := cv.Validate(tc.node)
+		if err != nil || result != tc.expect {
+			t.Errorf("case %d: expect %v, got %v, err=%v", i, tc.expect, result, err)
+		}
+	}
+
+	// 提案要求的投票比例低于ACL要求
+	methodNode.ACL.Pm.AcceptValue = 80
+	vf := ACLValidatorFactory{AclMgr: aclMgr, Proposal: request("1", "alice", "increase", "1"), ContractName: "counter"}
+	cv, _ := vf.GetACLValidator(protos.PermissionRule_COMMUNITY_VOTE)
+	if result, err := cv.Validate(methodNode); err != nil || result {
+		t.Error("validate failed, should have no error and result is false. err=", err)
+	}
+
+	vf.Proposal = request("4", "alice", "increase", "1")
+	cv, _ = vf.GetACLValidator(protos.PermissionRule_COMMUNITY_VOTE)
+	if _, err := cv.Validate(methodNode); err == nil {
+		t.Error("expect error for proposal not found")
+	}
+}
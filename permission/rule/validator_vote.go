@@ -0,0 +1,86 @@
+package rule
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/wooyang2018/corechain/contract/proposal/utils"
+	"github.com/wooyang2018/corechain/permission/base"
+)
+
+// CommunityVoteValidator is Valiator for COMMUNITY_VOTE permission model
+// 交易需引用一个处于passed状态的提案，提案的acl_target为当前账户或合约方法，
+// 且提案要求的最低投票比例min_vote_percent不低于AcceptValue，按表决规则通过的提案使用quorum_percent，
+// 提案还需绑定交易的发起人、引用提案的合约方法及其参数摘要，防止其他人抢先使用提案
+type CommunityVoteValidator struct {
+	aclMgr       base.AclManager
+	proposal     *base.ProposalRequest
+	contractName string
+}
+
+// NewCommunityVoteValidator return instance of CommunityVoteValidator
+func NewCommunityVoteValidator(aclMgr base.AclManager, proposal *base.ProposalRequest,
+	contractName string) *CommunityVoteValidator {
+	return &CommunityVoteValidator{
+		aclMgr:       aclMgr,
+		proposal:     proposal,
+		contractName: contractName,
+	}
+}
+
+// Validate implements the interface of ACLValidator
+func (cv *CommunityVoteValidator) Validate(pnode *PermNode) (bool, error) {
+	if pnode == nil || pnode.ACL == nil || pnode.ACL.Pm == nil {
+		return false, InvalidErr
+	}
+	if cv.aclMgr == nil {
+		return false, errors.New("COMMUNITY_VOTE validator requires acl manager")
+	}
+	if cv.proposal == nil || cv.proposal.ProposalID == "" {
+		return false, nil
+	}
+
+	proposalBuf, err := cv.aclMgr.GetObjectBySnapshot(utils.GetProposalBucket(),
+		[]byte(utils.MakeProposalKey(cv.proposal.ProposalID)))
+	if err != nil {
+		return false, err
+	}
+	if len(proposalBuf) == 0 {
+		return false, fmt.Errorf("proposal %s not found", cv.proposal.ProposalID)
+	}
+	proposal, err := utils.Parse(string(proposalBuf))
+	if err != nil {
+		return false, err
+	}
+	if proposal.Status != utils.ProposalStatusPassed {
+		return false, nil
+	}
+
+	// 提案必须明确授权当前账户或合约方法
+	target, _ := proposal.Args["acl_target"].(string)
+	if target != cv.target(pnode) {
+		return false, nil
+	}
+	if !cv.proposal.Match(proposal.Args) {
+		return false, nil
+	}
+	// 按表决规则通过的提案，以法定人数比例作为最低投票比例
+	if proposal.Rule != nil {
+		return float64(proposal.Rule.QuorumPercent) >= pnode.ACL.Pm.AcceptValue, nil
+	}
+	minVotePercent, _ := proposal.Args["min_vote_percent"].(string)
+	percent, err := strconv.ParseFloat(minVotePercent, 64)
+	if err != nil {
+		return false, nil
+	}
+	return percent >= pnode.ACL.Pm.AcceptValue, nil
+}
+
+// target 账户节点为账户名，合约方法节点为contract+separator+method
+func (cv *CommunityVoteValidator) target(pnode *PermNode) string {
+	if base.IsAccount(pnode.Name) == 1 {
+		return pnode.Name
+	}
+	return base.MakeContractMethodKey(cv.contractName, pnode.Name)
+}
@@ -24,6 +24,13 @@ func IdentifyAK(akuri string, sign *protos.SignatureInfo, msg []byte) (bool, err
 }
 
 func IdentifyAccount(aclMgr base.AclManager, account string, aksuri []string) (bool, error) {
+	return IdentifyAccountWithProposal(aclMgr, account, aksuri, nil)
+}
+
+// IdentifyAccountWithProposal identify account with the contract request which refers to a proposal,
+// proposal is only used by COMMUNITY_VOTE permission rule
+func IdentifyAccountWithProposal(aclMgr base.AclManager, account string, aksuri []string,
+	proposal *base.ProposalRequest) (bool, error) {
 	// aks and signs could have zero length for permission rule Null
 	if aclMgr == nil {
 		return false, fmt.Errorf("Invalid Param, aclMgr=%v", aclMgr)
@@ -35,11 +42,19 @@ func IdentifyAccount(aclMgr base.AclManager, account string, aksuri []string) (b
 		return false, err
 	}
 
-	return validatePermTree(pnode, true)
+	vf := &rule.ACLValidatorFactory{AclMgr: aclMgr, Proposal: proposal}
+	return validatePermTree(vf, pnode, true)
 }
 
 func CheckContractMethodPerm(aclMgr base.AclManager, aksuri []string,
 	contractName, methodName string) (bool, error) {
+	return CheckContractMethodPermWithProposal(aclMgr, aksuri, contractName, methodName, nil)
+}
+
+// CheckContractMethodPermWithProposal check contract method perm with the contract request which refers to a proposal,
+// proposal is only used by COMMUNITY_VOTE permission rule
+func CheckContractMethodPermWithProposal(aclMgr base.AclManager, aksuri []string,
+	contractName, methodName string, proposal *base.ProposalRequest) (bool, error) {
 
 	// aks and signs could have zero length for permission rule Null
 	if aclMgr == nil {
@@ -53,10 +68,11 @@ func CheckContractMethodPerm(aclMgr base.AclManager, aksuri []string,
 	}
 
 	// validate perm tree
-	return validatePermTree(pnode, false)
+	vf := &rule.ACLValidatorFactory{AclMgr: aclMgr, Proposal: proposal, ContractName: contractName}
+	return validatePermTree(vf, pnode, false)
 }
 
-func validatePermTree(root *rule.PermNode, isAccount bool) (bool, error) {
+func validatePermTree(vf *rule.ACLValidatorFactory, root *rule.PermNode, isAccount bool) (bool, error) {
 	if root == nil {
 		return false, errors.New("Root is null")
 	}
@@ -67,7 +83,6 @@ func validatePermTree(root *rule.PermNode, isAccount bool) (bool, error) {
 		return false, err
 	}
 	listlen := len(plist)
-	vf := &rule.ACLValidatorFactory{}
 
 	// reverse travel the perm tree
 	for i := listlen - 1; i >= 0; i-- {
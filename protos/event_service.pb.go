@@ -25,15 +25,23 @@ type SubscribeType int32
 const (
 	// 区块事件，payload为BlockFilter
 	SubscribeType_BLOCK SubscribeType = 0
+	// 未确认交易事件，payload为PendingTxFilter
+	SubscribeType_PENDING_TX SubscribeType = 1
+	// 链状态事件，payload为ChainStatusFilter
+	SubscribeType_CHAIN_STATUS SubscribeType = 2
 )
 
 // Enum value maps for SubscribeType.
 var (
 	SubscribeType_name = map[int32]string{
 		0: "BLOCK",
+		1: "PENDING_TX",
+		2: "CHAIN_STATUS",
 	}
 	SubscribeType_value = map[string]int32{
-		"BLOCK": 0,
+		"BLOCK":        0,
+		"PENDING_TX":   1,
+		"CHAIN_STATUS": 2,
 	}
 )
 
@@ -64,6 +72,106 @@ func (SubscribeType) EnumDescriptor() ([]byte, []int) {
 	return file_event_service_proto_rawDescGZIP(), []int{0}
 }
 
+type PendingTxAction int32
+
+const (
+	// 交易进入mempool
+	PendingTxAction_ADMITTED PendingTxAction = 0
+	// 交易被移出mempool，如冲突、回滚、超时
+	PendingTxAction_EVICTED PendingTxAction = 1
+)
+
+// Enum value maps for PendingTxAction.
+var (
+	PendingTxAction_name = map[int32]string{
+		0: "ADMITTED",
+		1: "EVICTED",
+	}
+	PendingTxAction_value = map[string]int32{
+		"ADMITTED": 0,
+		"EVICTED":  1,
+	}
+)
+
+func (x PendingTxAction) Enum() *PendingTxAction {
+	p := new(PendingTxAction)
+	*p = x
+	return p
+}
+
+func (x PendingTxAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PendingTxAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_service_proto_enumTypes[1].Descriptor()
+}
+
+func (PendingTxAction) Type() protoreflect.EnumType {
+	return &file_event_service_proto_enumTypes[1]
+}
+
+func (x PendingTxAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PendingTxAction.Descriptor instead.
+func (PendingTxAction) EnumDescriptor() ([]byte, []int) {
+	return file_event_service_proto_rawDescGZIP(), []int{1}
+}
+
+type ChainStatusType int32
+
+const (
+	// 最新区块更新
+	ChainStatusType_NEW_TIP ChainStatusType = 0
+	// 发生分叉切换
+	ChainStatusType_FORK_SWITCH ChainStatusType = 1
+	// 不可逆区块高度推进
+	ChainStatusType_IRREVERSIBLE ChainStatusType = 2
+)
+
+// Enum value maps for ChainStatusType.
+var (
+	ChainStatusType_name = map[int32]string{
+		0: "NEW_TIP",
+		1: "FORK_SWITCH",
+		2: "IRREVERSIBLE",
+	}
+	ChainStatusType_value = map[string]int32{
+		"NEW_TIP":      0,
+		"FORK_SWITCH":  1,
+		"IRREVERSIBLE": 2,
+	}
+)
+
+func (x ChainStatusType) Enum() *ChainStatusType {
+	p := new(ChainStatusType)
+	*p = x
+	return p
+}
+
+func (x ChainStatusType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChainStatusType) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_service_proto_enumTypes[2].Descriptor()
+}
+
+func (ChainStatusType) Type() protoreflect.EnumType {
+	return &file_event_service_proto_enumTypes[2]
+}
+
+func (x ChainStatusType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChainStatusType.Descriptor instead.
+func (ChainStatusType) EnumDescriptor() ([]byte, []int) {
+	return file_event_service_proto_rawDescGZIP(), []int{2}
+}
+
 type SubscribeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -466,6 +574,333 @@ func (x *FilteredTransaction) GetEvents() []*ContractEvent {
 	return nil
 }
 
+type PendingTxFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BcName         string `protobuf:"bytes,1,opt,name=bc_name,json=bcName,proto3" json:"bc_name,omitempty"`
+	ExcludeTx      bool   `protobuf:"varint,3,opt,name=exclude_tx,json=excludeTx,proto3" json:"exclude_tx,omitempty"`
+	ExcludeEvicted bool   `protobuf:"varint,4,opt,name=exclude_evicted,json=excludeEvicted,proto3" json:"exclude_evicted,omitempty"`
+	Contract       string `protobuf:"bytes,10,opt,name=contract,proto3" json:"contract,omitempty"`
+	Initiator      string `protobuf:"bytes,12,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	AuthRequire    string `protobuf:"bytes,13,opt,name=auth_require,json=authRequire,proto3" json:"auth_require,omitempty"`
+	FromAddr       string `protobuf:"bytes,14,opt,name=from_addr,json=fromAddr,proto3" json:"from_addr,omitempty"`
+	ToAddr         string `protobuf:"bytes,15,opt,name=to_addr,json=toAddr,proto3" json:"to_addr,omitempty"`
+}
+
+func (x *PendingTxFilter) Reset() {
+	*x = PendingTxFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_service_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PendingTxFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingTxFilter) ProtoMessage() {}
+
+func (x *PendingTxFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_event_service_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingTxFilter.ProtoReflect.Descriptor instead.
+func (*PendingTxFilter) Descriptor() ([]byte, []int) {
+	return file_event_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PendingTxFilter) GetBcName() string {
+	if x != nil {
+		return x.BcName
+	}
+	return ""
+}
+
+func (x *PendingTxFilter) GetExcludeTx() bool {
+	if x != nil {
+		return x.ExcludeTx
+	}
+	return false
+}
+
+func (x *PendingTxFilter) GetExcludeEvicted() bool {
+	if x != nil {
+		return x.ExcludeEvicted
+	}
+	return false
+}
+
+func (x *PendingTxFilter) GetContract() string {
+	if x != nil {
+		return x.Contract
+	}
+	return ""
+}
+
+func (x *PendingTxFilter) GetInitiator() string {
+	if x != nil {
+		return x.Initiator
+	}
+	return ""
+}
+
+func (x *PendingTxFilter) GetAuthRequire() string {
+	if x != nil {
+		return x.AuthRequire
+	}
+	return ""
+}
+
+func (x *PendingTxFilter) GetFromAddr() string {
+	if x != nil {
+		return x.FromAddr
+	}
+	return ""
+}
+
+func (x *PendingTxFilter) GetToAddr() string {
+	if x != nil {
+		return x.ToAddr
+	}
+	return ""
+}
+
+type PendingTxEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bcname string          `protobuf:"bytes,1,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Txid   string          `protobuf:"bytes,2,opt,name=txid,proto3" json:"txid,omitempty"`
+	Action PendingTxAction `protobuf:"varint,3,opt,name=action,proto3,enum=protos.PendingTxAction" json:"action,omitempty"`
+	Tx     *Transaction    `protobuf:"bytes,4,opt,name=tx,proto3" json:"tx,omitempty"`
+}
+
+func (x *PendingTxEvent) Reset() {
+	*x = PendingTxEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_service_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PendingTxEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingTxEvent) ProtoMessage() {}
+
+func (x *PendingTxEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_event_service_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingTxEvent.ProtoReflect.Descriptor instead.
+func (*PendingTxEvent) Descriptor() ([]byte, []int) {
+	return file_event_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PendingTxEvent) GetBcname() string {
+	if x != nil {
+		return x.Bcname
+	}
+	return ""
+}
+
+func (x *PendingTxEvent) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+func (x *PendingTxEvent) GetAction() PendingTxAction {
+	if x != nil {
+		return x.Action
+	}
+	return PendingTxAction_ADMITTED
+}
+
+func (x *PendingTxEvent) GetTx() *Transaction {
+	if x != nil {
+		return x.Tx
+	}
+	return nil
+}
+
+type ChainStatusFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BcName string `protobuf:"bytes,1,opt,name=bc_name,json=bcName,proto3" json:"bc_name,omitempty"`
+	// 为空时订阅全部类型
+	Types []ChainStatusType `protobuf:"varint,2,rep,packed,name=types,proto3,enum=protos.ChainStatusType" json:"types,omitempty"`
+}
+
+func (x *ChainStatusFilter) Reset() {
+	*x = ChainStatusFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_service_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChainStatusFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainStatusFilter) ProtoMessage() {}
+
+func (x *ChainStatusFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_event_service_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainStatusFilter.ProtoReflect.Descriptor instead.
+func (*ChainStatusFilter) Descriptor() ([]byte, []int) {
+	return file_event_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ChainStatusFilter) GetBcName() string {
+	if x != nil {
+		return x.BcName
+	}
+	return ""
+}
+
+func (x *ChainStatusFilter) GetTypes() []ChainStatusType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+type ChainStatusEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bcname      string          `protobuf:"bytes,1,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Type        ChainStatusType `protobuf:"varint,2,opt,name=type,proto3,enum=protos.ChainStatusType" json:"type,omitempty"`
+	Blockid     string          `protobuf:"bytes,3,opt,name=blockid,proto3" json:"blockid,omitempty"`
+	BlockHeight int64           `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// 切换前的最新区块
+	PrevBlockid string `protobuf:"bytes,5,opt,name=prev_blockid,json=prevBlockid,proto3" json:"prev_blockid,omitempty"`
+	// 分叉切换时回滚的区块数
+	UndoBlocks         int64 `protobuf:"varint,6,opt,name=undo_blocks,json=undoBlocks,proto3" json:"undo_blocks,omitempty"`
+	IrreversibleHeight int64 `protobuf:"varint,7,opt,name=irreversible_height,json=irreversibleHeight,proto3" json:"irreversible_height,omitempty"`
+}
+
+func (x *ChainStatusEvent) Reset() {
+	*x = ChainStatusEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_service_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChainStatusEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainStatusEvent) ProtoMessage() {}
+
+func (x *ChainStatusEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_event_service_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainStatusEvent.ProtoReflect.Descriptor instead.
+func (*ChainStatusEvent) Descriptor() ([]byte, []int) {
+	return file_event_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ChainStatusEvent) GetBcname() string {
+	if x != nil {
+		return x.Bcname
+	}
+	return ""
+}
+
+func (x *ChainStatusEvent) GetType() ChainStatusType {
+	if x != nil {
+		return x.Type
+	}
+	return ChainStatusType_NEW_TIP
+}
+
+func (x *ChainStatusEvent) GetBlockid() string {
+	if x != nil {
+		return x.Blockid
+	}
+	return ""
+}
+
+func (x *ChainStatusEvent) GetBlockHeight() int64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *ChainStatusEvent) GetPrevBlockid() string {
+	if x != nil {
+		return x.PrevBlockid
+	}
+	return ""
+}
+
+func (x *ChainStatusEvent) GetUndoBlocks() int64 {
+	if x != nil {
+		return x.UndoBlocks
+	}
+	return 0
+}
+
+func (x *ChainStatusEvent) GetIrreversibleHeight() int64 {
+	if x != nil {
+		return x.IrreversibleHeight
+	}
+	return 0
+}
+
 var File_event_service_proto protoreflect.FileDescriptor
 
 var file_event_service_proto_rawDesc = []byte{
@@ -518,17 +953,74 @@ var file_event_service_proto_rawDesc = []byte{
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x12, 0x2d, 0x0a, 0x06,
 	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2a, 0x1a, 0x0a, 0x0d, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05,
-	0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x00, 0x32, 0x46, 0x0a, 0x0c, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x36, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x42,
-	0x29, 0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x6f,
-	0x6f, 0x79, 0x61, 0x6e, 0x67, 0x32, 0x30, 0x31, 0x38, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x85, 0x02, 0x0a, 0x0f,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x17, 0x0a, 0x07, 0x62, 0x63, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x62, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x5f, 0x74, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x78,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x78, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x5f, 0x65, 0x76, 0x69, 0x63, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0e, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x45, 0x76, 0x69, 0x63, 0x74, 0x65, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75,
+	0x74, 0x68, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x6f,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x41,
+	0x64, 0x64, 0x72, 0x22, 0x92, 0x01, 0x0a, 0x0e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54,
+	0x78, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x63, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x63, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78,
+	0x69, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x50, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x54, 0x78, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x74, 0x78, 0x22, 0x5b, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x17, 0x0a,
+	0x07, 0x62, 0x63, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x62, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x22, 0x89, 0x02, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x63,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x63, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x70, 0x72, 0x65, 0x76, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x76, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x75, 0x6e, 0x64, 0x6f, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x6e, 0x64, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x12, 0x2f, 0x0a, 0x13, 0x69, 0x72, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x69, 0x62, 0x6c, 0x65,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x69,
+	0x72, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x2a, 0x3c, 0x0a, 0x0d, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x00, 0x12, 0x0e, 0x0a,
+	0x0a, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x58, 0x10, 0x01, 0x12, 0x10, 0x0a,
+	0x0c, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x10, 0x02, 0x2a,
+	0x2c, 0x0a, 0x0f, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x44, 0x4d, 0x49, 0x54, 0x54, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x0b, 0x0a, 0x07, 0x45, 0x56, 0x49, 0x43, 0x54, 0x45, 0x44, 0x10, 0x01, 0x2a, 0x41, 0x0a,
+	0x0f, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x0b, 0x0a, 0x07, 0x4e, 0x45, 0x57, 0x5f, 0x54, 0x49, 0x50, 0x10, 0x00, 0x12, 0x0f, 0x0a,
+	0x0b, 0x46, 0x4f, 0x52, 0x4b, 0x5f, 0x53, 0x57, 0x49, 0x54, 0x43, 0x48, 0x10, 0x01, 0x12, 0x10,
+	0x0a, 0x0c, 0x49, 0x52, 0x52, 0x45, 0x56, 0x45, 0x52, 0x53, 0x49, 0x42, 0x4c, 0x45, 0x10, 0x02,
+	0x32, 0x46, 0x0a, 0x0c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x36, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x18, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x42, 0x29, 0x5a, 0x27, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x6f, 0x6f, 0x79, 0x61, 0x6e, 0x67, 0x32, 0x30,
+	0x31, 0x38, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -543,30 +1035,41 @@ func file_event_service_proto_rawDescGZIP() []byte {
 	return file_event_service_proto_rawDescData
 }
 
-var file_event_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_event_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_event_service_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_event_service_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_event_service_proto_goTypes = []interface{}{
 	(SubscribeType)(0),          // 0: protos.SubscribeType
-	(*SubscribeRequest)(nil),    // 1: protos.SubscribeRequest
-	(*Event)(nil),               // 2: protos.Event
-	(*BlockRange)(nil),          // 3: protos.BlockRange
-	(*BlockFilter)(nil),         // 4: protos.BlockFilter
-	(*FilteredBlock)(nil),       // 5: protos.FilteredBlock
-	(*FilteredTransaction)(nil), // 6: protos.FilteredTransaction
-	(*ContractEvent)(nil),       // 7: protos.ContractEvent
+	(PendingTxAction)(0),        // 1: protos.PendingTxAction
+	(ChainStatusType)(0),        // 2: protos.ChainStatusType
+	(*SubscribeRequest)(nil),    // 3: protos.SubscribeRequest
+	(*Event)(nil),               // 4: protos.Event
+	(*BlockRange)(nil),          // 5: protos.BlockRange
+	(*BlockFilter)(nil),         // 6: protos.BlockFilter
+	(*FilteredBlock)(nil),       // 7: protos.FilteredBlock
+	(*FilteredTransaction)(nil), // 8: protos.FilteredTransaction
+	(*PendingTxFilter)(nil),     // 9: protos.PendingTxFilter
+	(*PendingTxEvent)(nil),      // 10: protos.PendingTxEvent
+	(*ChainStatusFilter)(nil),   // 11: protos.ChainStatusFilter
+	(*ChainStatusEvent)(nil),    // 12: protos.ChainStatusEvent
+	(*ContractEvent)(nil),       // 13: protos.ContractEvent
+	(*Transaction)(nil),         // 14: protos.Transaction
 }
 var file_event_service_proto_depIdxs = []int32{
-	0, // 0: protos.SubscribeRequest.type:type_name -> protos.SubscribeType
-	3, // 1: protos.BlockFilter.range:type_name -> protos.BlockRange
-	6, // 2: protos.FilteredBlock.txs:type_name -> protos.FilteredTransaction
-	7, // 3: protos.FilteredTransaction.events:type_name -> protos.ContractEvent
-	1, // 4: protos.EventService.Subscribe:input_type -> protos.SubscribeRequest
-	2, // 5: protos.EventService.Subscribe:output_type -> protos.Event
-	5, // [5:6] is the sub-list for method output_type
-	4, // [4:5] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	0,  // 0: protos.SubscribeRequest.type:type_name -> protos.SubscribeType
+	5,  // 1: protos.BlockFilter.range:type_name -> protos.BlockRange
+	8,  // 2: protos.FilteredBlock.txs:type_name -> protos.FilteredTransaction
+	13, // 3: protos.FilteredTransaction.events:type_name -> protos.ContractEvent
+	1,  // 4: protos.PendingTxEvent.action:type_name -> protos.PendingTxAction
+	14, // 5: protos.PendingTxEvent.tx:type_name -> protos.Transaction
+	2,  // 6: protos.ChainStatusFilter.types:type_name -> protos.ChainStatusType
+	2,  // 7: protos.ChainStatusEvent.type:type_name -> protos.ChainStatusType
+	3,  // 8: protos.EventService.Subscribe:input_type -> protos.SubscribeRequest
+	4,  // 9: protos.EventService.Subscribe:output_type -> protos.Event
+	9,  // [9:10] is the sub-list for method output_type
+	8,  // [8:9] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_event_service_proto_init() }
@@ -648,14 +1151,62 @@ func file_event_service_proto_init() {
 				return nil
 			}
 		}
+		file_event_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingTxFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingTxEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChainStatusFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChainStatusEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_event_service_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   6,
+			NumEnums:      3,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
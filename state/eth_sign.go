@@ -0,0 +1,100 @@
+package state
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/wooyang2018/corechain/contract/evm"
+	"github.com/wooyang2018/corechain/protos"
+)
+
+const (
+	ethModuleName  = "evm"
+	ethInputArg    = "input"
+	feePlaceholder = "$"
+)
+
+// isEthSignedTx 发起人签名为以太坊钱包签名的RLP交易
+func isEthSignedTx(tx *protos.Transaction) bool {
+	return tx.GetXuperSign() == nil && len(tx.GetInitiatorSigns()) == 1 &&
+		tx.GetInitiatorSigns()[0].GetPublicKey() == evm.EthSignPublicKey
+}
+
+// verifyEthSign 校验以太坊钱包签名的交易
+// 钱包只对RLP交易签名，其余部分由web3服务构造，因此要求合约调用与签名内容完全一致，
+// 发起人的支出不超过转账金额与gasPrice*gasLimit之和，重放由evm合约中按发送者记录的nonce保证
+func (t *State) verifyEthSign(tx *protos.Transaction) (bool, map[string]bool, error) {
+	chainID := t.sctx.Ledger.GetGenesisBlock().GetConfig().EVMChainID
+	if chainID == 0 {
+		return false, nil, errors.New("ethereum signed transaction disabled")
+	}
+	if len(tx.GetAuthRequire()) != 0 || len(tx.GetAuthRequireSigns()) != 0 {
+		return false, nil, errors.New("ethereum signed transaction can not have auth require")
+	}
+	raw := tx.GetInitiatorSigns()[0].GetSign()
+	ethTx, err := evm.DecodeEthTx(raw)
+	if err != nil {
+		return false, nil, err
+	}
+	if id := ethTx.ChainID(); id != nil && (!id.IsUint64() || id.Uint64() != chainID) {
+		return false, nil, fmt.Errorf("ethereum transaction chain id %s mismatch", id)
+	}
+	sender, err := ethTx.Sender()
+	if err != nil {
+		return false, nil, err
+	}
+	initiator, err := evm.EVMAddressToXchain(sender)
+	if err != nil || initiator != tx.GetInitiator() {
+		return false, nil, errors.New("ethereum transaction sender mismatch initiator")
+	}
+
+	// 除系统保留合约外只能有一个evm合约调用，保留合约调用在校验读写集时检查
+	reserved := len(t.meta.GetReservedContracts())
+	if len(ethTx.To) == 0 || len(tx.GetContractRequests()) != reserved+1 {
+		return false, nil, errors.New("ethereum transaction must call exactly one evm contract")
+	}
+	to, err := crypto.AddressFromBytes(ethTx.To)
+	if err != nil {
+		return false, nil, err
+	}
+	contractName, err := evm.DetermineContractNameFromEVM(to)
+	if err != nil {
+		return false, nil, err
+	}
+	req := tx.GetContractRequests()[reserved]
+	value := ""
+	if ethTx.Value.Sign() > 0 {
+		value = ethTx.Value.String()
+	}
+	if req.GetModuleName() != ethModuleName || req.GetContractName() != contractName ||
+		req.GetAmount() != value || len(req.GetArgs()) != 2 ||
+		string(req.GetArgs()[ethInputArg]) != string(ethTx.Data) ||
+		string(req.GetArgs()[evm.EthTxArg]) != string(raw) {
+		return false, nil, errors.New("contract request mismatch ethereum transaction")
+	}
+
+	// 手续费和发起人的支出
+	maxFee := new(big.Int).Mul(ethTx.GasPrice, new(big.Int).SetUint64(ethTx.GasLimit))
+	fee := new(big.Int)
+	spent := new(big.Int)
+	for _, output := range tx.GetTxOutputs() {
+		amount := new(big.Int).SetBytes(output.GetAmount())
+		switch string(output.GetToAddr()) {
+		case feePlaceholder:
+			fee.Add(fee, amount)
+		case initiator:
+			spent.Sub(spent, amount)
+		}
+	}
+	for _, input := range tx.GetTxInputs() {
+		if string(input.GetFromAddr()) == initiator {
+			spent.Add(spent, new(big.Int).SetBytes(input.GetAmount()))
+		}
+	}
+	if fee.Cmp(maxFee) > 0 || spent.Cmp(new(big.Int).Add(ethTx.Value, maxFee)) > 0 {
+		return false, nil, errors.New("ethereum transaction spends more than signed")
+	}
+	return true, map[string]bool{initiator: true}, nil
+}
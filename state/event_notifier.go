@@ -0,0 +1,61 @@
+package state
+
+import "sync"
+
+const defaultEventBufferSize = 1024
+
+// EventNotifier broadcast events to all listeners,
+// events are dropped for listeners whose buffer is full instead of blocking the publisher
+type EventNotifier struct {
+	mutex     sync.Mutex
+	listeners map[chan interface{}]struct{}
+	bufSize   int
+}
+
+// NewEventNotifier instances a new EventNotifier
+func NewEventNotifier(bufSize int) *EventNotifier {
+	if bufSize <= 0 {
+		bufSize = defaultEventBufferSize
+	}
+	return &EventNotifier{
+		listeners: make(map[chan interface{}]struct{}),
+		bufSize:   bufSize,
+	}
+}
+
+// Subscribe returns the events channel and the function to cancel subscription
+func (e *EventNotifier) Subscribe() (<-chan interface{}, func()) {
+	ch := make(chan interface{}, e.bufSize)
+	e.mutex.Lock()
+	e.listeners[ch] = struct{}{}
+	e.mutex.Unlock()
+
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			e.mutex.Lock()
+			delete(e.listeners, ch)
+			e.mutex.Unlock()
+		})
+	}
+	return ch, cancel
+}
+
+// Notify send event to all listeners without blocking
+func (e *EventNotifier) Notify(event interface{}) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	for ch := range e.listeners {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
+
+// Listeners returns the number of listeners
+func (e *EventNotifier) Listeners() int {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	return len(e.listeners)
+}
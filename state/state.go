@@ -55,6 +55,10 @@ var (
 	ErrGetReservedContracts = errors.New("Get reserved contracts error")
 
 	ErrMempoolIsFull = errors.New("Mempool is full")
+
+	ErrTxLockInvalid = errors.New("Invalid tx lock height or lock time")
+	ErrTxNotMature   = errors.New("Tx is locked until a later height or time")
+	ErrTxExpired     = errors.New("Tx is expired at current height")
 )
 
 const (
@@ -76,6 +80,8 @@ type State struct {
 	ldb           storage.Database
 	latestBlockid []byte
 	notifier      *BlockHeightNotifier // 最新区块高度通知器
+	txEvents      *EventNotifier       // mempool交易事件通知器
+	statusEvents  *EventNotifier       // 链状态事件通知器
 }
 
 //NewState 新建状态机
@@ -142,6 +148,9 @@ func NewState(sctx *stateBase.StateCtx) (*State, error) {
 	}
 
 	obj.notifier = NewBlockHeightNotifier()
+	obj.txEvents = NewEventNotifier(0)
+	obj.statusEvents = NewEventNotifier(0)
+	obj.tx.Mempool.SetTxWatcher(obj.notifyTxEvent)
 
 	return obj, nil
 }
@@ -242,7 +251,7 @@ func (t *State) QueryAccountGovernTokenBalance(accountName string) (*protos.Gove
 
 // HasTx 查询一笔交易是否在unconfirm表  这些可能是放在tx对外提供
 func (t *State) HasTx(txid []byte) (bool, error) {
-	return t.tx.Mempool.HasTx(string(txid)), nil
+	return t.tx.Mempool.HasTx(string(txid)) || t.tx.LockedPool.Has(string(txid)), nil
 }
 
 func (t *State) GetFrozenBalance(addr string) (*big.Int, error) {
@@ -343,9 +352,52 @@ func (t *State) DoTx(tx *protos.Transaction) error {
 		t.log.Warn("tx from PostTx must not have blockid", "txid", utils.F(tx.Txid))
 		return ErrUnexpected
 	}
+	return t.doOrHoldTx(tx)
+}
+
+// doOrHoldTx 时间锁未到期或依赖暂存交易的交易先暂存，到期后再执行
+func (t *State) doOrHoldTx(tx *protos.Transaction) error {
+	nextHeight := t.sctx.Ledger.GetMeta().TrunkHeight + 1
+	if ltx.IsTxExpired(tx, nextHeight) {
+		return ErrTxExpired
+	}
+	now := time.Now().Unix()
+	if !ltx.IsTxMature(tx, nextHeight, now) || t.tx.LockedPool.DependOnLocked(tx) {
+		return t.holdLockedTx(tx, nextHeight, now)
+	}
 	return t.doTxSync(tx)
 }
 
+// holdLockedTx 暂存交易只保存在内存中，节点重启后丢失
+func (t *State) holdLockedTx(tx *protos.Transaction, nextHeight, now int64) error {
+	if t.tx.Mempool.HasTx(string(tx.GetTxid())) {
+		return ErrAlreadyInUnconfirmed
+	}
+	err := t.tx.LockedPool.Put(tx, nextHeight, now)
+	if err == ltx.ErrTxExist {
+		return ErrAlreadyInUnconfirmed
+	}
+	if err != nil {
+		return err
+	}
+	t.log.Debug("hold time locked tx", "txid", utils.F(tx.Txid),
+		"lockHeight", tx.GetLockHeight(), "lockTime", tx.GetLockTime())
+	return nil
+}
+
+// releaseLockedTxs 执行在下一个区块高度已到期的暂存交易
+func (t *State) releaseLockedTxs(nextHeight int64) {
+	for _, tx := range t.tx.LockedPool.Release(nextHeight, time.Now().Unix()) {
+		if ltx.IsTxExpired(tx, nextHeight) {
+			t.log.Warn("drop expired time locked tx", "txid", utils.F(tx.Txid))
+			continue
+		}
+		if err := t.doTxSync(tx); err != nil {
+			t.log.Warn("release time locked tx failed", "txid", utils.F(tx.Txid), "err", err)
+		}
+	}
+}
+
 // 创建获取最新状态数据XMReader
 func (t *State) CreateXMReader() ledger.XReader {
 	return t.xmodel
@@ -435,6 +487,7 @@ func (t *State) PlayForMiner(blockid []byte) error {
 		return updateErr
 	}
 	//更新latestBlockid
+	prevBlockid := t.latestBlockid
 	err = t.updateLatestBlockid(block.Blockid, batch, "failed to save block")
 	timer.Mark("persist_tx")
 	if err != nil {
@@ -447,6 +500,8 @@ func (t *State) PlayForMiner(blockid []byte) error {
 	newMeta := proto.Clone(t.meta.TempMeta).(*protos.UtxoMeta)
 	t.meta.UtxoMeta = newMeta
 	t.meta.Mutex.Unlock()
+	t.notifyChainStatus(block, prevBlockid, 0, curIrreversibleBlockHeight)
+	go t.releaseLockedTxs(block.Height + 1)
 	t.log.Info("play for miner", "height", block.Height, "blockId", utils.F(block.Blockid), "costs", timer.Print())
 	return nil
 }
@@ -513,6 +568,7 @@ func (t *State) PlayAndRepost(blockid []byte, needRepost bool, isRootTx bool) er
 		return updateErr
 	}
 	//更新latestBlockid
+	prevBlockid := t.latestBlockid
 	persistErr := t.updateLatestBlockid(block.Blockid, batch, "failed to save block")
 	timer.Mark("persist_tx")
 	if persistErr != nil {
@@ -533,6 +589,8 @@ func (t *State) PlayAndRepost(blockid []byte, needRepost bool, isRootTx bool) er
 	newMeta := proto.Clone(t.meta.TempMeta).(*protos.UtxoMeta)
 	t.meta.UtxoMeta = newMeta
 	t.meta.Mutex.Unlock()
+	t.notifyChainStatus(block, prevBlockid, 0, curIrreversibleBlockHeight)
+	go t.releaseLockedTxs(block.Height + 1)
 
 	t.log.Info("play and repost", "height", block.Height, "blockId", utils.F(block.Blockid), "unconfirmed", len(unconfirmToConfirm), "costs", timer.Print())
 	return nil
@@ -553,14 +611,19 @@ func (t *State) GetTimerTx(blockHeight int64) (*protos.Transaction, error) {
 		return nil, err
 	}
 
+	// 定时交易不携带utxo输入输出，周期任务调用的合约转账直接失败
 	contextConfig := &contractBase.ContextConfig{
-		State:       sandBox,
+		State:       ptimer.NewTaskSandbox(sandBox),
 		Initiator:   "",
 		AuthRequire: nil,
 	}
 
 	args := make(map[string][]byte)
 	args["block_height"] = []byte(strconv.FormatInt(blockHeight, 10))
+	// 使用父区块的出块时间调度周期任务，打包和校验时父区块均为当前最新区块
+	if parent, err := t.sctx.Ledger.QueryBlockHeader(t.latestBlockid); err == nil {
+		args["block_time"] = []byte(strconv.FormatInt(parent.GetTimestamp()/int64(time.Second), 10))
+	}
 	req := protos.InvokeRequest{
 		ModuleName:   "xkernel",
 		ContractName: "$timer_task",
@@ -666,6 +729,8 @@ func (t *State) Walk(blockid []byte, ledgerPrune bool) error {
 		return nil
 	}
 	xTimer.Mark("walk_get_lock")
+	prevBlockid := t.latestBlockid
+	prevIrreversibleBlockHeight := t.meta.GetIrreversibleBlockHeight()
 
 	// 首先先把所有的unconfirm回滚，记录被回滚的交易，然后walk结束后恢复被回滚的合法未确认交易
 	undoDone, undoList, err := t.RollBackUnconfirmedTx()
@@ -702,6 +767,9 @@ func (t *State) Walk(blockid []byte, ledgerPrune bool) error {
 		return fmt.Errorf("walk todo block fail")
 	}
 	xTimer.Mark("walk_todo_block")
+	if tipBlock, err := t.sctx.Ledger.QueryBlockHeader(t.latestBlockid); err == nil {
+		t.notifyChainStatus(tipBlock, prevBlockid, int64(len(undoBlocks)), prevIrreversibleBlockHeight)
+	}
 
 	// 异步回放被回滚未确认交易
 	go t.recoverUnconfirmedTx(undoList)
@@ -1278,7 +1346,8 @@ func (t *State) recoverUnconfirmedTx(undoList []*protos.Transaction) {
 		}
 
 		// 重新提交交易，可能交易已经被其他节点打包到区块广播过来，导致失败
-		err = t.doTxSync(tx)
+		// 回滚后高度降低，时间锁可能重新未到期，与DoTx一样先暂存
+		err = t.doOrHoldTx(tx)
 		if err != nil {
 			doTxErrCnt++
 			t.log.Info("dotx fail for recover unconfirm tx,ignore recover this tx",
@@ -1451,6 +1520,56 @@ func (t *State) queryContractBannedStatus(contractName string) (bool, error) {
 	return false, nil
 }
 
+// WatchTxPool returns the channel of *protos.PendingTxEvent and the function to stop watching
+func (t *State) WatchTxPool() (<-chan interface{}, func()) {
+	return t.txEvents.Subscribe()
+}
+
+// WatchChainStatus returns the channel of *protos.ChainStatusEvent and the function to stop watching
+func (t *State) WatchChainStatus() (<-chan interface{}, func()) {
+	return t.statusEvents.Subscribe()
+}
+
+func (t *State) notifyTxEvent(tx *protos.Transaction, evicted bool) {
+	action := protos.PendingTxAction_ADMITTED
+	if evicted {
+		action = protos.PendingTxAction_EVICTED
+	}
+	t.txEvents.Notify(&protos.PendingTxEvent{
+		Bcname: t.sctx.BCName,
+		Txid:   hex.EncodeToString(tx.GetTxid()),
+		Action: action,
+		Tx:     tx,
+	})
+}
+
+// notifyChainStatus 在最新区块切换后通知链状态事件，undoBlocks大于0表示发生了分叉切换
+func (t *State) notifyChainStatus(tip *protos.InternalBlock, prevBlockid []byte, undoBlocks int64, prevIrreversibleHeight int64) {
+	tp := protos.ChainStatusType_NEW_TIP
+	if undoBlocks > 0 {
+		tp = protos.ChainStatusType_FORK_SWITCH
+	}
+	irreversibleHeight := t.meta.GetIrreversibleBlockHeight()
+	t.statusEvents.Notify(&protos.ChainStatusEvent{
+		Bcname:             t.sctx.BCName,
+		Type:               tp,
+		Blockid:            hex.EncodeToString(tip.GetBlockid()),
+		BlockHeight:        tip.GetHeight(),
+		PrevBlockid:        hex.EncodeToString(prevBlockid),
+		UndoBlocks:         undoBlocks,
+		IrreversibleHeight: irreversibleHeight,
+	})
+	if irreversibleHeight > prevIrreversibleHeight {
+		t.statusEvents.Notify(&protos.ChainStatusEvent{
+			Bcname:             t.sctx.BCName,
+			Type:               protos.ChainStatusType_IRREVERSIBLE,
+			Blockid:            hex.EncodeToString(tip.GetBlockid()),
+			BlockHeight:        tip.GetHeight(),
+			IrreversibleHeight: irreversibleHeight,
+		})
+	}
+}
+
 // WaitBlockHeight wait util the height of current block >= target
 func (t *State) WaitBlockHeight(target int64) int64 {
 	return t.notifier.WaitHeight(target)
@@ -14,6 +14,7 @@ import (
 
 	"github.com/wooyang2018/corechain/common/metrics"
 	contractBase "github.com/wooyang2018/corechain/contract/base"
+	"github.com/wooyang2018/corechain/contract/proposal/utils"
 	"github.com/wooyang2018/corechain/contract/sandbox"
 	"github.com/wooyang2018/corechain/crypto/client"
 	"github.com/wooyang2018/corechain/ledger"
@@ -58,6 +59,10 @@ func (t *State) ImmediateVerifyTx(tx *protos.Transaction, isRootTx bool) (bool,
 	if tx.Autogen {
 		return false, ErrInvalidAutogenTx
 	}
+	// 时间锁是否到期由打包区块的高度和时间决定，这里只校验取值
+	if tx.LockHeight < 0 || tx.LockTime < 0 || !ltx.CheckLockHeightArgs(tx) {
+		return false, ErrTxLockInvalid
+	}
 	MaxTxSizePerBlock, MaxTxSizePerBlockErr := t.MaxTxSizePerBlock()
 	if MaxTxSizePerBlockErr != nil {
 		return false, MaxTxSizePerBlockErr
@@ -214,6 +219,10 @@ func (t *State) verifySignatures(tx *protos.Transaction, digestHash []byte) (boo
 	if tx.GetXuperSign() != nil {
 		return t.verifyXuperSign(tx, digestHash)
 	}
+	// 以太坊钱包签名的交易
+	if isEthSignedTx(tx) {
+		return t.verifyEthSign(tx)
+	}
 
 	// Not XuperSign(multisig/rignsign etc.), use old signature process
 	verifiedAddr := make(map[string]bool)
@@ -404,7 +413,7 @@ func (t *State) verifyContractOwnerPermission(contractName string, tx *protos.Tr
 	if verifiedID[accountName] {
 		return true, nil
 	}
-	ok, err := permission.IdentifyAccount(t.sctx.AclMgr, accountName, tx.AuthRequire)
+	ok, err := permission.IdentifyAccountWithProposal(t.sctx.AclMgr, accountName, tx.AuthRequire, getProposalRequestFromTx(tx))
 	if err == nil && ok {
 		verifiedID[accountName] = true
 	}
@@ -422,6 +431,7 @@ func (t *State) verifyRWSetPermission(tx *protos.Transaction, verifiedID map[str
 	for _, txOut := range tx.TxOutputsExt {
 		writeSet = append(writeSet, &ledger.PureData{Bucket: txOut.Bucket, Key: txOut.Key, Value: txOut.Value})
 	}
+	proposal := getProposalRequestFromTx(tx)
 	for _, ele := range writeSet {
 		bucket := ele.GetBucket()
 		key := ele.GetKey()
@@ -432,7 +442,7 @@ func (t *State) verifyRWSetPermission(tx *protos.Transaction, verifiedID map[str
 			if verifiedID[accountName] {
 				continue
 			}
-			ok, err := permission.IdentifyAccount(t.sctx.AclMgr, accountName, tx.AuthRequire)
+			ok, err := permission.IdentifyAccountWithProposal(t.sctx.AclMgr, accountName, tx.AuthRequire, proposal)
 			if !ok {
 				t.log.Warn("verifyRWSetPermission check account bucket failed",
 					"account", accountName, "AuthRequire ", tx.AuthRequire, "error", err)
@@ -453,6 +463,22 @@ func (t *State) verifyRWSetPermission(tx *protos.Transaction, verifiedID map[str
 					"contract", contractName, "AuthRequire ", tx.AuthRequire, "error", contractErr)
 				return ok, contractErr
 			}
+		case base.GetCABucket():
+			// modified CA cert or revocation list, need to check if the tx has the permission of CA account
+			accountName := string(key)
+			if idx := bytes.Index(key, []byte(base.GetACLSeparator())); idx >= 0 {
+				accountName = string(key[:idx])
+			}
+			if verifiedID[accountName] {
+				continue
+			}
+			ok, err := permission.IdentifyAccountWithProposal(t.sctx.AclMgr, accountName, tx.AuthRequire, proposal)
+			if !ok {
+				t.log.Warn("verifyRWSetPermission check CA bucket failed",
+					"account", accountName, "AuthRequire ", tx.AuthRequire, "error", err)
+				return ok, err
+			}
+			verifiedID[accountName] = true
 		case base.GetContract2AccountBucket():
 			// modified contract/account mapping
 			// need to check if the tx has the permission of target account
@@ -464,7 +490,7 @@ func (t *State) verifyRWSetPermission(tx *protos.Transaction, verifiedID map[str
 			if verifiedID[accountName] {
 				continue
 			}
-			ok, accountErr := permission.IdentifyAccount(t.sctx.AclMgr, accountName, tx.AuthRequire)
+			ok, accountErr := permission.IdentifyAccountWithProposal(t.sctx.AclMgr, accountName, tx.AuthRequire, proposal)
 			if !ok {
 				t.log.Warn("verifyRWSetPermission check contract2account bucket failed",
 					"account", accountName, "AuthRequire ", tx.AuthRequire, "error", accountErr)
@@ -489,7 +515,8 @@ func (t *State) verifyContractPermission(tx *protos.Transaction, allUsers []stri
 		contractName := tmpReq.GetContractName()
 		methodName := tmpReq.GetMethodName()
 
-		ok, err := permission.CheckContractMethodPerm(t.sctx.AclMgr, allUsers, contractName, methodName)
+		proposal := getConsumedProposal(tx, tmpReq)
+		ok, err := permission.CheckContractMethodPermWithProposal(t.sctx.AclMgr, allUsers, contractName, methodName, proposal)
 		if err != nil || !ok {
 			t.log.Warn("verify contract method ACL failed ", "contract", contractName, "method",
 				methodName, "error", err)
@@ -499,6 +526,32 @@ func (t *State) verifyContractPermission(tx *protos.Transaction, allUsers []stri
 	return true, nil
 }
 
+// getProposalRequestFromTx return the first contract request of tx which consumed a proposal
+func getProposalRequestFromTx(tx *protos.Transaction) *base.ProposalRequest {
+	for _, req := range tx.GetContractRequests() {
+		if proposal := getConsumedProposal(tx, req); proposal != nil {
+			return proposal
+		}
+	}
+	return nil
+}
+
+// getConsumedProposal return the proposal referenced by the contract request,
+// the proposal must be consumed by the tx, i.e. the used marker is in the write set
+func getConsumedProposal(tx *protos.Transaction, req *protos.InvokeRequest) *base.ProposalRequest {
+	proposal := base.NewProposalRequest(tx.GetInitiator(), req.GetContractName(), req.GetMethodName(), req.GetArgs())
+	if proposal == nil {
+		return nil
+	}
+	usedKey := []byte(utils.MakeProposalUsedKey(proposal.ProposalID))
+	for _, output := range tx.GetTxOutputsExt() {
+		if output.GetBucket() == utils.GetProposalBucket() && bytes.Equal(output.GetKey(), usedKey) {
+			return proposal
+		}
+	}
+	return nil
+}
+
 func getGasLimitFromTx(tx *protos.Transaction) (int64, error) {
 	for _, output := range tx.GetTxOutputs() {
 		if string(output.GetToAddr()) != "$" {
@@ -838,7 +891,8 @@ func (t *State) VerifyContractPermission(initiator string, authRequire []string,
 }
 
 // VerifyContractOwnerPermission implement Contract ChainCore, used to verify contract ownership permisson
-func (t *State) VerifyContractOwnerPermission(contractName string, authRequire []string) error {
+func (t *State) VerifyContractOwnerPermission(contractName string, authRequire []string, initiator string,
+	req *protos.InvokeRequest) error {
 	versionData, confirmed, err := t.xmodel.GetWithTxStatus(base.GetContract2AccountBucket(), []byte(contractName))
 	if err != nil {
 		return err
@@ -850,7 +904,8 @@ func (t *State) VerifyContractOwnerPermission(contractName string, authRequire [
 	if accountName == "" {
 		return errors.New("contract not found")
 	}
-	ok, err := permission.IdentifyAccount(t.sctx.AclMgr, accountName, authRequire)
+	proposal := base.NewProposalRequest(initiator, req.GetContractName(), req.GetMethodName(), req.GetArgs())
+	ok, err := permission.IdentifyAccountWithProposal(t.sctx.AclMgr, accountName, authRequire, proposal)
 	if err != nil {
 		return err
 	}
@@ -877,6 +932,20 @@ func (t *State) queryAccountACL(accountName string) (*protos.Acl, error) {
 }
 
 func (t *State) verifyBlockTxs(block *protos.InternalBlock, isRootTx bool, unconfirmToConfirm map[string]bool) error {
+	// 使用区块高度和区块时间校验时间锁，保证各节点结果一致
+	blockTime := block.Timestamp / int64(time.Second)
+	for _, tx := range block.Transactions {
+		if !ltx.IsTxMature(tx, block.Height, blockTime) {
+			t.log.Warn("tx is not mature in block", "txid", fmt.Sprintf("%x", tx.Txid),
+				"lockHeight", tx.LockHeight, "lockTime", tx.LockTime, "height", block.Height)
+			return ErrTxNotMature
+		}
+		if ltx.IsTxExpired(tx, block.Height) {
+			t.log.Warn("tx is expired in block", "txid", fmt.Sprintf("%x", tx.Txid), "height", block.Height)
+			return ErrTxExpired
+		}
+	}
+
 	var err error
 	var once sync.Once
 	wg := sync.WaitGroup{}
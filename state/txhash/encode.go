@@ -177,6 +177,12 @@ func HashTx(tx *protos.Transaction, includeSigns bool) []byte {
 	enc.Encode(tx.GetHDInfo().GetHdPublicKey())
 	enc.Encode(tx.GetHDInfo().GetOriginalHash())
 
+	// 时间锁字段只在设置时参与编码，保持老交易的txid不变
+	if tx.LockHeight != 0 || tx.LockTime != 0 {
+		enc.Encode(tx.LockHeight)
+		enc.Encode(tx.LockTime)
+	}
+
 	sum := sha256.Sum256(h.Sum(nil))
 	return sum[:]
 }
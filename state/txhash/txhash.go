@@ -157,6 +157,15 @@ func encodeTxData(tx *protos.Transaction, includeSigns bool) ([]byte, error) {
 			return nil, err
 		}
 	}
+	// 时间锁字段只在设置时参与编码，保持老交易的txid不变
+	if tx.LockHeight != 0 || tx.LockTime != 0 {
+		if err = encoder.Encode(tx.LockHeight); err != nil {
+			return nil, err
+		}
+		if err = encoder.Encode(tx.LockTime); err != nil {
+			return nil, err
+		}
+	}
 	return buf.Bytes(), nil
 }
 
@@ -71,6 +71,37 @@ func TestTxHashVersion(t *testing.T) {
 
 }
 
+func TestTxHashWithLock(t *testing.T) {
+	for _, version := range []int32{1, 3} {
+		tx := readTxFile(t, "tx.pb")
+		tx.Version = version
+		origin, err := MakeTxID(tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		tx.LockHeight = 100
+		withHeight, err := MakeTxID(tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hex.EncodeToString(withHeight) == hex.EncodeToString(origin) {
+			t.Fatalf("lock height should change txid when version = %d", version)
+		}
+
+		tx.LockHeight = 0
+		tx.LockTime = 1700000000
+		withTime, err := MakeTxID(tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hex.EncodeToString(withTime) == hex.EncodeToString(origin) ||
+			hex.EncodeToString(withTime) == hex.EncodeToString(withHeight) {
+			t.Fatalf("lock time should change txid when version = %d", version)
+		}
+	}
+}
+
 func TestTestSignTx(t *testing.T) {
 	tx := &protos.Transaction{}
 	_, err := MakeTxDigestHash(tx)